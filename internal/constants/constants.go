@@ -4,26 +4,82 @@ const Version = "1.0.0"
 
 const DefaultInputFormat = "{YYYY}-{MM}-{DD}"
 
+const DefaultInputFormatDateTime = "{YYYY}-{MM}-{DD}T{hh}:{mm}"
+
 const ParseLayoutDate = "2006-1-2"
 
+const ParseLayoutDateTime = "2006-1-2T15:04"
+
+const ParseLayoutDateTimeSeconds = "2006-1-2T15:04:05"
+
 const HelpMessage = `Usage:
-  pdate [-i <days-to-ignore>] [-f <format>] [-r] [-l <language>] [start-date] [end-date]
+  pdate [-i <days-to-ignore>] [-f <format>] [--format-style <style>] [-r] [--grid] [-l <language>] [--locale-file <file>] [--count <n>] [--tz <zone>] [--step <step>] [--calendar <calendar>] [--digits <digits>] [--week-start <day>] [--weekend <profile>] [--workdays-only] [--only <days>] [--days <list>] [--months <list>] [--weeks <list>] [--doy <list>] [--where <expression>] [--holidays <file>] [--offset <n>] [--every <n>] [--first <n>] [--last <n>] [--sample <n> [--seed <seed>]] [--union <ranges>] [--intersect <ranges>] [--minus <ranges>] [- | --stdin] [start-date] [end-date | <from>..<to> ...]
+  pdate reformat --in <format> [-f <format>] [--format-style <style>] [-l <language>] [--calendar <calendar>] [--digits <digits>]
+  pdate info [--json] [-f <format>] [-l <language>] [--holidays <file>] [date]
 
 Description:
   Prints dates from <start-date> to <end-date> (or today if end-date is omitted).
   You can optionally ignore specific weekdays, customize the date format, or reverse the order.
+  reformat reads a text from standard input and replaces the dates of the --in format with the -f format.
+  info prints the weekday, ISO week, day of year, quarter and holidays of a date (or today).
 
 Options:
-  [start-date]         Start of the date range (format: YYYY-MM-DD).
-  [end-date]           Optional end of the range (format: YYYY-MM-DD). Defaults to today.
+  [start-date]         Start of the date range (format: YYYY-MM-DD, YYYY-MM-DDThh:mm or YYYY-MM-DDThh:mm:ss).
+  [end-date]           Optional end of the range (same formats). Defaults to today, or now for steps below a day.
+  [<from>..<to> ...]   Ranges instead of the dates (e.g., 2025-01-01..2025-01-31 2025-03-01..2025-03-15),
+                       the dates are printed once and in order.
   -i <days>            Ignore specific weekdays using codes (e.g., mo tu fr).
   -f <format>          Format each date using placeholders (see below).
+  --format-style <s>   Interpret the -f format as placeholder (default), strftime or go.
+  --in <format>        With reformat: the format of the dates in the text, in the same style as -f.
+                       It needs a year, a month and a day; names are read in the language of -l or in English.
+  --json               With info: print the record as JSON.
+  --grid               Print the dates as a calendar of months with the locale weeks in front,
+                       not with -f, --calendar or -r.
   -r                   Print dates in reverse order.
-  -l <language>        Print the format in the desired language, default language is english
+  -l <language>        Print the format in a language given as BCP 47 tag (e.g., de, de-CH, pt-BR, zh-Hant),
+                       defaults to $LC_ALL, $LC_TIME or $LANG (e.g., de_CH.UTF-8), else en.
+                       The language also applies to this help, the error messages and the -i codes.
+  --locale-file <f>    Use the names of a YAML locale file (see the README), -l can still choose another language.
+  --count <n>          Print n dates from start-date on (backwards with -r), counted after -i.
+  --limit <n>          Same as --count.
+  --step <step>        Distance between two dates, a number followed by s, m, h, d or w (e.g., 15m). Defaults to 1d.
+                       Steps of weeks go from the first day of a week to the next, 7d keeps the weekday of start-date.
+  --tz <zone>          Resolve today in a timezone (e.g., Europe/Zurich), defaults to $TZ or the system timezone.
+                       Steps below a day follow its daylight saving time.
+  --calendar <cal>     Print the dates in another calendar (see below), defaults to gregorian.
+  --digits <digits>    Print numbers with native (e.g., ar, fa, hi, th, zh, ja) or latin digits, defaults to native for ar and fa.
+  --week-start <day>   First day of the week for {ww}, {gggg}, steps of weeks and --grid: mo, su or sa,
+                       defaults to the first day of the locale (e.g., mo for de, su for en-US, sa for ar-EG).
+                       Week 1 of mo is the ISO week, of su and sa the week of January 1.
+  --weekend <profile>  Weekend left out by --workdays-only: sat-sun, fri-sat, fri or sun,
+                       defaults to the weekend of the locale (e.g., sat-sun for de, fri-sat for he and ar-SA).
+  --workdays-only      Leave out the weekend, together with the weekdays of -i.
+  --only <days>        Keep only these weekdays, with the codes of -i (e.g., mo we).
+  --days <list>        Keep only these days of the month, negative ones count from the end (e.g., 1,15,-1 or 1-7).
+  --months <list>      Keep only these months as codes, names of the language or numbers (e.g., jan,apr,jul,oct or nov-feb).
+  --weeks <list>       Keep only these ISO weeks (e.g., 1-10).
+  --doy <list>         Keep only these days of the year, negative ones count from the end (e.g., 1,100,-1).
+                       The filters can be combined, a date has to match all of them.
+  --where <expr>       Keep only the dates for which the expression is true (see below).
+  --holidays <file>    File with a date (YYYY-MM-DD) per line for holiday in --where,
+                       followed by an optional name which info prints.
+  --offset <n>         Skip the first n dates which pass the filters.
+  --every <n>          Keep every nth date which passes the filters, starting after --offset.
+  --first <n>          Keep the first n dates.
+  --last <n>           Keep the last n dates.
+  --sample <n>         Keep n dates drawn at random, in their order.
+  --seed <seed>        Draw the same --sample every time.
+                       These options are applied in this order after the filters and before -r.
+  --union <ranges>     Add the dates of these ranges.
+  --intersect <ranges> Keep only the dates within these ranges.
+  --minus <ranges>     Remove the dates within these ranges.
+                       These are applied in this order before the filters and take every range after them.
+  -, --stdin           Read the dates and ranges line by line from standard input.
   -h, --help           Show this help message.
   -v, --version        Show version
 
-Weekday Codes for -i:
+Weekday Codes for -i (other languages add their own codes and abbreviations, e.g., lu ma me with -l fr):
   mo  Monday
   tu  Tuesday
   we  Wednesday
@@ -40,23 +96,70 @@ Format Placeholders for -f:
   {DD}    Day with leading zero (e.g., 07)
   {D}     Day without leading zero (e.g., 7)
   {MN}    Full month name (e.g., December)
+  {MNg}   Month name inside a date, genitive in Russian (e.g., декабря)
   {mn}    Abbreviated month name (e.g., Dec)
+  {mn1}   Narrow month name (e.g., D)
   {WD}    Full weekday name (e.g., Sunday)
   {wd}    Abbreviated weekday name (e.g., Sun)
+  {wd1}   Narrow weekday name (e.g., S)
+  {WW}    ISO week with leading zero (e.g., 49)
+  {GGGG}  ISO week-numbering year (e.g., 2025)
+  {ww}    Week of the locale with leading zero, week 1 holds January 1 in the US and January 4 in most of Europe (e.g., 50)
+  {gggg}  Week-numbering year of the locale (e.g., 2025)
+  {DOY}   Day of year with leading zeros (e.g., 341)
+  {Q}     Quarter of the year (e.g., 4)
+  {Do}    Day of month as ordinal (e.g., 7th)
+  {U}     Unix timestamp in seconds, of midnight in the timezone for whole days (e.g., 1765065600)
+  {JDN}   Julian Day Number (e.g., 2461017)
+  {N}     ISO weekday, Monday = 1 (e.g., 7)
+  {idx}   Position in the output, starting at 1
+  {hh}    Hour (00-23)
+  {mm}    Minute (00-59)
+  {ss}    Second (00-59)
+  {HH12}  Hour on a 12-hour clock (01-12)
+  {AMPM}  Before or after noon (e.g., AM)
+  {Z}     Offset of the timezone from UTC (e.g., +01:00)
+  {ERA}   Japanese era or Chinese sexagenary year (e.g., Reiwa)
+
+Placeholder Modifiers for -f (e.g., {WD:len=2:upper}):
+  upper   Upper case
+  lower   Lower case
+  title   Upper case first letter (e.g., {WD:title} prints Dimanche with -l fr)
+  padN    Pad to N characters (e.g., {D:pad3} prints 007)
+  len=N   Cut the value after N characters (e.g., {mn:len=2} prints De)
+  Use {{ and }} to print a literal { or }.
+
+Expressions for --where (e.g., 'weekday in (mo,fr) and day <= 7 and not holiday'):
+  Comparisons  = != < <= > >= and in (...) or not in (...) with numbers, codes (mo..su, jan..dec) and ranges (1..7, nov..feb)
+  Conditions   and, or, not and parentheses
+  Numbers      year month day weekday (mo = 1) week (ISO) quarter doy hour minute second daysInMonth nthWeekday
+  Tests        isFirstWeekdayOfMonth isLastWeekdayOfMonth isLastDayOfMonth isLeapYear weekend holiday
+
+Format Styles for --format-style:
+  placeholder  {YYYY}-{MM}-{DD} (default)
+  strftime     %Y-%m-%d, supports %Y %y %m %-m %d %-d %a %A %b %h %B %F %D %j %V %G %u %q %s
+               %H %M %S %I %p %T %R %n %t %%
+  go           2006-01-02, supports 2006 06 01 1 02 2 002 Jan January Mon Monday 15 03 04 05 PM pm
 
-Language Codes for -l:
-  en  English
-  fr  French
-  es  Spanish
-  de  German
-  ch  Swiss
-  it  Italian
-  pt  Portuguese
-  nl  Dutch
-  ru  Russian
-  zh  Chinese
-  ar  Arabic
-  hi  Hindi
+Calendars for --calendar (change {YYYY} {YY} {MM} {M} {DD} {D} {Do} {MN} {mn}):
+  gregorian  Default
+  julian     Proleptic Julian calendar
+  islamic    Tabular Islamic calendar
+  hebrew     Hebrew calendar, months numbered from Tishri
+  persian    Solar Hijri calendar
+  buddhist   Thai solar calendar
+  japanese   Gregorian months with the year of the Japanese era
+  chinese    Chinese lunisolar calendar, {YYYY} is the Gregorian year the Chinese year started in
+
+Languages for -l (add a region for regional names, e.g., de-AT, es-MX, fr-CA, ar-MA, zh-TW):
+  ar Arabic     bg Bulgarian  ca Catalan    cs Czech      da Danish     de German
+  el Greek      en English    es Spanish    et Estonian   fa Persian    fi Finnish
+  fr French     gsw Swiss     he Hebrew     hi Hindi      hr Croatian   hu Hungarian
+  id Indonesian it Italian    ja Japanese   ko Korean     lt Lithuanian lv Latvian
+  nb Norwegian  nl Dutch      pl Polish     pt Portuguese ro Romanian   ru Russian
+  sk Slovak     sl Slovenian  sv Swedish    th Thai       tr Turkish    uk Ukrainian
+  vi Vietnamese zh Chinese    zh-Hant Traditional Chinese
+  ch is still accepted for Swiss German.
 
 Examples:
   pdate 2025-10-02
@@ -73,4 +176,46 @@ Examples:
 
   pdate -f "{DD}.{MM}.{YYYY} ({wd})" 2025-10-02 2025-10-10
     Prints formatted dates like 02.10.2025 (Thu)
+
+  pdate --count 20 -i sa su 2025-10-02
+    Prints the next 20 working days starting at October 2, 2025.
+
+  pdate --count 20 --workdays-only --weekend fri-sat 2025-10-02
+    Prints the next 20 working days of a week with its weekend on Friday and Saturday.
+
+  pdate --grid --workdays-only --week-start mo 2025-10-01 2025-10-31
+    Prints the workdays of October 2025 as a calendar with weeks from Monday to Sunday.
+
+  pdate --count 12 --days 15,-1 2025-01-01
+    Prints the 15th and the last day of each month for the first half of 2025.
+
+  pdate --where 'weekday = fr and isLastWeekdayOfMonth' 2025-01-01 2025-12-31
+    Prints the last Friday of every month in 2025.
+
+  pdate --sample 10 --seed 42 2025-01-01 2025-12-31
+    Prints 10 random dates of 2025, the same ones on every run.
+
+  pdate 2025-08-18..2025-12-19 2026-01-05..2026-03-27 --minus 2025-10-06..2025-10-17
+    Prints the dates of two school terms without the autumn holidays.
+
+  git log --format=%as | pdate - -i sa su -f "{DD}.{MM}.{YYYY}"
+    Reformats the dates of the commits made on working days.
+
+  pdate reformat --in "{YYYY}-{MM}-{DD}" -f "{D}. {MN} {YYYY}" -l de < CHANGELOG.md
+    Writes the dates of a changelog in German and leaves the rest of the text as it is.
+
+  pdate info 2025-12-25 --holidays holidays.txt
+    Prints the weekday, week, day of year and holidays of Christmas 2025 and how far away it is.
+
+  pdate --step 15m 2025-10-02T08:00 2025-10-02T18:00
+    Prints a time slot every 15 minutes from 08:00 to 18:00.
+
+  pdate --format-style strftime -f "%d.%m.%Y (%a)" 2025-10-02 2025-10-10
+    Prints the same dates using a strftime format string.
+
+  pdate --calendar hebrew -f "{D} {MN} {YYYY}" 2025-09-23 2025-10-02
+    Prints the dates in the Hebrew calendar, like 1 Tishri 5786
+
+  pdate -l zh --digits native -f "{YYYY}年{MN}{D}日" 2025-12-01 2025-12-07
+    Prints the dates with Chinese numerals, like 二〇二五年十二月一日
 `
@@ -0,0 +1,427 @@
+package dates
+
+import (
+	"pdate/internal/job"
+	"pdate/internal/locale"
+	"strings"
+	"time"
+)
+
+// CalendarDate is a date in one of the supported calendars. NameIndex points
+// into the month names of the calendar, which differs from Month-1 where a
+// month number doesn't have a fixed name, e.g. the Hebrew Adar in leap years.
+type CalendarDate struct {
+	Year      int
+	Month     int
+	Day       int
+	NameIndex int
+	Leap      bool
+}
+
+// yearWidth is the number of digits {YYYY} is padded to. Japanese era years
+// start again at 1 with every era and are never padded.
+var yearWidth = map[job.Calendar]int{
+	job.GregorianCalendar: 4,
+	job.IslamicCalendar:   4,
+	job.HebrewCalendar:    4,
+	job.PersianCalendar:   4,
+	job.BuddhistCalendar:  4,
+	job.JapaneseCalendar:  1,
+	job.JulianCalendar:    4,
+	job.ChineseCalendar:   4,
+}
+
+var islamicMonthNames = map[job.Language][]string{
+	job.English:    {"Muharram", "Safar", "Rabiʻ I", "Rabiʻ II", "Jumada I", "Jumada II", "Rajab", "Shaʻban", "Ramadan", "Shawwal", "Dhuʻl-Qiʻdah", "Dhuʻl-Hijjah"},
+	job.Spanish:    {"muharram", "safar", "rabi’ al-awwal", "rabi’ al-akhir", "yumada al-ula", "yumada al-akhirah", "rayab", "sha’ban", "ramadán", "shawwal", "dhu al-qadah", "dhu al-hijjah"},
+	job.French:     {"mouharram", "safar", "rabia al awal", "rabia ath-thani", "joumada al oula", "joumada ath-thania", "rajab", "chaabane", "ramadan", "chawwal", "dhou al qi`da", "dhou al-hijja"},
+	job.German:     {"Muharram", "Safar", "Rabiʻ I", "Rabiʻ II", "Dschumada I", "Dschumada II", "Radschab", "Schaʻban", "Ramadan", "Schawwal", "Dhu l-qaʻda", "Dhu l-Hiddscha"},
+	job.Italian:    {"muharram", "safar", "rabi' al-awwal", "rabi' al-akhir", "jumada al-ula", "jumada al-akhira", "rajab", "sha'ban", "ramadan", "shawwal", "dhu l-qa'da", "dhu l-hijja"},
+	job.Portuguese: {"muharram", "safar", "rabi al-awwal", "rabi al-thani", "jumada al-awwal", "jumada al-thani", "rajab", "xabã", "ramadã", "xawal", "dhu al-qada", "dhu al-hijja"},
+	job.Dutch:      {"Moeharram", "Safar", "Rabiʻa al awal", "Rabiʻa al thani", "Joemadʻal awal", "Joemadʻal thani", "Rajab", "Sjaʻaban", "Ramadan", "Sjawal", "Dhul Qaʻada", "Dhul Hijja"},
+	job.Russian:    {"мухаррам", "сафар", "раби-уль-авваль", "раби-уль-ахир", "джумад-уль-авваль", "джумад-уль-ахир", "раджаб", "шаабан", "рамадан", "шавваль", "зуль-каада", "зуль-хиджжа"},
+	job.Chinese:    {"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+	job.Arabic:     {"محرم", "صفر", "ربيع الأول", "ربيع الآخر", "جمادى الأولى", "جمادى الآخرة", "رجب", "شعبان", "رمضان", "شوال", "ذو القعدة", "ذو الحجة"},
+	job.Hindi:      {"मुहर्रम", "सफ़र", "राबी प्रथम", "राबी द्वितीय", "जुम्डा प्रथम", "जुम्डा द्वितीय", "रजब", "शाबान", "रमज़ान", "शव्व्ल", "ज़िलक़ाद", "ज़िलहिज्ज"},
+	job.Japanese:   {"ムハッラム", "サフアル", "ラビー・ウル・アウワル", "ラビー・ウッ・サーニー", "ジュマーダル・アウワル", "ジュマーダッサーニー", "ラジャブ", "シャアバーン", "ラマダーン", "シャウワール", "ズル・カイダ", "ズル・ヒッジャ"},
+	job.Hebrew:     {"מוחרם", "צפר", "רביע אל-אוול", "רביע א-ת׳אני", "ג׳ומאדא אל-אולא", "ג׳ומאדא א-ת׳אניה", "רג׳ב", "שעבאן", "רמדאן", "שוואל", "ד׳ו אל-קעדה", "ד׳ו אל-חיג׳ה"},
+	job.Persian:    {"محرم", "صفر", "ربیع‌الاول", "ربیع‌الثانی", "جمادی‌الاول", "جمادی‌الثانی", "رجب", "شعبان", "رمضان", "شوال", "ذیقعده", "ذیحجه"},
+}
+
+// hebrewMonthNames has Adar I at index 5 and Adar II at the end, see
+// HebrewDate.
+var hebrewMonthNames = map[job.Language][]string{
+	job.English:    {"Tishri", "Heshvan", "Kislev", "Tevet", "Shevat", "Adar I", "Adar", "Nisan", "Iyar", "Sivan", "Tamuz", "Av", "Elul", "Adar II"},
+	job.Spanish:    {"tishri", "heshvan", "kislev", "tevet", "shevat", "adar I", "adar", "nisán", "iyar", "siván", "tamuz", "av", "elul", "adar II"},
+	job.French:     {"Tichri", "Hesvan", "Kislev", "Tébeth", "Schébat", "Adar I", "Adar", "Nissan", "Iyar", "Sivan", "Tamouz", "Ab", "Elloul", "Adar II"},
+	job.German:     {"Tischri", "Cheschwan", "Kislew", "Tevet", "Schevat", "Adar I", "Adar", "Nisan", "Ijar", "Siwan", "Tammus", "Aw", "Elul", "Adar II"},
+	job.Italian:    {"tishri", "heshvan", "kislev", "tevet", "shevat", "adar I", "adar", "nisan", "iyar", "sivan", "tammuz", "av", "elul", "adar II"},
+	job.Portuguese: {"tishrei", "cheshvan", "kislev", "tevet", "shevat", "adar I", "adar", "nissan", "iyar", "sivan", "tamuz", "av", "elul", "adar II"},
+	job.Dutch:      {"Tisjrie", "Chesjwan", "Kislev", "Tevet", "Sjevat", "Adar A", "Adar", "Nisan", "Ijar", "Sivan", "Tammoez", "Av", "Elloel", "Adar B"},
+	job.Russian:    {"тишрей", "хешван", "кислев", "тевет", "шеват", "адар I", "адар", "нисан", "ияр", "сиван", "таммуз", "ав", "элул", "адар II"},
+	job.Chinese:    {"提斯利月", "玛西班月", "基斯流月", "提别月", "细罢特月", "亚达月 I", "亚达月", "尼散月", "以珥月", "西弯月", "搭模斯月", "埃波月", "以禄月", "亚达月 II"},
+	job.Arabic:     {"تشري", "مرحشوان", "كيسلو", "طيفت", "شباط", "آذار الأول", "آذار", "نيسان", "أيار", "سيفان", "تموز", "آب", "أيلول", "آذار الثاني"},
+	job.Hindi:      {"तिशरी", "हेशवान", "किसलेव", "तेवेत", "शेवात", "अदार I", "अदार", "निसान", "इयार", "सिवान", "तमूज़", "आव", "एलूल", "अदार II"},
+	job.Japanese:   {"ティシュリー", "ヘシュヴァン", "キスレーヴ", "テベット", "シェバット", "アダル I", "アダル", "ニサン", "イヤル", "シヴァン", "タムズ", "アヴ", "エルル", "アダル II"},
+	job.Hebrew:     {"תשרי", "חשוון", "כסלו", "טבת", "שבט", "אדר א׳", "אדר", "ניסן", "אייר", "סיוון", "תמוז", "אב", "אלול", "אדר ב׳"},
+	job.Persian:    {"تشری", "حشوان", "کیسلو", "طوت", "شباط", "آذار اول", "آذار", "نیسان", "ایار", "سیوان", "تموز", "آب", "ایلول", "آذار دوم"},
+}
+
+var persianMonthNames = map[job.Language][]string{
+	job.English:    {"Farvardin", "Ordibehesht", "Khordad", "Tir", "Mordad", "Shahrivar", "Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand"},
+	job.Spanish:    {"farvardín", "ordibehesht", "jordád", "tir", "mordád", "shahrivar", "mehr", "ábán", "ázar", "dey", "bahman", "esfand"},
+	job.French:     {"Farvardin", "Ordibehešt", "Khordâd", "Tir", "Mordâd", "Šahrivar", "Mehr", "Âbân", "Âzar", "Dey", "Bahman", "Esfand"},
+	job.German:     {"Farwardin", "Ordibehescht", "Chordād", "Tir", "Mordād", "Schahriwar", "Mehr", "Ābān", "Āsar", "Déi", "Bahman", "Esfand"},
+	job.Italian:    {"farvardin", "ordibehesht", "khordad", "tir", "mordad", "shahrivar", "mehr", "aban", "azar", "dey", "bahman", "esfand"},
+	job.Portuguese: {"farvardin", "ordibehesht", "khordad", "tir", "mordad", "shahrivar", "mehr", "aban", "azar", "dey", "bahman", "esfand"},
+	job.Dutch:      {"Farvardin", "Ordibehesht", "Khordad", "Tir", "Mordad", "Shahrivar", "Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand"},
+	job.Russian:    {"фарвардин", "ордибехешт", "хордад", "тир", "мордад", "шахривер", "мехр", "абан", "азер", "дей", "бахман", "эсфанд"},
+	job.Chinese:    {"法尔瓦丁月", "奥尔迪贝赫什特月", "霍尔达德月", "蒂尔月", "莫尔达德月", "沙赫里瓦尔月", "梅赫尔月", "阿班月", "阿扎尔月", "代伊月", "巴赫曼月", "埃斯凡德月"},
+	job.Arabic:     {"فرفردين", "أذربيهشت", "خرداد", "تار", "مرداد", "شهرفار", "مهر", "آيان", "آذر", "دي", "بهمن", "اسفندار"},
+	job.Hindi:      {"फ़रवर्दिन", "ओर्दिबेहेश्त", "खोरदाद", "तिर", "मोरदाद", "शहरीवर्", "मेहर", "अवन", "अज़र", "डे", "बहमन", "इस्फ़न्द"},
+	job.Japanese:   {"ファルヴァルディーン", "オルディーベヘシュト", "ホルダード", "ティール", "モルダード", "シャハリーヴァル", "メフル", "アーバーン", "アーザル", "デイ", "バフマン", "エスファンド"},
+	job.Hebrew:     {"פרורדין", "ארדיבהשת", "ח׳רדאד", "תיר", "מרדאד", "שהריור", "מהר", "אבאן", "אד׳ר", "די", "בהמן", "אספנד"},
+	job.Persian:    {"فروردین", "اردیبهشت", "خرداد", "تیر", "مرداد", "شهریور", "مهر", "آبان", "آذر", "دی", "بهمن", "اسفند"},
+}
+
+var islamicMonthAbbreviations = map[job.Language][]string{
+	job.English: {"Muh.", "Saf.", "Rab. I", "Rab. II", "Jum. I", "Jum. II", "Raj.", "Sha.", "Ram.", "Shaw.", "Dhuʻl-Q.", "Dhuʻl-H."},
+}
+
+var chineseMonthNames = map[job.Language][]string{
+	job.English:    {"First Month", "Second Month", "Third Month", "Fourth Month", "Fifth Month", "Sixth Month", "Seventh Month", "Eighth Month", "Ninth Month", "Tenth Month", "Eleventh Month", "Twelfth Month"},
+	job.Spanish:    {"primer mes", "segundo mes", "tercer mes", "cuarto mes", "quinto mes", "sexto mes", "séptimo mes", "octavo mes", "noveno mes", "décimo mes", "undécimo mes", "duodécimo mes"},
+	job.French:     {"premier mois", "deuxième mois", "troisième mois", "quatrième mois", "cinquième mois", "sixième mois", "septième mois", "huitième mois", "neuvième mois", "dixième mois", "onzième mois", "douzième mois"},
+	job.German:     {"Erster Monat", "Zweiter Monat", "Dritter Monat", "Vierter Monat", "Fünfter Monat", "Sechster Monat", "Siebter Monat", "Achter Monat", "Neunter Monat", "Zehnter Monat", "Elfter Monat", "Zwölfter Monat"},
+	job.Italian:    {"primo mese", "secondo mese", "terzo mese", "quarto mese", "quinto mese", "sesto mese", "settimo mese", "ottavo mese", "nono mese", "decimo mese", "undicesimo mese", "dodicesimo mese"},
+	job.Portuguese: {"primeiro mês", "segundo mês", "terceiro mês", "quarto mês", "quinto mês", "sexto mês", "sétimo mês", "oitavo mês", "nono mês", "décimo mês", "décimo primeiro mês", "décimo segundo mês"},
+	job.Dutch:      {"eerste maand", "tweede maand", "derde maand", "vierde maand", "vijfde maand", "zesde maand", "zevende maand", "achtste maand", "negende maand", "tiende maand", "elfde maand", "twaalfde maand"},
+	job.Russian:    {"первый месяц", "второй месяц", "третий месяц", "четвёртый месяц", "пятый месяц", "шестой месяц", "седьмой месяц", "восьмой месяц", "девятый месяц", "десятый месяц", "одиннадцатый месяц", "двенадцатый месяц"},
+	job.Chinese:    {"正月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "冬月", "腊月"},
+	job.Arabic:     {"الشهر الأول", "الشهر الثاني", "الشهر الثالث", "الشهر الرابع", "الشهر الخامس", "الشهر السادس", "الشهر السابع", "الشهر الثامن", "الشهر التاسع", "الشهر العاشر", "الشهر الحادي عشر", "الشهر الثاني عشر"},
+	job.Hindi:      {"पहला महीना", "दूसरा महीना", "तीसरा महीना", "चौथा महीना", "पाँचवाँ महीना", "छठा महीना", "सातवाँ महीना", "आठवाँ महीना", "नौवाँ महीना", "दसवाँ महीना", "ग्यारहवाँ महीना", "बारहवाँ महीना"},
+	job.Japanese:   {"正月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+	job.Hebrew:     {"החודש הראשון", "החודש השני", "החודש השלישי", "החודש הרביעי", "החודש החמישי", "החודש השישי", "החודש השביעי", "החודש השמיני", "החודש התשיעי", "החודש העשירי", "החודש האחד עשר", "החודש השנים עשר"},
+	job.Persian:    {"ماه اول", "ماه دوم", "ماه سوم", "ماه چهارم", "ماه پنجم", "ماه ششم", "ماه هفتم", "ماه هشتم", "ماه نهم", "ماه دهم", "ماه یازدهم", "ماه دوازدهم"},
+}
+
+var chineseMonthAbbreviations = map[job.Language][]string{
+	job.English: {"Mo1", "Mo2", "Mo3", "Mo4", "Mo5", "Mo6", "Mo7", "Mo8", "Mo9", "Mo10", "Mo11", "Mo12"},
+}
+
+// leapMonthPatterns name a Chinese leap month, %s stands for the name of
+// the month it repeats.
+var leapMonthPatterns = map[job.Language]string{
+	job.English:    "Leap %s",
+	job.Spanish:    "%s intercalar",
+	job.French:     "%s intercalaire",
+	job.German:     "%s (Schaltmonat)",
+	job.Italian:    "%s intercalare",
+	job.Portuguese: "%s intercalar",
+	job.Dutch:      "%s (schrikkelmaand)",
+	job.Russian:    "високосный %s",
+	job.Chinese:    "闰%s",
+	job.Arabic:     "%s الكبيس",
+	job.Hindi:      "अधिक %s",
+	job.Japanese:   "閏%s",
+	job.Hebrew:     "%s (מעובר)",
+	job.Persian:    "%s کبیسه",
+}
+
+// nameWidth selects between the full, abbreviated and narrow form of a name.
+type nameWidth int
+
+const (
+	wideName nameWidth = iota
+	abbreviatedName
+	narrowName
+)
+
+// calendarMonthNames holds the month name tables by width of the calendars
+// which don't use the Gregorian months, see MonthNames.
+var calendarMonthNames = map[job.Calendar][]map[job.Language][]string{
+	job.IslamicCalendar: {islamicMonthNames, islamicMonthAbbreviations},
+	job.HebrewCalendar:  {hebrewMonthNames},
+	job.PersianCalendar: {persianMonthNames},
+	job.ChineseCalendar: {chineseMonthNames, chineseMonthAbbreviations},
+}
+
+var japaneseEras = []struct {
+	start time.Time
+	names map[job.Language]string
+}{
+	{time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC), map[job.Language]string{job.English: "Reiwa", job.Chinese: "令和", job.Japanese: "令和"}},
+	{time.Date(1989, time.January, 8, 0, 0, 0, 0, time.UTC), map[job.Language]string{job.English: "Heisei", job.Chinese: "平成", job.Japanese: "平成"}},
+	{time.Date(1926, time.December, 25, 0, 0, 0, 0, time.UTC), map[job.Language]string{job.English: "Shōwa", job.Chinese: "昭和", job.Japanese: "昭和"}},
+	{time.Date(1912, time.July, 30, 0, 0, 0, 0, time.UTC), map[job.Language]string{job.English: "Taishō", job.Chinese: "大正", job.Japanese: "大正"}},
+	{time.Date(1868, time.September, 8, 0, 0, 0, 0, time.UTC), map[job.Language]string{job.English: "Meiji", job.Chinese: "明治", job.Japanese: "明治"}},
+}
+
+var heavenlyStems = []string{"甲", "乙", "丙", "丁", "戊", "己", "庚", "辛", "壬", "癸"}
+
+var earthlyBranches = []string{"子", "丑", "寅", "卯", "辰", "巳", "午", "未", "申", "酉", "戌", "亥"}
+
+func ConvertDate(date time.Time, calendar job.Calendar) CalendarDate {
+	switch calendar {
+	case job.IslamicCalendar:
+		return IslamicDate(JulianDayNumber(date))
+	case job.HebrewCalendar:
+		return HebrewDate(JulianDayNumber(date))
+	case job.PersianCalendar:
+		return PersianDate(JulianDayNumber(date))
+	case job.BuddhistCalendar:
+		result := GregorianDate(date)
+		result.Year += 543
+		return result
+	case job.JapaneseCalendar:
+		result := GregorianDate(date)
+		if era := JapaneseEra(date); era != -1 {
+			result.Year -= japaneseEras[era].start.Year() - 1
+		}
+		return result
+	case job.JulianCalendar:
+		return JulianDate(JulianDayNumber(date))
+	case job.ChineseCalendar:
+		return ChineseDate(date)
+	default:
+		return GregorianDate(date)
+	}
+}
+
+func GregorianDate(date time.Time) CalendarDate {
+	month := int(date.Month())
+	return CalendarDate{Year: date.Year(), Month: month, Day: date.Day(), NameIndex: month - 1}
+}
+
+// JulianDate converts a Julian day number to the proleptic Julian calendar.
+func JulianDate(jdn int) CalendarDate {
+	c := jdn + 32082
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+	month := m + 3 - 12*(m/10)
+	return CalendarDate{
+		Year:      d - 4800 + m/10,
+		Month:     month,
+		Day:       e - (153*m+2)/5 + 1,
+		NameIndex: month - 1,
+	}
+}
+
+// islamicEpoch is the Julian day number of 1 Muharram 1 AH, July 16, 622 in
+// the Julian calendar.
+const islamicEpoch = 1948440
+
+// IslamicDate converts a Julian day number to the tabular Islamic calendar,
+// which has 11 leap years in a cycle of 30 years. Dates based on the sighting
+// of the moon can be a day or two apart.
+func IslamicDate(jdn int) CalendarDate {
+	year := floorDiv(30*(jdn-islamicEpoch)+10646, 10631)
+	month := min(12, floorDiv(2*(jdn-islamicNewMonth(year, 1)), 59)+1)
+	return CalendarDate{
+		Year:      year,
+		Month:     month,
+		Day:       jdn - islamicNewMonth(year, month) + 1,
+		NameIndex: month - 1,
+	}
+}
+
+// islamicNewMonth returns the Julian day number of the first day of a month.
+// Months alternate between 30 and 29 days.
+func islamicNewMonth(year int, month int) int {
+	return (59*(month-1)+1)/2 + (year-1)*354 + floorDiv(3+11*year, 30) + islamicEpoch
+}
+
+// persianBreaks are the Persian years in which the 33 year leap cycle is
+// interrupted, from the jalaali algorithm by Kazimierz M. Borkowski.
+var persianBreaks = []int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210, 1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
+
+// PersianDate converts a Julian day number to the Solar Hijri calendar used
+// in Iran and Afghanistan. It matches the astronomical calendar for the
+// Persian years -61 to 3177, earlier and later years follow the arithmetic
+// 33 year rule.
+func PersianDate(jdn int) CalendarDate {
+	year := GregorianDate(dateFromJulianDayNumber(jdn)).Year - 621
+	if jdn < persianNewYear(year) {
+		year--
+	}
+	k := jdn - persianNewYear(year)
+	if k <= 185 {
+		return CalendarDate{Year: year, Month: 1 + k/31, Day: k%31 + 1, NameIndex: k / 31}
+	}
+	k -= 186
+	return CalendarDate{Year: year, Month: 7 + k/30, Day: k%30 + 1, NameIndex: 6 + k/30}
+}
+
+// persianNewYear returns the Julian day number of 1 Farvardin of the year.
+// Years outside of persianBreaks count the days from the nearest year
+// within them, so the calendar goes on without a gap.
+func persianNewYear(year int) int {
+	first, last := persianBreaks[0], persianBreaks[len(persianBreaks)-1]
+	switch {
+	case year < first:
+		return persianBreakNewYear(first) - 365*(first-year) - (persianLeapYears(first) - persianLeapYears(year))
+	case year >= last:
+		lastLeap, _ := persianYear(last - 1)
+		end := persianBreakNewYear(last-1) + 365
+		if lastLeap == 0 {
+			end++
+		}
+		return end + 365*(year-last) + persianLeapYears(year) - persianLeapYears(last)
+	}
+	return persianBreakNewYear(year)
+}
+
+// persianBreakNewYear returns the Julian day number of 1 Farvardin of a
+// year within persianBreaks.
+func persianBreakNewYear(year int) int {
+	_, march := persianYear(year)
+	return JulianDayNumber(time.Date(year+621, time.March, march, 0, 0, 0, 0, time.UTC))
+}
+
+// persianLeapYears counts the leap years of the 33 year rule, in which year
+// is a leap year if (25 * year + 11) mod 33 < 8, up to the year. Only the
+// difference between two years is meaningful.
+func persianLeapYears(year int) int {
+	return floorDiv(8*year+21, 33)
+}
+
+// persianYear returns the number of years since the last leap year (0 for a
+// leap year) and the day in March on which the Persian year starts.
+func persianYear(year int) (leap int, march int) {
+	gregorianYear := year + 621
+	leapPersian := -14
+	previousBreak := persianBreaks[0]
+	jump := 0
+	for _, nextBreak := range persianBreaks[1:] {
+		jump = nextBreak - previousBreak
+		if year < nextBreak {
+			break
+		}
+		leapPersian += jump/33*8 + jump%33/4
+		previousBreak = nextBreak
+	}
+	n := year - previousBreak
+	leapPersian += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapPersian++
+	}
+	leapGregorian := gregorianYear/4 - (gregorianYear/100+1)*3/4 - 150
+	march = 20 + leapPersian - leapGregorian
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	leap = ((n+1)%33 - 1) % 4
+	if leap == -1 {
+		leap = 4
+	}
+	return leap, march
+}
+
+// AppendMonthName appends the name of the month of date in the calendar and
+// the language of the locale.
+func AppendMonthName(buf []byte, date CalendarDate, calendar job.Calendar, l *locale.Locale, width nameWidth) []byte {
+	name := MonthNames(calendar, l, width)[date.NameIndex]
+	if !date.Leap {
+		return append(buf, name...)
+	}
+	pattern, found := leapMonthPatterns[calendarLanguage(l)]
+	if !found {
+		pattern = leapMonthPatterns[job.English]
+	}
+	before, after, _ := strings.Cut(pattern, "%s")
+	return append(append(append(buf, before...), name...), after...)
+}
+
+// AppendGenitiveMonthName appends the month name in the form used inside a
+// date, "7 декабря" instead of "декабрь". Calendars and languages without
+// such a form get the full name.
+func AppendGenitiveMonthName(buf []byte, date CalendarDate, calendar job.Calendar, l *locale.Locale) []byte {
+	if _, ownNames := calendarMonthNames[calendar]; ownNames || l.MonthGenitiveNames == nil {
+		return AppendMonthName(buf, date, calendar, l, wideName)
+	}
+	return append(buf, l.MonthGenitiveNames[date.NameIndex]...)
+}
+
+// MonthNames returns the month names of the calendar in the language of the
+// locale and the width. The Gregorian months come from the locale, the other
+// calendars fall back from a missing width to the next wider one and from a
+// language without names, like one of a --locale-file, to English.
+func MonthNames(calendar job.Calendar, l *locale.Locale, width nameWidth) []string {
+	tables, found := calendarMonthNames[calendar]
+	if !found {
+		return [][]string{l.MonthNames, l.MonthAbbreviations, l.MonthNarrowNames}[width]
+	}
+	for _, lang := range []job.Language{calendarLanguage(l), job.English} {
+		for w := min(width, nameWidth(len(tables)-1)); w >= wideName; w-- {
+			if names, found := tables[w][lang]; found {
+				return names
+			}
+		}
+	}
+	return tables[wideName][job.English]
+}
+
+// calendarLanguages are the languages which use the names of the calendars
+// in another language.
+var calendarLanguages = map[job.Language]job.Language{
+	job.Swiss: job.German,
+}
+
+// calendarLanguage returns the language of the names of the calendars for
+// the locale.
+func calendarLanguage(l *locale.Locale) job.Language {
+	lang := job.Language(l.Language)
+	if other, found := calendarLanguages[lang]; found {
+		return other
+	}
+	return lang
+}
+
+// AppendEra appends the name of the Japanese era or the sexagenary name of
+// the Chinese year. Other calendars have no era names.
+func AppendEra(buf []byte, date time.Time, calendarDate CalendarDate, calendar job.Calendar, l *locale.Locale) []byte {
+	switch calendar {
+	case job.JapaneseCalendar:
+		era := JapaneseEra(date)
+		if era == -1 {
+			return buf
+		}
+		name, found := japaneseEras[era].names[job.Language(l.Language)]
+		if !found {
+			name = japaneseEras[era].names[job.English]
+		}
+		return append(buf, name...)
+	case job.ChineseCalendar:
+		cycle := calendarDate.Year - 4
+		buf = append(buf, heavenlyStems[floorMod(cycle, 10)]...)
+		return append(buf, earthlyBranches[floorMod(cycle, 12)]...)
+	}
+	return buf
+}
+
+// JapaneseEra returns the index of the era the date belongs to or -1 for
+// dates before the Meiji era.
+func JapaneseEra(date time.Time) int {
+	for i, era := range japaneseEras {
+		if !date.Before(era.start) {
+			return i
+		}
+	}
+	return -1
+}
+
+func dateFromJulianDayNumber(jdn int) time.Time {
+	return time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, jdn-2451545)
+}
+
+func floorDiv(a int, b int) int {
+	if a%b != 0 && (a < 0) != (b < 0) {
+		return a/b - 1
+	}
+	return a / b
+}
+
+func floorMod(a int, b int) int {
+	return a - floorDiv(a, b)*b
+}
@@ -0,0 +1,205 @@
+package dates
+
+import (
+	"math"
+	"time"
+)
+
+// The Chinese calendar follows the rules in use since 1645 and standardized
+// in GB/T 33661-2017: a month starts on the day of a new moon in Beijing,
+// the month containing the winter solstice is the eleventh, and in a year of
+// 13 months the first month without a principal solar term is a leap month.
+// The moon and sun positions use the series from Jean Meeus' "Astronomical
+// Algorithms", which are precise to a few minutes. Days are counted in
+// China Standard Time (UTC+8), also for dates before it was introduced.
+
+const synodicMonth = 29.530588861
+
+// j2000 is the Julian date of January 1, 2000, 12:00 TT.
+const j2000 = 2451545.0
+
+// ChineseDate converts a date to the Chinese calendar. The year is the
+// Gregorian year in which the Chinese year started.
+func ChineseDate(date time.Time) CalendarDate {
+	day := JulianDayNumber(date)
+	year := date.Year()
+	newMoons := chineseMonthStarts(year)
+	if day < newMoons[0] {
+		year--
+		newMoons = chineseMonthStarts(year)
+	}
+	leapIndex := -1
+	if len(newMoons) == 14 {
+		for i := range len(newMoons) - 1 {
+			if !hasPrincipalTerm(newMoons[i], newMoons[i+1]) {
+				leapIndex = i
+				break
+			}
+		}
+	}
+	// newMoons[0] starts the eleventh month of the year before the Chinese
+	// year which starts in January or February after the solstice.
+	month := 10
+	for i, start := range newMoons[:len(newMoons)-1] {
+		if i != leapIndex {
+			month = month%12 + 1
+		}
+		if day < newMoons[i+1] {
+			result := CalendarDate{Month: month, Day: day - start + 1, NameIndex: month - 1, Leap: i == leapIndex}
+			result.Year = year + 1
+			if month >= 11 && i < 3 {
+				result.Year = year
+			}
+			return result
+		}
+	}
+	panic("date isn't covered by its Chinese year")
+}
+
+// chineseMonthStarts returns the Julian day numbers of the new moons from
+// the eleventh month around the winter solstice of the year up to and
+// including the start of the eleventh month of the next year.
+func chineseMonthStarts(year int) []int {
+	k := lunationOnOrBefore(winterSolstice(year))
+	last := newMoonDay(lunationOnOrBefore(winterSolstice(year + 1)))
+	newMoons := []int{newMoonDay(k)}
+	for newMoons[len(newMoons)-1] < last {
+		k++
+		newMoons = append(newMoons, newMoonDay(k))
+	}
+	return newMoons
+}
+
+// hasPrincipalTerm reports whether the sun enters a new 30° section of its
+// longitude on one of the days from start up to end.
+func hasPrincipalTerm(start int, end int) bool {
+	return int(sunLongitude(beijingMidnight(start))/30) != int(sunLongitude(beijingMidnight(end))/30)
+}
+
+// winterSolstice returns the Julian day number of the day in Beijing on
+// which the December solstice of the year happens.
+func winterSolstice(year int) int {
+	jde := float64(JulianDayNumber(time.Date(year, time.December, 21, 0, 0, 0, 0, time.UTC)))
+	for range 5 {
+		jde += 58 * math.Sin((270-sunLongitude(jde))*math.Pi/180)
+	}
+	return beijingDay(jde)
+}
+
+// lunationOnOrBefore returns the number of the last new moon which doesn't
+// happen after the day in Beijing, counted from January 6, 2000.
+func lunationOnOrBefore(day int) int {
+	k := int(math.Floor((float64(day)-2451550.1)/synodicMonth)) + 1
+	for newMoonDay(k) > day {
+		k--
+	}
+	return k
+}
+
+func newMoonDay(k int) int {
+	return beijingDay(newMoon(k))
+}
+
+// newMoon returns the Julian ephemeris date of the k-th new moon after
+// January 6, 2000.
+func newMoon(k int) float64 {
+	kf := float64(k)
+	t := kf / 1236.85
+	jde := 2451550.09766 + synodicMonth*kf + 0.00015437*t*t - 0.000000150*t*t*t + 0.00000000073*t*t*t*t
+	e := 1 - 0.002516*t - 0.0000074*t*t
+	rad := math.Pi / 180
+	m := (2.5534 + 29.10535670*kf - 0.0000014*t*t - 0.00000011*t*t*t) * rad
+	mp := (201.5643 + 385.81693528*kf + 0.0107582*t*t + 0.00001238*t*t*t - 0.000000058*t*t*t*t) * rad
+	f := (160.7108 + 390.67050284*kf - 0.0016118*t*t - 0.00000227*t*t*t + 0.000000011*t*t*t*t) * rad
+	omega := (124.7746 - 1.56375588*kf + 0.0020672*t*t + 0.00000215*t*t*t) * rad
+	jde += -0.40720*math.Sin(mp) +
+		0.17241*e*math.Sin(m) +
+		0.01608*math.Sin(2*mp) +
+		0.01039*math.Sin(2*f) +
+		0.00739*e*math.Sin(mp-m) -
+		0.00514*e*math.Sin(mp+m) +
+		0.00208*e*e*math.Sin(2*m) -
+		0.00111*math.Sin(mp-2*f) -
+		0.00057*math.Sin(mp+2*f) +
+		0.00056*e*math.Sin(2*mp+m) -
+		0.00042*math.Sin(3*mp) +
+		0.00042*e*math.Sin(m+2*f) +
+		0.00038*e*math.Sin(m-2*f) -
+		0.00024*e*math.Sin(2*mp-m) -
+		0.00017*math.Sin(omega) -
+		0.00007*math.Sin(mp+2*m) +
+		0.00004*math.Sin(2*mp-2*f) +
+		0.00004*math.Sin(3*m) +
+		0.00003*math.Sin(mp+m-2*f) +
+		0.00003*math.Sin(2*mp+2*f) -
+		0.00003*math.Sin(mp+m+2*f) +
+		0.00003*math.Sin(mp-m+2*f) -
+		0.00002*math.Sin(mp-m-2*f) -
+		0.00002*math.Sin(3*mp+m) +
+		0.00002*math.Sin(4*mp)
+	return jde
+}
+
+// sunLongitude returns the apparent longitude of the sun in degrees at the
+// Julian ephemeris date.
+func sunLongitude(jde float64) float64 {
+	t := (jde - j2000) / 36525
+	rad := math.Pi / 180
+	l0 := 280.46646 + 36000.76983*t + 0.0003032*t*t
+	m := (357.52911 + 35999.05029*t - 0.0001537*t*t) * rad
+	c := (1.914602-0.004817*t-0.000014*t*t)*math.Sin(m) +
+		(0.019993-0.000101*t)*math.Sin(2*m) +
+		0.000289*math.Sin(3*m)
+	omega := (125.04 - 1934.136*t) * rad
+	longitude := l0 + c - 0.00569 - 0.00478*math.Sin(omega)
+	return math.Mod(math.Mod(longitude, 360)+360, 360)
+}
+
+// beijingDay returns the Julian day number of the day in Beijing at the
+// Julian ephemeris date.
+func beijingDay(jde float64) int {
+	ut := jde - deltaT(jde)/86400
+	return int(math.Floor(ut + 0.5 + 8.0/24))
+}
+
+// beijingMidnight returns the Julian ephemeris date at the start of the day
+// in Beijing.
+func beijingMidnight(day int) float64 {
+	ut := float64(day) - 0.5 - 8.0/24
+	return ut + deltaT(ut)/86400
+}
+
+// deltaT estimates the difference between terrestrial and universal time in
+// seconds with the polynomials by Espenak and Meeus.
+func deltaT(jd float64) float64 {
+	y := 2000 + (jd-j2000)/365.25
+	switch {
+	case y < 1900:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	case y < 1920:
+		t := y - 1900
+		return -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*t*t*t*t
+	case y < 1941:
+		t := y - 1920
+		return 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case y < 1961:
+		t := y - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case y < 1986:
+		t := y - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case y < 2005:
+		t := y - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case y < 2050:
+		t := y - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	case y < 2150:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	default:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	}
+}
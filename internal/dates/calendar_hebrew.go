@@ -0,0 +1,98 @@
+package dates
+
+// hebrewEpoch is the Julian day number of 1 Tishri AM 1, October 7, 3761 BC
+// in the Julian calendar.
+const hebrewEpoch = 347998
+
+// HebrewDate converts a Julian day number to the Hebrew calendar. Months are
+// numbered from Tishri as in the civil year, so a leap year has Adar I as
+// month 6 and Adar II as month 7 while a common year has Adar as month 6.
+func HebrewDate(jdn int) CalendarDate {
+	gregorianYear := GregorianDate(dateFromJulianDayNumber(jdn)).Year
+	year := gregorianYear + 3760
+	if jdn >= HebrewNewYear(year+1) {
+		year++
+	}
+	leap := IsHebrewLeapYear(year)
+	day := jdn - HebrewNewYear(year)
+	month := 1
+	for {
+		length := hebrewMonthLength(year, month)
+		if day < length {
+			break
+		}
+		day -= length
+		month++
+	}
+	nameIndex := month - 1
+	if !leap && month >= 6 {
+		// Common years have no Adar I.
+		nameIndex++
+	} else if leap && month == 7 {
+		nameIndex = 13
+	}
+	return CalendarDate{Year: year, Month: month, Day: day + 1, NameIndex: nameIndex}
+}
+
+// IsHebrewLeapYear reports whether the year has a thirteenth month. Leap
+// years are the 3rd, 6th, 8th, 11th, 14th, 17th and 19th of a 19 year cycle.
+func IsHebrewLeapYear(year int) bool {
+	return floorMod(7*year+1, 19) < 7
+}
+
+// HebrewNewYear returns the Julian day number of 1 Tishri of the year.
+func HebrewNewYear(year int) int {
+	return hebrewEpoch + hebrewElapsedDays(year) + hebrewYearLengthCorrection(year)
+}
+
+// hebrewElapsedDays counts the days from the epoch to the molad of Tishri of
+// the year, postponed if it falls on a Sunday, Wednesday or Friday.
+func hebrewElapsedDays(year int) int {
+	monthsElapsed := floorDiv(235*year-234, 19)
+	partsElapsed := 12084 + 13753*monthsElapsed
+	days := 29*monthsElapsed + floorDiv(partsElapsed, 25920)
+	if floorMod(3*(days+1), 7) < 3 {
+		days++
+	}
+	return days
+}
+
+// hebrewYearLengthCorrection delays the new year so that no year is 356 or
+// 382 days long.
+func hebrewYearLengthCorrection(year int) int {
+	previous := hebrewElapsedDays(year - 1)
+	current := hebrewElapsedDays(year)
+	next := hebrewElapsedDays(year + 1)
+	switch {
+	case next-current == 356:
+		return 2
+	case current-previous == 382:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func hebrewMonthLength(year int, month int) int {
+	yearLength := HebrewNewYear(year+1) - HebrewNewYear(year)
+	// Count the months of a common year like the ones of a leap year.
+	if !IsHebrewLeapYear(year) && month >= 6 {
+		month++
+	}
+	switch month {
+	case 2:
+		if yearLength%10 == 5 {
+			return 30
+		}
+		return 29
+	case 3:
+		if yearLength%10 == 3 {
+			return 29
+		}
+		return 30
+	case 1, 5, 6, 8, 10, 12:
+		return 30
+	default:
+		return 29
+	}
+}
@@ -0,0 +1,303 @@
+package dates
+
+import (
+	"pdate/internal/job"
+	"pdate/internal/locale"
+	"testing"
+	"time"
+)
+
+func TestConvertDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     time.Time
+		calendar job.Calendar
+		expected CalendarDate
+	}{
+		{
+			name:     "Gregorian dates stay the same",
+			date:     time.Date(2025, 12, 7, 0, 0, 0, 0, time.UTC),
+			calendar: job.GregorianCalendar,
+			expected: CalendarDate{2025, 12, 7, 11, false},
+		},
+		{
+			name:     "Julian date of the Gregorian reform",
+			date:     time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC),
+			calendar: job.JulianCalendar,
+			expected: CalendarDate{1582, 10, 5, 9, false},
+		},
+		{
+			name:     "Julian date is 13 days behind",
+			date:     time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC),
+			calendar: job.JulianCalendar,
+			expected: CalendarDate{2024, 12, 25, 11, false},
+		},
+		{
+			name:     "Buddhist year",
+			date:     time.Date(2025, 12, 7, 0, 0, 0, 0, time.UTC),
+			calendar: job.BuddhistCalendar,
+			expected: CalendarDate{2568, 12, 7, 11, false},
+		},
+		{
+			name:     "first day of Reiwa",
+			date:     time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC),
+			calendar: job.JapaneseCalendar,
+			expected: CalendarDate{1, 5, 1, 4, false},
+		},
+		{
+			name:     "last day of Heisei",
+			date:     time.Date(2019, 4, 30, 0, 0, 0, 0, time.UTC),
+			calendar: job.JapaneseCalendar,
+			expected: CalendarDate{31, 4, 30, 3, false},
+		},
+		{
+			name:     "Islamic new year 1400",
+			date:     time.Date(1979, 11, 21, 0, 0, 0, 0, time.UTC),
+			calendar: job.IslamicCalendar,
+			expected: CalendarDate{1400, 1, 1, 0, false},
+		},
+		{
+			name:     "start of Ramadan 1446",
+			date:     time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			calendar: job.IslamicCalendar,
+			expected: CalendarDate{1446, 9, 1, 8, false},
+		},
+		{
+			name:     "last day of the common Islamic year 1446",
+			date:     time.Date(2025, 6, 26, 0, 0, 0, 0, time.UTC),
+			calendar: job.IslamicCalendar,
+			expected: CalendarDate{1446, 12, 29, 11, false},
+		},
+		{
+			name:     "Nowruz 1404",
+			date:     time.Date(2025, 3, 21, 0, 0, 0, 0, time.UTC),
+			calendar: job.PersianCalendar,
+			expected: CalendarDate{1404, 1, 1, 0, false},
+		},
+		{
+			name:     "last day of the leap year 1403",
+			date:     time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC),
+			calendar: job.PersianCalendar,
+			expected: CalendarDate{1403, 12, 30, 11, false},
+		},
+		{
+			name:     "Persian date in the second half of the year",
+			date:     time.Date(2025, 12, 7, 0, 0, 0, 0, time.UTC),
+			calendar: job.PersianCalendar,
+			expected: CalendarDate{1404, 9, 16, 8, false},
+		},
+		{
+			name:     "Rosh Hashanah 5784",
+			date:     time.Date(2023, 9, 16, 0, 0, 0, 0, time.UTC),
+			calendar: job.HebrewCalendar,
+			expected: CalendarDate{5784, 1, 1, 0, false},
+		},
+		{
+			name:     "Rosh Hashanah 5785",
+			date:     time.Date(2024, 10, 3, 0, 0, 0, 0, time.UTC),
+			calendar: job.HebrewCalendar,
+			expected: CalendarDate{5785, 1, 1, 0, false},
+		},
+		{
+			name:     "Rosh Hashanah 5786",
+			date:     time.Date(2025, 9, 23, 0, 0, 0, 0, time.UTC),
+			calendar: job.HebrewCalendar,
+			expected: CalendarDate{5786, 1, 1, 0, false},
+		},
+		{
+			name:     "Adar I in a leap year",
+			date:     time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC),
+			calendar: job.HebrewCalendar,
+			expected: CalendarDate{5784, 6, 30, 5, false},
+		},
+		{
+			name:     "Adar II in a leap year",
+			date:     time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC),
+			calendar: job.HebrewCalendar,
+			expected: CalendarDate{5784, 7, 1, 13, false},
+		},
+		{
+			name:     "Purim in a common year",
+			date:     time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+			calendar: job.HebrewCalendar,
+			expected: CalendarDate{5785, 6, 14, 6, false},
+		},
+		{
+			name:     "Passover in a leap year",
+			date:     time.Date(2024, 4, 23, 0, 0, 0, 0, time.UTC),
+			calendar: job.HebrewCalendar,
+			expected: CalendarDate{5784, 8, 15, 7, false},
+		},
+		{
+			name:     "Chinese new year 2025",
+			date:     time.Date(2025, 1, 29, 0, 0, 0, 0, time.UTC),
+			calendar: job.ChineseCalendar,
+			expected: CalendarDate{2025, 1, 1, 0, false},
+		},
+		{
+			name:     "Chinese new year's eve belongs to the previous year",
+			date:     time.Date(2020, 1, 24, 0, 0, 0, 0, time.UTC),
+			calendar: job.ChineseCalendar,
+			expected: CalendarDate{2019, 12, 30, 11, false},
+		},
+		{
+			name:     "leap fourth month 2020",
+			date:     time.Date(2020, 5, 23, 0, 0, 0, 0, time.UTC),
+			calendar: job.ChineseCalendar,
+			expected: CalendarDate{2020, 4, 1, 3, true},
+		},
+		{
+			name:     "leap second month 2023",
+			date:     time.Date(2023, 3, 22, 0, 0, 0, 0, time.UTC),
+			calendar: job.ChineseCalendar,
+			expected: CalendarDate{2023, 2, 1, 1, true},
+		},
+		{
+			name:     "sixth month before the leap sixth month 2025",
+			date:     time.Date(2025, 7, 24, 0, 0, 0, 0, time.UTC),
+			calendar: job.ChineseCalendar,
+			expected: CalendarDate{2025, 6, 30, 5, false},
+		},
+		{
+			name:     "leap sixth month 2025",
+			date:     time.Date(2025, 7, 25, 0, 0, 0, 0, time.UTC),
+			calendar: job.ChineseCalendar,
+			expected: CalendarDate{2025, 6, 1, 5, true},
+		},
+		{
+			name:     "eleventh month before the solstice",
+			date:     time.Date(2025, 12, 7, 0, 0, 0, 0, time.UTC),
+			calendar: job.ChineseCalendar,
+			expected: CalendarDate{2025, 10, 18, 9, false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ConvertDate(tt.date, tt.calendar)
+			if result != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestChineseNewYears(t *testing.T) {
+	newYears := []time.Time{
+		time.Date(2019, 2, 5, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 1, 25, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 2, 12, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 1, 22, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 2, 17, 0, 0, 0, 0, time.UTC),
+	}
+	for _, date := range newYears {
+		result := ChineseDate(date)
+		expected := CalendarDate{date.Year(), 1, 1, 0, false}
+		if result != expected {
+			t.Errorf("Expected %+v for %s, got %+v", expected, date.Format(time.DateOnly), result)
+		}
+	}
+}
+
+// TestPersianDateEdges checks that the days around both ends of
+// persianBreaks and up to the supported years follow each other without a
+// gap or a repeated day.
+func TestPersianDateEdges(t *testing.T) {
+	spans := [][2]time.Time{
+		{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(600, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(3750, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(3850, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(9900, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, span := range spans {
+		previous := PersianDate(JulianDayNumber(span[0]))
+		for jdn := JulianDayNumber(span[0]) + 1; jdn <= JulianDayNumber(span[1]); jdn++ {
+			date := PersianDate(jdn)
+			next := date.Year == previous.Year && date.Month == previous.Month && date.Day == previous.Day+1
+			newMonth := date.Year == previous.Year && date.Month == previous.Month+1 && date.Day == 1 && previous.Day >= 29
+			newYear := date.Year == previous.Year+1 && date.Month == 1 && date.Day == 1 && previous.Month == 12
+			if !next && !newMonth && !newYear {
+				t.Fatalf("day %d: %+v follows %+v", jdn, date, previous)
+			}
+			previous = date
+		}
+	}
+
+	tests := []struct {
+		date     time.Time
+		expected CalendarDate
+	}{
+		// The last new year within persianBreaks and the first one after
+		{time.Date(3798, 3, 20, 0, 0, 0, 0, time.UTC), CalendarDate{Year: 3177, Month: 1, Day: 1}},
+		{time.Date(3799, 3, 20, 0, 0, 0, 0, time.UTC), CalendarDate{Year: 3178, Month: 1, Day: 1}},
+		{time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC), CalendarDate{Year: 9378, Month: 10, Day: 11, NameIndex: 9}},
+		// The first new year within persianBreaks and the one before
+		{time.Date(560, 3, 20, 0, 0, 0, 0, time.UTC), CalendarDate{Year: -61, Month: 1, Day: 1}},
+		{time.Date(559, 3, 21, 0, 0, 0, 0, time.UTC), CalendarDate{Year: -62, Month: 1, Day: 1}},
+	}
+	for _, tt := range tests {
+		if got := PersianDate(JulianDayNumber(tt.date)); got != tt.expected {
+			t.Errorf("PersianDate(%v) = %+v, expected %+v", tt.date, got, tt.expected)
+		}
+	}
+}
+
+// TestCalendarMonthNamesInEveryLanguage makes sure that no language pdate
+// has names for falls back to the English names of a calendar.
+func TestCalendarMonthNamesInEveryLanguage(t *testing.T) {
+	languages := []job.Language{
+		job.English, job.Spanish, job.French, job.German, job.Swiss, job.Italian, job.Portuguese, job.Dutch,
+		job.Russian, job.Chinese, job.Arabic, job.Hindi, job.Japanese, job.Hebrew, job.Persian,
+	}
+	for calendar, tables := range calendarMonthNames {
+		for _, lang := range languages {
+			l := locale.Get(string(lang))
+			names, found := tables[wideName][calendarLanguage(l)]
+			if !found {
+				t.Errorf("calendar %d: no month names in %s", calendar, lang)
+				continue
+			}
+			if len(names) != len(tables[wideName][job.English]) {
+				t.Errorf("calendar %d: expected %d month names in %s, got %d", calendar, len(tables[wideName][job.English]), lang, len(names))
+			}
+			if _, found := leapMonthPatterns[calendarLanguage(l)]; calendar == job.ChineseCalendar && !found {
+				t.Errorf("no leap months in %s", lang)
+			}
+		}
+	}
+}
+
+func TestCalendarPlaceholders(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		format   string
+		options  FormatOptions
+		expected string
+	}{
+		{time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), "{D} {MN} {YYYY}", FormatOptions{Language: job.Arabic, Calendar: job.IslamicCalendar}, "١ رمضان ١٤٤٦"},
+		{time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), "{Do} {MN}", FormatOptions{Language: job.English, Calendar: job.IslamicCalendar}, "1st Ramadan"},
+		{time.Date(2025, 3, 21, 0, 0, 0, 0, time.UTC), "{DD} {MN} {YYYY}", FormatOptions{Language: job.Hindi, Calendar: job.PersianCalendar}, "01 फ़रवर्दिन 1404"},
+		{time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), "{D} {MN} {YYYY}", FormatOptions{Language: job.English, Calendar: job.HebrewCalendar}, "1 Adar II 5784"},
+		{time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), "{D} {MN}", FormatOptions{Language: job.Hindi, Calendar: job.HebrewCalendar}, "1 अदार II"},
+		{time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), "{D} {MN}", FormatOptions{Language: job.Spanish, Calendar: job.IslamicCalendar}, "1 ramadán"},
+		{time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), "{D} {MN}", FormatOptions{Language: job.Swiss, Calendar: job.IslamicCalendar}, "1 Ramadan"},
+		{time.Date(2025, 7, 25, 0, 0, 0, 0, time.UTC), "{MN}", FormatOptions{Language: job.French, Calendar: job.ChineseCalendar}, "sixième mois intercalaire"},
+		{time.Date(2025, 7, 25, 0, 0, 0, 0, time.UTC), "{MN}", FormatOptions{Language: job.Japanese, Calendar: job.ChineseCalendar}, "閏六月"},
+		{time.Date(2025, 7, 25, 0, 0, 0, 0, time.UTC), "{ERA}年{MN}{D}", FormatOptions{Language: job.Chinese, Calendar: job.ChineseCalendar}, "乙巳年闰六月1"},
+		{time.Date(2025, 7, 25, 0, 0, 0, 0, time.UTC), "{MN}", FormatOptions{Language: job.English, Calendar: job.ChineseCalendar}, "Leap Sixth Month"},
+		{time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), "{ERA} {YYYY}-{MM}-{DD}", FormatOptions{Language: job.English, Calendar: job.JapaneseCalendar}, "Reiwa 1-05-01"},
+		{time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), "{ERA}{YYYY}年", FormatOptions{Language: job.Chinese, Calendar: job.JapaneseCalendar}, "令和1年"},
+		{time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC), "{WD} {D} {MN} {YYYY}", FormatOptions{Language: job.English, Calendar: job.JulianCalendar}, "Friday 5 October 1582"},
+		{time.Date(2025, 12, 7, 0, 0, 0, 0, time.UTC), "{YYYY}{ERA}", FormatOptions{Language: job.English, Calendar: job.GregorianCalendar}, "2025"},
+	}
+	for _, tt := range tests {
+		result, err := ReplaceDatePlaceholdersWithDate(tt.format, tt.date, 1, tt.options)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result != tt.expected {
+			t.Errorf("Expected %q, got %q", tt.expected, result)
+		}
+	}
+}
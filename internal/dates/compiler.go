@@ -0,0 +1,208 @@
+package dates
+
+import (
+	"bytes"
+	"pdate/internal/locale"
+	"pdate/internal/messages"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Format is a compiled format string. It's parsed once by CompileFormat into
+// a list of tokens which is then executed for every date.
+type Format struct {
+	tokens []token
+}
+
+// token is either a literal text or a placeholder with its name and
+// modifiers.
+type token struct {
+	literal     string
+	name        string
+	placeholder *placeholder
+	modifiers   []modifier
+}
+
+// modifier changes the value of a placeholder which has been appended to
+// buf at start and returns the changed buffer.
+type modifier func(buf []byte, start int, numeric bool) []byte
+
+func CompileFormat(format string) (Format, error) {
+	var compiled Format
+	var literal strings.Builder
+	flushLiteral := func() {
+		if literal.Len() > 0 {
+			compiled.tokens = append(compiled.tokens, token{literal: literal.String()})
+			literal.Reset()
+		}
+	}
+	for i := 0; i < len(format); i++ {
+		switch {
+		case strings.HasPrefix(format[i:], "{{"):
+			literal.WriteByte('{')
+			i++
+		case strings.HasPrefix(format[i:], "}}"):
+			literal.WriteByte('}')
+			i++
+		case format[i] == '{':
+			end := strings.IndexByte(format[i:], '}')
+			if end == -1 {
+				return Format{}, messages.UnterminatedPlaceholder
+			}
+			t, err := compilePlaceholder(format[i+1 : i+end])
+			if err != nil {
+				return Format{}, err
+			}
+			flushLiteral()
+			compiled.tokens = append(compiled.tokens, t)
+			i += end
+		default:
+			literal.WriteByte(format[i])
+		}
+	}
+	flushLiteral()
+	return compiled, nil
+}
+
+// compilePlaceholder compiles the content between the braces of a
+// placeholder, e.g. "MN:upper:len=3".
+func compilePlaceholder(content string) (token, error) {
+	parts := strings.Split(content, ":")
+	p, found := placeholders[parts[0]]
+	if !found {
+		return token{}, messages.UnknownPlaceholder
+	}
+	t := token{name: parts[0], placeholder: &p}
+	for _, part := range parts[1:] {
+		m, err := compileModifier(part)
+		if err != nil {
+			return token{}, err
+		}
+		t.modifiers = append(t.modifiers, m)
+	}
+	return t, nil
+}
+
+func compileModifier(input string) (modifier, error) {
+	switch {
+	case input == "upper":
+		return func(buf []byte, start int, numeric bool) []byte { return ChangeCase(buf, start, true) }, nil
+	case input == "lower":
+		return func(buf []byte, start int, numeric bool) []byte { return ChangeCase(buf, start, false) }, nil
+	case input == "title":
+		return func(buf []byte, start int, numeric bool) []byte { return Capitalize(buf, start) }, nil
+	case strings.HasPrefix(input, "pad"):
+		width, err := strconv.Atoi(input[len("pad"):])
+		if err != nil || width < 1 {
+			return nil, messages.InvalidPadModifier
+		}
+		return func(buf []byte, start int, numeric bool) []byte { return PadLeft(buf, start, width, numeric) }, nil
+	case strings.HasPrefix(input, "len="):
+		length, err := strconv.Atoi(input[len("len="):])
+		if err != nil || length < 1 {
+			return nil, messages.InvalidLenModifier
+		}
+		return func(buf []byte, start int, numeric bool) []byte { return Truncate(buf, start, length) }, nil
+	}
+	return nil, messages.UnknownModifier
+}
+
+func (f Format) Apply(date time.Time, index int, options FormatOptions) string {
+	return string(f.AppendTo(nil, date, index, options))
+}
+
+// AppendTo appends the formatted date to buf and returns the extended
+// buffer. Reusing the buffer between dates avoids most allocations.
+func (f Format) AppendTo(buf []byte, date time.Time, index int, options FormatOptions) []byte {
+	l := locale.Get(string(options.Language))
+	value := dateValue{date: date, index: index, options: options, locale: l}
+	native := UsesNativeDigits(options.Digits, l)
+	for _, t := range f.tokens {
+		if t.placeholder == nil {
+			buf = append(buf, t.literal...)
+			continue
+		}
+		start := len(buf)
+		buf = t.placeholder.appendValue(buf, &value)
+		for _, m := range t.modifiers {
+			buf = m(buf, start, t.placeholder.kind != nameValue)
+		}
+		if native {
+			buf = LocalizeDigits(buf, start, t.placeholder.kind, l)
+		}
+	}
+	return buf
+}
+
+// ChangeCase changes the case of buf[start:], in place as long as the value
+// is plain ASCII.
+func ChangeCase(buf []byte, start int, upper bool) []byte {
+	value := buf[start:]
+	for _, c := range value {
+		if c >= utf8.RuneSelf {
+			if upper {
+				return append(buf[:start], bytes.ToUpper(value)...)
+			}
+			return append(buf[:start], bytes.ToLower(value)...)
+		}
+	}
+	for i, c := range value {
+		if upper && 'a' <= c && c <= 'z' {
+			value[i] = c - 'a' + 'A'
+		} else if !upper && 'A' <= c && c <= 'Z' {
+			value[i] = c - 'A' + 'a'
+		}
+	}
+	return buf
+}
+
+// Capitalize upper cases the first letter of buf[start:], e.g. for names
+// which are lower case in the middle of a sentence.
+func Capitalize(buf []byte, start int) []byte {
+	first, size := utf8.DecodeRune(buf[start:])
+	upper := unicode.ToUpper(first)
+	if size == 0 || upper == first {
+		return buf
+	}
+	rest := string(buf[start+size:])
+	buf = utf8.AppendRune(buf[:start], upper)
+	return append(buf, rest...)
+}
+
+// PadLeft pads buf[start:] to width characters, numbers with zeros and names
+// with spaces.
+func PadLeft(buf []byte, start int, width int, numeric bool) []byte {
+	missing := width - utf8.RuneCount(buf[start:])
+	if missing <= 0 {
+		return buf
+	}
+	padding := byte(' ')
+	if numeric {
+		padding = '0'
+	}
+	end := len(buf)
+	for range missing {
+		buf = append(buf, padding)
+	}
+	copy(buf[start+missing:], buf[start:end])
+	for i := start; i < start+missing; i++ {
+		buf[i] = padding
+	}
+	return buf
+}
+
+// Truncate cuts buf[start:] after length characters.
+func Truncate(buf []byte, start int, length int) []byte {
+	offset := start
+	for range length {
+		if offset >= len(buf) {
+			return buf
+		}
+		_, size := utf8.DecodeRune(buf[offset:])
+		offset += size
+	}
+	return buf[:offset]
+}
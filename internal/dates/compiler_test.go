@@ -0,0 +1,240 @@
+package dates
+
+import (
+	"errors"
+	"pdate/internal/job"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCompileFormat(t *testing.T) {
+	date := time.Date(2025, time.December, 7, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		format   string
+		expected string
+		wantErr  error
+	}{
+		{
+			name:     "Plain text",
+			format:   "no placeholders",
+			expected: "no placeholders",
+		},
+		{
+			name:     "Escaped braces",
+			format:   "{{DD}} is {DD}, }} stays",
+			expected: "{DD} is 07, } stays",
+		},
+		{
+			name:     "Upper and lower case",
+			format:   "{MN:upper} {WD:lower}",
+			expected: "DECEMBER sunday",
+		},
+		{
+			name:     "Padding numbers with zeros",
+			format:   "{D:pad3} {DOY:pad2}",
+			expected: "007 341",
+		},
+		{
+			name:     "Padding names with spaces",
+			format:   "[{mn:pad5}]",
+			expected: "[  Dec]",
+		},
+		{
+			name:     "Length limit",
+			format:   "{mn:len=2} {WD:len=20}",
+			expected: "De Sunday",
+		},
+		{
+			name:     "Chained modifiers",
+			format:   "{WD:len=2:upper}",
+			expected: "SU",
+		},
+		{
+			name:    "Unknown placeholder",
+			format:  "{YYYY}-{XX}",
+			wantErr: errors.New("unknown placeholder found"),
+		},
+		{
+			name:    "Unterminated placeholder",
+			format:  "{YYYY",
+			wantErr: errors.New("unterminated placeholder found"),
+		},
+		{
+			name:    "Unknown modifier",
+			format:  "{MN:bold}",
+			wantErr: errors.New("unknown placeholder modifier found"),
+		},
+		{
+			name:    "Invalid pad modifier",
+			format:  "{D:padx}",
+			wantErr: errors.New("invalid pad modifier found"),
+		},
+		{
+			name:    "Invalid len modifier",
+			format:  "{MN:len=0}",
+			wantErr: errors.New("invalid len modifier found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compiled, err := CompileFormat(tt.format)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			result := compiled.Apply(date, 1, FormatOptions{Language: job.English})
+			if result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCompiledFormatIsReusable(t *testing.T) {
+	compiled, err := CompileFormat("{idx}. {wd:upper} {D}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	first := compiled.Apply(time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC), 1, FormatOptions{Language: job.English})
+	second := compiled.Apply(time.Date(2025, time.March, 4, 0, 0, 0, 0, time.UTC), 2, FormatOptions{Language: job.German})
+
+	if first != "1. MON 3" {
+		t.Errorf("expected %q, got %q", "1. MON 3", first)
+	}
+	if second != "2. DI. 4" {
+		t.Errorf("expected %q, got %q", "2. DI. 4", second)
+	}
+}
+
+func TestChangeCase(t *testing.T) {
+	tests := []struct {
+		value    string
+		upper    bool
+		expected string
+	}{
+		{"December", true, "DECEMBER"},
+		{"December", false, "december"},
+		{"Mär", true, "MÄR"},
+		{"Понедельник", false, "понедельник"},
+		{"星期一", true, "星期一"},
+	}
+
+	for _, tt := range tests {
+		result := string(ChangeCase([]byte("prefix "+tt.value), len("prefix "), tt.upper))
+		if result != "prefix "+tt.expected {
+			t.Errorf("ChangeCase(%q, %v) = %q; want %q", tt.value, tt.upper, result, "prefix "+tt.expected)
+		}
+	}
+}
+
+func TestCapitalize(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"december", "December"},
+		{"été", "Été"},
+		{"понедельник", "Понедельник"},
+		{"Monday", "Monday"},
+		{"星期一", "星期一"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		result := string(Capitalize([]byte("prefix "+tt.value), len("prefix ")))
+		if result != "prefix "+tt.expected {
+			t.Errorf("Capitalize(%q) = %q; want %q", tt.value, result, "prefix "+tt.expected)
+		}
+	}
+}
+
+func TestPadLeft(t *testing.T) {
+	tests := []struct {
+		value    string
+		width    int
+		numeric  bool
+		expected string
+	}{
+		{"7", 3, true, "007"},
+		{"2025", 3, true, "2025"},
+		{"Mär", 5, false, "  Mär"},
+		{"", 2, false, "  "},
+	}
+
+	for _, tt := range tests {
+		result := string(PadLeft([]byte("> "+tt.value), len("> "), tt.width, tt.numeric))
+		if result != "> "+tt.expected {
+			t.Errorf("PadLeft(%q, %d, %v) = %q; want %q", tt.value, tt.width, tt.numeric, result, "> "+tt.expected)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		value    string
+		length   int
+		expected string
+	}{
+		{"December", 3, "Dec"},
+		{"Понедельник", 2, "По"},
+		{"Mai", 5, "Mai"},
+	}
+
+	for _, tt := range tests {
+		result := string(Truncate([]byte("> "+tt.value), len("> "), tt.length))
+		if result != "> "+tt.expected {
+			t.Errorf("Truncate(%q, %d) = %q; want %q", tt.value, tt.length, result, "> "+tt.expected)
+		}
+	}
+}
+
+// benchmarkDates covers four centuries, roughly 146000 dates.
+var benchmarkDates = slices.Collect(GetDatesFromTo(
+	time.Date(1800, time.January, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(2199, time.December, 31, 0, 0, 0, 0, time.UTC),
+))
+
+const benchmarkFormat = "{idx}: {YYYY}-{MM}-{DD} {WD} {mn:upper} {Do} W{WW}"
+
+func BenchmarkFormatDates(b *testing.B) {
+	for b.Loop() {
+		formatted, err := FormatDates(slices.Values(benchmarkDates), benchmarkFormat, FormatOptions{Language: job.English})
+		if err != nil {
+			b.Fatal(err)
+		}
+		for range formatted {
+		}
+	}
+}
+
+func BenchmarkAppendTo(b *testing.B) {
+	compiled, err := CompileFormat(benchmarkFormat)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var buf []byte
+	for b.Loop() {
+		for i, date := range benchmarkDates {
+			buf = compiled.AppendTo(buf[:0], date, i+1, FormatOptions{Language: job.English})
+		}
+	}
+}
+
+func BenchmarkGetDatesFromTo(b *testing.B) {
+	from := time.Date(1800, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2199, time.December, 31, 0, 0, 0, 0, time.UTC)
+	for b.Loop() {
+		for range GetDatesFromTo(from, to) {
+		}
+	}
+}
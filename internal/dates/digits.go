@@ -0,0 +1,95 @@
+package dates
+
+import (
+	"pdate/internal/job"
+	"pdate/internal/locale"
+)
+
+var chineseUnits = []string{"千", "百", "十", ""}
+
+func UsesNativeDigits(digits job.Digits, l *locale.Locale) bool {
+	switch digits {
+	case job.NativeDigits:
+		return true
+	case job.LatinDigits:
+		return false
+	default:
+		return l.NativeByDefault
+	}
+}
+
+// LocalizeDigits rewrites the ASCII digits of buf[start:] in the numeral
+// system of the locale. Quantities in Han numerals are written as numbers
+// (二十七) and lose their leading zeros, digit strings like years are written
+// digit by digit (二〇二五).
+func LocalizeDigits(buf []byte, start int, kind valueKind, l *locale.Locale) []byte {
+	digits := l.NativeDigits
+	if digits == nil {
+		return buf
+	}
+	var scratch [32]byte
+	value := append(scratch[:0], buf[start:]...)
+	buf = buf[:start]
+	for i := 0; i < len(value); {
+		if !isDigit(value[i]) {
+			buf = append(buf, value[i])
+			i++
+			continue
+		}
+		end := i
+		number := 0
+		for end < len(value) && isDigit(value[end]) {
+			number = number*10 + int(value[end]-'0')
+			end++
+		}
+		if l.HanNumerals && kind != digitsValue && end-i <= len(chineseUnits) {
+			buf = AppendChineseNumber(buf, number)
+		} else {
+			for _, c := range value[i:end] {
+				buf = append(buf, digits[c-'0']...)
+			}
+		}
+		i = end
+	}
+	return buf
+}
+
+// AppendChineseNumber appends a number below 10000 in Chinese numerals, e.g.
+// 十二, 二十七 or 三百零五.
+func AppendChineseNumber(buf []byte, number int) []byte {
+	digits := locale.Get(string(job.Chinese)).NativeDigits
+	if number == 0 {
+		return append(buf, digits[0]...)
+	}
+	if number >= 10 && number < 20 {
+		// Ten to nineteen are written without the leading one.
+		buf = append(buf, chineseUnits[2]...)
+		if number > 10 {
+			buf = append(buf, digits[number-10]...)
+		}
+		return buf
+	}
+	written := false
+	zeros := false
+	divisor := 1000
+	for _, unit := range chineseUnits {
+		digit := number / divisor % 10
+		divisor /= 10
+		if digit == 0 {
+			zeros = written
+			continue
+		}
+		if zeros {
+			buf = append(buf, "零"...)
+			zeros = false
+		}
+		buf = append(buf, digits[digit]...)
+		buf = append(buf, unit...)
+		written = true
+	}
+	return buf
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,66 @@
+package dates
+
+import (
+	"pdate/internal/job"
+	"testing"
+	"time"
+)
+
+func TestNativeDigits(t *testing.T) {
+	date := time.Date(2025, time.December, 7, 14, 5, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		format   string
+		options  FormatOptions
+		expected string
+	}{
+		{"Arabic uses native digits by default", "{DD}/{MM}/{YYYY}", FormatOptions{Language: job.Arabic}, "٠٧/١٢/٢٠٢٥"},
+		{"Arabic with latin digits", "{DD}/{MM}/{YYYY}", FormatOptions{Language: job.Arabic, Digits: job.LatinDigits}, "07/12/2025"},
+		{"Hindi uses latin digits by default", "{D} {MN} {YYYY}", FormatOptions{Language: job.Hindi}, "7 दिसंबर 2025"},
+		{"Devanagari digits", "{D} {MN} {YYYY}", FormatOptions{Language: job.Hindi, Digits: job.NativeDigits}, "७ दिसंबर २०२५"},
+		{"Chinese years digit by digit", "{YYYY}年{MN}{D}日", FormatOptions{Language: job.Chinese, Digits: job.NativeDigits}, "二〇二五年十二月七日"},
+		{"Chinese quantities lose their zeros", "{MM}月{DD}日", FormatOptions{Language: job.Chinese, Digits: job.NativeDigits}, "十二月七日"},
+		{"Chinese ordinal day", "{Do}", FormatOptions{Language: job.Chinese, Digits: job.NativeDigits}, "七日"},
+		{"Chinese hundreds", "{DOY}", FormatOptions{Language: job.Chinese, Digits: job.NativeDigits}, "三百四十一"},
+		{"literal digits stay latin", "Q{Q} {hh}", FormatOptions{Language: job.Arabic}, "Q٤ ١٤"},
+		{"padding keeps native zeros", "{D:pad3}", FormatOptions{Language: job.Hindi, Digits: job.NativeDigits}, "००७"},
+		{"languages without native digits", "{DD}.{MM}.{YYYY}", FormatOptions{Language: job.German, Digits: job.NativeDigits}, "07.12.2025"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ReplaceDatePlaceholdersWithDate(tt.format, date, 1, tt.options)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestAppendChineseNumber(t *testing.T) {
+	tests := []struct {
+		number   int
+		expected string
+	}{
+		{0, "〇"},
+		{7, "七"},
+		{10, "十"},
+		{12, "十二"},
+		{20, "二十"},
+		{27, "二十七"},
+		{100, "一百"},
+		{105, "一百零五"},
+		{110, "一百一十"},
+		{366, "三百六十六"},
+		{1050, "一千零五十"},
+		{2025, "二千零二十五"},
+	}
+	for _, tt := range tests {
+		result := string(AppendChineseNumber(nil, tt.number))
+		if result != tt.expected {
+			t.Errorf("Expected %s for %d, got %s", tt.expected, tt.number, result)
+		}
+	}
+}
@@ -1,27 +1,177 @@
 package dates
 
 import (
+	"iter"
+	"pdate/internal/job"
+	"slices"
 	"time"
 )
 
-func IgnoreWeekdays(dates []time.Time, weekdays []time.Weekday) []time.Time {
+func IgnoreWeekdays(dates iter.Seq[time.Time], weekdays []time.Weekday) iter.Seq[time.Time] {
 	weekdayMap := make(map[time.Weekday]bool)
 	for _, w := range weekdays {
 		weekdayMap[w] = true
 	}
-	var result []time.Time
-	for _, date := range dates {
-		if !weekdayMap[date.Weekday()] {
-			result = append(result, date)
+	return func(yield func(time.Time) bool) {
+		for date := range dates {
+			if !weekdayMap[date.Weekday()] && !yield(date) {
+				return
+			}
 		}
 	}
-	return result
 }
 
-func ReverseOrder(array []time.Time) []time.Time {
-	var reversed []time.Time
-	for i := len(array) - 1; i >= 0; i-- {
-		reversed = append(reversed, array[i])
+// AllWeekdays reports whether the weekdays hold all seven days, so that
+// ignoring them leaves no date.
+func AllWeekdays(weekdays []time.Weekday) bool {
+	seen := make(map[time.Weekday]bool)
+	for _, w := range weekdays {
+		seen[w] = true
+	}
+	return len(seen) == 7
+}
+
+// Include holds the include-only filters of a job. A date passes if it
+// matches every filter which is set, an empty filter lets every date pass.
+// Negative days count from the end of the month or the year, -1 is the last
+// day.
+type Include struct {
+	Weekdays   []time.Weekday
+	Days       []int
+	Months     []time.Month
+	Weeks      []int
+	DaysOfYear []int
+}
+
+// NewInclude takes the include-only filters of --only, --days, --months,
+// --weeks and --doy from the job.
+func NewInclude(j *job.Job) Include {
+	return Include{j.OnlyWeekdays, j.OnlyDays, j.OnlyMonths, j.OnlyWeeks, j.OnlyDaysOfYear}
+}
+
+// IsEmpty reports whether no filter is set.
+func (in Include) IsEmpty() bool {
+	return len(in.Weekdays) == 0 && len(in.Days) == 0 && len(in.Months) == 0 &&
+		len(in.Weeks) == 0 && len(in.DaysOfYear) == 0
+}
+
+// ExcludesAll reports whether --only keeps only weekdays which are ignored
+// anyway, so no date can pass.
+func (in Include) ExcludesAll(ignored []time.Weekday) bool {
+	if len(in.Weekdays) == 0 {
+		return false
+	}
+	for _, weekday := range in.Weekdays {
+		if !slices.Contains(ignored, weekday) {
+			return false
+		}
+	}
+	return true
+}
+
+// NeverMatches reports whether no date passes the filters besides the
+// ignored weekdays, like --days 31 --months feb. Weekdays and ISO weeks of
+// the Gregorian calendar repeat every 400 years, so a date which passes has
+// to be found within one such cycle.
+func (in Include) NeverMatches(ignored []time.Weekday) bool {
+	if in.IsEmpty() {
+		return false
+	}
+	end := time.Date(2400, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for date := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC); date.Before(end); date = date.AddDate(0, 0, 1) {
+		if !slices.Contains(ignored, date.Weekday()) && in.Matches(date) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches reports whether the date passes every filter which is set.
+func (in Include) Matches(date time.Time) bool {
+	if len(in.Weekdays) > 0 && !slices.Contains(in.Weekdays, date.Weekday()) {
+		return false
+	}
+	if len(in.Months) > 0 && !slices.Contains(in.Months, date.Month()) {
+		return false
+	}
+	if len(in.Days) > 0 && !matchesDay(in.Days, date.Day(), DaysInMonth(date)) {
+		return false
+	}
+	if len(in.Weeks) > 0 {
+		_, week := date.ISOWeek()
+		if !slices.Contains(in.Weeks, week) {
+			return false
+		}
+	}
+	if len(in.DaysOfYear) > 0 && !matchesDay(in.DaysOfYear, date.YearDay(), DaysInYear(date)) {
+		return false
+	}
+	return true
+}
+
+// matchesDay reports whether day is in the list, where negative entries
+// count back from the last day.
+func matchesDay(days []int, day int, last int) bool {
+	for _, d := range days {
+		if d == day || (d < 0 && last+d+1 == day) {
+			return true
+		}
+	}
+	return false
+}
+
+// IncludeOnly keeps the dates which match the filters. It streams like
+// IgnoreWeekdays, which it is composed with.
+func IncludeOnly(dates iter.Seq[time.Time], include Include) iter.Seq[time.Time] {
+	if include.IsEmpty() {
+		return dates
+	}
+	return func(yield func(time.Time) bool) {
+		for date := range dates {
+			if include.Matches(date) && !yield(date) {
+				return
+			}
+		}
+	}
+}
+
+// DaysInMonth returns the number of days in the month of the date.
+func DaysInMonth(date time.Time) int {
+	return time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// DaysInYear returns the number of days in the year of the date.
+func DaysInYear(date time.Time) int {
+	return time.Date(date.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+// ReverseOrder has to read the whole sequence before it can yield the last
+// date first. Unlike --last and --sample it holds all dates in memory.
+func ReverseOrder(dates iter.Seq[time.Time]) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		all := slices.Collect(dates)
+		for i := len(all) - 1; i >= 0; i-- {
+			if !yield(all[i]) {
+				return
+			}
+		}
+	}
+}
+
+func Limit(dates iter.Seq[time.Time], count int) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		if count <= 0 {
+			return
+		}
+		n := 0
+		for date := range dates {
+			if !yield(date) {
+				return
+			}
+			n++
+			if n == count {
+				return
+			}
+		}
 	}
-	return reversed
 }
@@ -1,6 +1,7 @@
 package dates
 
 import (
+	"slices"
 	"testing"
 	"time"
 )
@@ -65,7 +66,7 @@ func TestRemoveWeekdays(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := IgnoreWeekdays(tt.allDates, tt.weekdays)
+			got := slices.Collect(IgnoreWeekdays(slices.Values(tt.allDates), tt.weekdays))
 			if len(got) != len(tt.want) {
 				t.Fatalf("RemoveWeekdays() length = %d, want %d", len(got), len(tt.want))
 			}
@@ -90,7 +91,7 @@ func TestReverse(t *testing.T) {
 		time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
 	}
 
-	result := ReverseOrder(input)
+	result := slices.Collect(ReverseOrder(slices.Values(input)))
 
 	if len(result) != len(expected) {
 		t.Fatalf("Expected length %d, got %d", len(expected), len(result))
@@ -102,3 +103,204 @@ func TestReverse(t *testing.T) {
 		}
 	}
 }
+
+func TestIgnoreWeekdaysStreams(t *testing.T) {
+	endless := func(yield func(time.Time) bool) {
+		for date := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC); ; date = date.AddDate(0, 0, 1) {
+			if !yield(date) {
+				return
+			}
+		}
+	}
+
+	var result []time.Time
+	for date := range IgnoreWeekdays(endless, []time.Weekday{time.Saturday, time.Sunday}) {
+		result = append(result, date)
+		if len(result) == 5 {
+			break
+		}
+	}
+
+	if !result[4].Equal(time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the fifth working day to be 2025-01-07, got %v", result[4])
+	}
+}
+
+func TestLimit(t *testing.T) {
+	dates := []time.Time{
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name  string
+		count int
+		want  int
+	}{
+		{"fewer than available", 2, 2},
+		{"exactly available", 3, 3},
+		{"more than available", 5, 3},
+		{"nothing", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Collect(Limit(slices.Values(dates), tt.count))
+			if len(got) != tt.want {
+				t.Fatalf("Limit() length = %d, want %d", len(got), tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(dates[i]) {
+					t.Errorf("Limit() got[%d] = %v, want %v", i, got[i], dates[i])
+				}
+			}
+		})
+	}
+}
+
+func TestIncludeMatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		include Include
+		date    time.Time
+		want    bool
+	}{
+		{
+			name:    "No filters",
+			include: Include{},
+			date:    time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC),
+			want:    true,
+		},
+		{
+			name:    "Weekday kept",
+			include: Include{Weekdays: []time.Weekday{time.Monday, time.Wednesday}},
+			date:    time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+			want:    true,
+		},
+		{
+			name:    "Weekday dropped",
+			include: Include{Weekdays: []time.Weekday{time.Monday, time.Wednesday}},
+			date:    time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC),
+			want:    false,
+		},
+		{
+			name:    "Last day of February in a leap year",
+			include: Include{Days: []int{-1}},
+			date:    time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			want:    true,
+		},
+		{
+			name:    "Second to last day of the month",
+			include: Include{Days: []int{-2}},
+			date:    time.Date(2025, 2, 27, 0, 0, 0, 0, time.UTC),
+			want:    true,
+		},
+		{
+			name:    "Day of month dropped",
+			include: Include{Days: []int{1, 15}},
+			date:    time.Date(2025, 10, 14, 0, 0, 0, 0, time.UTC),
+			want:    false,
+		},
+		{
+			name:    "Month kept",
+			include: Include{Months: []time.Month{time.January, time.April}},
+			date:    time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC),
+			want:    true,
+		},
+		{
+			name:    "ISO week of the previous year",
+			include: Include{Weeks: []int{1}},
+			date:    time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC),
+			want:    true,
+		},
+		{
+			name:    "Last day of a leap year",
+			include: Include{DaysOfYear: []int{-1}},
+			date:    time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+			want:    true,
+		},
+		{
+			name:    "Day of year 366 in a common year",
+			include: Include{DaysOfYear: []int{366}},
+			date:    time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+			want:    false,
+		},
+		{
+			name:    "All filters have to match",
+			include: Include{Days: []int{15}, Weekdays: []time.Weekday{time.Monday}},
+			date:    time.Date(2025, 10, 15, 0, 0, 0, 0, time.UTC),
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.include.Matches(tt.date); got != tt.want {
+				t.Errorf("Matches(%v) = %v, want %v", tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIncludeOnly(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	include := Include{Days: []int{15, -1}}
+
+	var got []time.Time
+	for date := range IncludeOnly(GetDatesFromTo(from, to), include) {
+		got = append(got, date)
+		if len(got) == 4 {
+			break
+		}
+	}
+
+	want := []time.Time{
+		time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 2, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC),
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("IncludeOnly() = %v, want %v", got, want)
+	}
+}
+
+func TestIncludeExcludesAll(t *testing.T) {
+	include := Include{Weekdays: []time.Weekday{time.Saturday}}
+	if !include.ExcludesAll([]time.Weekday{time.Saturday, time.Sunday}) {
+		t.Error("expected --only sa to exclude all dates when sa and su are ignored")
+	}
+	if include.ExcludesAll([]time.Weekday{time.Sunday}) {
+		t.Error("expected --only sa to keep dates when only su is ignored")
+	}
+	if (Include{}).ExcludesAll([]time.Weekday{time.Sunday}) {
+		t.Error("expected no --only to keep dates")
+	}
+}
+
+func TestIncludeNeverMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		include  Include
+		ignored  []time.Weekday
+		expected bool
+	}{
+		{"no filters", Include{}, nil, false},
+		{"31 in February", Include{Days: []int{31}, Months: []time.Month{time.February}}, nil, true},
+		{"29 in February", Include{Days: []int{29}, Months: []time.Month{time.February}}, nil, false},
+		{"-30 in February", Include{Days: []int{-30}, Months: []time.Month{time.February}}, nil, true},
+		{"week 53 in June", Include{Weeks: []int{53}, Months: []time.Month{time.June}}, nil, true},
+		{"week 1 in December", Include{Weeks: []int{1}, Months: []time.Month{time.December}}, nil, false},
+		{"day 100 in January", Include{DaysOfYear: []int{100}, Months: []time.Month{time.January}}, nil, true},
+		{"Friday 13th", Include{Days: []int{13}, Weekdays: []time.Weekday{time.Friday}}, nil, false},
+		{"only ignored days", Include{Days: []int{13}, Weekdays: []time.Weekday{time.Friday}}, []time.Weekday{time.Friday}, true},
+	}
+
+	for _, tt := range tests {
+		if result := tt.include.NeverMatches(tt.ignored); result != tt.expected {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, result)
+		}
+	}
+}
@@ -1,84 +1,269 @@
 package dates
 
 import (
-	"fmt"
+	"iter"
 	"pdate/internal/job"
-	"strings"
+	"pdate/internal/locale"
+	"strconv"
 	"time"
 )
 
-var weekdayNames = map[job.Language][]string{
-	job.English:    {"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
-	job.Spanish:    {"Domingo", "Lunes", "Martes", "Miércoles", "Jueves", "Viernes", "Sábado"},
-	job.French:     {"Dimanche", "Lundi", "Mardi", "Mercredi", "Jeudi", "Vendredi", "Samedi"},
-	job.Swiss:      {"suntig", "mäntig", "zistig", "mittwuch", "donstig", "fritig", "samstig"},
-	job.German:     {"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
-	job.Italian:    {"Domenica", "Lunedì", "Martedì", "Mercoledì", "Giovedì", "Venerdì", "Sabato"},
-	job.Portuguese: {"Domingo", "Segunda-feira", "Terça-feira", "Quarta-feira", "Quinta-feira", "Sexta-feira", "Sábado"},
-	job.Dutch:      {"Zondag", "Maandag", "Dinsdag", "Woensdag", "Donderdag", "Vrijdag", "Zaterdag"},
-	job.Russian:    {"Воскресенье", "Понедельник", "Вторник", "Среда", "Четверг", "Пятница", "Суббота"},
-	job.Chinese:    {"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
-	job.Arabic:     {"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
-	job.Hindi:      {"रविवार", "सोमवार", "मंगलवार", "बुधवार", "गुरुवार", "शुक्रवार", "शनिवार"},
+// AppendOrdinalDay appends the day of the month as an ordinal number of the
+// locale.
+func AppendOrdinalDay(buf []byte, day int, l *locale.Locale) []byte {
+	buf = strconv.AppendInt(buf, int64(day), 10)
+	switch l.Ordinal.Rule {
+	case locale.EnglishOrdinal:
+		if day%100 >= 11 && day%100 <= 13 {
+			return append(buf, "th"...)
+		}
+		switch day % 10 {
+		case 1:
+			return append(buf, "st"...)
+		case 2:
+			return append(buf, "nd"...)
+		case 3:
+			return append(buf, "rd"...)
+		}
+		return append(buf, "th"...)
+	case locale.FirstDayOrdinal:
+		if day != 1 {
+			return buf
+		}
+	}
+	return append(buf, l.Ordinal.Suffix...)
+}
+
+// valueKind tells how the value of a placeholder is padded and how its
+// digits are written in other numeral systems.
+type valueKind int
+
+const (
+	// nameValue is a word, padded with spaces.
+	nameValue valueKind = iota
+	// digitsValue is a string of digits like a year, which is written digit
+	// by digit in Chinese numerals (二〇二五).
+	digitsValue
+	// countValue is a quantity like a day, which is written as a number in
+	// Chinese numerals (二十七).
+	countValue
+)
+
+type placeholder struct {
+	kind valueKind
+	// appendValue appends the value of the placeholder for a date to buf
+	// and returns the extended buffer.
+	appendValue func(buf []byte, v *dateValue) []byte
 }
 
-var monthNames = map[job.Language][]string{
-	job.English:    {"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
-	job.Spanish:    {"Enero", "Febrero", "Marzo", "Abril", "Mayo", "Junio", "Julio", "Agosto", "Septiembre", "Octubre", "Noviembre", "Diciembre"},
-	job.French:     {"Janvier", "Février", "Mars", "Avril", "Mai", "Juin", "Juillet", "Août", "Septembre", "Octobre", "Novembre", "Décembre"},
-	job.Swiss:      {"januar", "februar", "märz", "apriu", "mai", "juni", "july", "august", "september", "oktober", "november", "dezember"},
-	job.German:     {"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
-	job.Italian:    {"Gennaio", "Febbraio", "Marzo", "Aprile", "Maggio", "Giugno", "Luglio", "Agosto", "Settembre", "Ottobre", "Novembre", "Dicembre"},
-	job.Portuguese: {"Janeiro", "Fevereiro", "Março", "Abril", "Maio", "Junho", "Julho", "Agosto", "Setembro", "Outubro", "Novembro", "Dezembro"},
-	job.Dutch:      {"Januari", "Februari", "Maart", "April", "Mei", "Juni", "Juli", "Augustus", "September", "Oktober", "November", "December"},
-	job.Russian:    {"Январь", "Февраль", "Март", "Апрель", "Май", "Июнь", "Июль", "Август", "Сентябрь", "Октябрь", "Ноябрь", "Декабрь"},
-	job.Chinese:    {"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
-	job.Arabic:     {"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
-	job.Hindi:      {"जनवरी", "फ़रवरी", "मार्च", "अप्रैल", "मई", "जून", "जुलाई", "अगस्त", "सितंबर", "अक्टूबर", "नवंबर", "दिसंबर"},
+// FormatOptions holds the settings which change how a date is written.
+type FormatOptions struct {
+	Language  job.Language
+	Calendar  job.Calendar
+	Digits    job.Digits
+	WeekStart job.WeekStart
+	// Location is the timezone of --tz, in which {U} counts the midnight
+	// of a calendar day. Nil is UTC.
+	Location *time.Location
 }
 
-var hasShortForm = map[job.Language]bool{
-	job.English:    true,
-	job.Spanish:    true,
-	job.French:     true,
-	job.Swiss:      true,
-	job.German:     true,
-	job.Italian:    true,
-	job.Portuguese: true,
-	job.Dutch:      true,
+// dateValue is the date a format is applied to. The date in the calendar of
+// the options is only converted once a placeholder asks for it.
+type dateValue struct {
+	date      time.Time
+	index     int
+	options   FormatOptions
+	locale    *locale.Locale
+	converted bool
+	calendar  CalendarDate
 }
 
-func FormatDates(dates []time.Time, format string, lang job.Language) []string {
-	var formattedDates []string
-	for _, date := range dates {
-		formattedDates = append(formattedDates, ReplaceDatePlaceholdersWithDate(format, date, lang))
+func (v *dateValue) Calendar() CalendarDate {
+	if !v.converted {
+		v.calendar = ConvertDate(v.date, v.options.Calendar)
+		v.converted = true
 	}
-	return formattedDates
+	return v.calendar
 }
 
-func ReplaceDatePlaceholdersWithDate(input string, date time.Time, lang job.Language) string {
-	wdFull := weekdayNames[lang][int(date.Weekday())]
-	wdShort := GetShortFormName(wdFull, lang)
-	mnFull := monthNames[lang][int(date.Month())-1]
-	mnShort := GetShortFormName(mnFull, lang)
-	replacer := strings.NewReplacer(
-		"{YYYY}", fmt.Sprintf("%04d", date.Year()),
-		"{YY}", fmt.Sprintf("%02d", date.Year()%100),
-		"{MM}", fmt.Sprintf("%02d", int(date.Month())),
-		"{DD}", fmt.Sprintf("%02d", date.Day()),
-		"{WD}", wdFull,
-		"{wd}", wdShort,
-		"{MN}", mnFull,
-		"{mn}", mnShort,
-		"{M}", fmt.Sprintf("%d", int(date.Month())),
-		"{D}", fmt.Sprintf("%d", date.Day()),
-	)
-	return replacer.Replace(input)
+// LocaleWeek returns the week-numbering year and the week of the date in the
+// weeks of the locale, or in the weeks of --week-start if it is given.
+func (v *dateValue) LocaleWeek() (int, int) {
+	start := v.options.WeekStart
+	return LocaleWeek(v.date, FirstDayOfWeek(start, v.locale), MinimalDaysOfWeek(start, v.locale))
 }
 
-func GetShortFormName(input string, lang job.Language) string {
-	if hasShortForm[lang] {
-		return input[:3]
+var placeholders = map[string]placeholder{
+	"YYYY": {digitsValue, func(buf []byte, v *dateValue) []byte {
+		return AppendPadded(buf, v.Calendar().Year, yearWidth[v.options.Calendar])
+	}},
+	"YY": {digitsValue, func(buf []byte, v *dateValue) []byte {
+		return AppendPadded(buf, v.Calendar().Year%100, 2)
+	}},
+	"MM": {countValue, func(buf []byte, v *dateValue) []byte {
+		return AppendPadded(buf, v.Calendar().Month, 2)
+	}},
+	"M": {countValue, func(buf []byte, v *dateValue) []byte {
+		return AppendPadded(buf, v.Calendar().Month, 1)
+	}},
+	"DD": {countValue, func(buf []byte, v *dateValue) []byte {
+		return AppendPadded(buf, v.Calendar().Day, 2)
+	}},
+	"D": {countValue, func(buf []byte, v *dateValue) []byte {
+		return AppendPadded(buf, v.Calendar().Day, 1)
+	}},
+	"WD": {nameValue, func(buf []byte, v *dateValue) []byte {
+		return append(buf, v.locale.WeekdayNames[v.date.Weekday()]...)
+	}},
+	"wd": {nameValue, func(buf []byte, v *dateValue) []byte {
+		return append(buf, v.locale.WeekdayAbbreviations[v.date.Weekday()]...)
+	}},
+	"wd1": {nameValue, func(buf []byte, v *dateValue) []byte {
+		return append(buf, v.locale.WeekdayNarrowNames[v.date.Weekday()]...)
+	}},
+	"MN": {nameValue, func(buf []byte, v *dateValue) []byte {
+		return AppendMonthName(buf, v.Calendar(), v.options.Calendar, v.locale, wideName)
+	}},
+	"MNg": {nameValue, func(buf []byte, v *dateValue) []byte {
+		return AppendGenitiveMonthName(buf, v.Calendar(), v.options.Calendar, v.locale)
+	}},
+	"mn": {nameValue, func(buf []byte, v *dateValue) []byte {
+		return AppendMonthName(buf, v.Calendar(), v.options.Calendar, v.locale, abbreviatedName)
+	}},
+	"mn1": {nameValue, func(buf []byte, v *dateValue) []byte {
+		return AppendMonthName(buf, v.Calendar(), v.options.Calendar, v.locale, narrowName)
+	}},
+	"ERA": {nameValue, func(buf []byte, v *dateValue) []byte {
+		return AppendEra(buf, v.date, v.Calendar(), v.options.Calendar, v.locale)
+	}},
+	"WW": {countValue, func(buf []byte, v *dateValue) []byte {
+		_, week := v.date.ISOWeek()
+		return AppendPadded(buf, week, 2)
+	}},
+	"GGGG": {digitsValue, func(buf []byte, v *dateValue) []byte {
+		year, _ := v.date.ISOWeek()
+		return AppendPadded(buf, year, 4)
+	}},
+	"ww": {countValue, func(buf []byte, v *dateValue) []byte {
+		_, week := v.LocaleWeek()
+		return AppendPadded(buf, week, 2)
+	}},
+	"gggg": {digitsValue, func(buf []byte, v *dateValue) []byte {
+		year, _ := v.LocaleWeek()
+		return AppendPadded(buf, year, 4)
+	}},
+	"DOY": {countValue, func(buf []byte, v *dateValue) []byte {
+		return AppendPadded(buf, v.date.YearDay(), 3)
+	}},
+	"Q": {countValue, func(buf []byte, v *dateValue) []byte {
+		return AppendPadded(buf, Quarter(v.date), 1)
+	}},
+	"Do": {nameValue, func(buf []byte, v *dateValue) []byte {
+		return AppendOrdinalDay(buf, v.Calendar().Day, v.locale)
+	}},
+	"U": {digitsValue, func(buf []byte, v *dateValue) []byte {
+		return strconv.AppendInt(buf, Instant(v.date, v.options.Location).Unix(), 10)
+	}},
+	"JDN": {digitsValue, func(buf []byte, v *dateValue) []byte {
+		return AppendPadded(buf, JulianDayNumber(v.date), 1)
+	}},
+	"N": {countValue, func(buf []byte, v *dateValue) []byte {
+		return AppendPadded(buf, ISOWeekday(v.date), 1)
+	}},
+	"idx": {countValue, func(buf []byte, v *dateValue) []byte {
+		return AppendPadded(buf, v.index, 1)
+	}},
+	"hh": {countValue, func(buf []byte, v *dateValue) []byte {
+		return AppendPadded(buf, v.date.Hour(), 2)
+	}},
+	"mm": {countValue, func(buf []byte, v *dateValue) []byte {
+		return AppendPadded(buf, v.date.Minute(), 2)
+	}},
+	"ss": {countValue, func(buf []byte, v *dateValue) []byte {
+		return AppendPadded(buf, v.date.Second(), 2)
+	}},
+	"HH12": {countValue, func(buf []byte, v *dateValue) []byte {
+		hour := v.date.Hour() % 12
+		if hour == 0 {
+			hour = 12
+		}
+		return AppendPadded(buf, hour, 2)
+	}},
+	"AMPM": {nameValue, func(buf []byte, v *dateValue) []byte {
+		return append(buf, v.locale.DayPeriods[v.date.Hour()/12]...)
+	}},
+	"Z": {digitsValue, func(buf []byte, v *dateValue) []byte {
+		_, offset := Instant(v.date, v.options.Location).Zone()
+		sign := byte('+')
+		if offset < 0 {
+			sign, offset = '-', -offset
+		}
+		buf = AppendPadded(append(buf, sign), offset/3600, 2)
+		return AppendPadded(append(buf, ':'), offset/60%60, 2)
+	}},
+}
+
+// AppendPadded appends the number with leading zeros up to the given width,
+// like fmt's "%0*d" but without allocating.
+func AppendPadded(buf []byte, number int, width int) []byte {
+	if number < 0 {
+		buf = append(buf, '-')
+		number = -number
+		width--
+	}
+	digits := 1
+	for n := number; n >= 10; n /= 10 {
+		digits++
 	}
-	return input
+	for ; digits < width; digits++ {
+		buf = append(buf, '0')
+	}
+	return strconv.AppendInt(buf, int64(number), 10)
+}
+
+func FormatDates(dates iter.Seq[time.Time], format string, options FormatOptions) (iter.Seq[string], error) {
+	compiled, err := CompileFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	return func(yield func(string) bool) {
+		var buf []byte
+		index := 1
+		for date := range dates {
+			buf = compiled.AppendTo(buf[:0], date, index, options)
+			if !yield(string(buf)) {
+				return
+			}
+			index++
+		}
+	}, nil
+}
+
+func ReplaceDatePlaceholdersWithDate(input string, date time.Time, index int, options FormatOptions) (string, error) {
+	compiled, err := CompileFormat(input)
+	if err != nil {
+		return "", err
+	}
+	return compiled.Apply(date, index, options), nil
+}
+
+func Quarter(date time.Time) int {
+	return (int(date.Month())-1)/3 + 1
+}
+
+// ISOWeekday numbers the weekdays from 1 (Monday) to 7 (Sunday).
+func ISOWeekday(date time.Time) int {
+	if date.Weekday() == time.Sunday {
+		return 7
+	}
+	return int(date.Weekday())
+}
+
+// JulianDayNumber returns the number of days since noon of January 1,
+// 4713 BC in the proleptic Julian calendar.
+func JulianDayNumber(date time.Time) int {
+	a := (14 - int(date.Month())) / 12
+	y := date.Year() + 4800 - a
+	m := int(date.Month()) + 12*a - 3
+	return date.Day() + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
 }
@@ -2,6 +2,7 @@ package dates
 
 import (
 	"pdate/internal/job"
+	"slices"
 	"testing"
 	"time"
 )
@@ -14,7 +15,11 @@ func TestFormatDates(t *testing.T) {
 	format := "{YY}-{mn}-{D}"
 
 	expected := []string{"25-Jan-1", "25-Dec-31"}
-	result := FormatDates(dates, format, job.English)
+	formatted, err := FormatDates(slices.Values(dates), format, FormatOptions{Language: job.English})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := slices.Collect(formatted)
 
 	for i := range expected {
 		if result[i] != expected[i] {
@@ -37,10 +42,22 @@ func TestReplaceDatePlaceholdersWithDate(t *testing.T) {
 		"{wd}":   "Wed",
 		"{MN}":   "March",
 		"{mn}":   "Mar",
+		"{WW}":   "10",
+		"{GGGG}": "2025",
+		"{DOY}":  "064",
+		"{Q}":    "1",
+		"{Do}":   "5th",
+		"{U}":    "1741132800",
+		"{JDN}":  "2460740",
+		"{N}":    "3",
+		"{idx}":  "1",
 	}
 
 	for placeholder, expected := range tests {
-		result := ReplaceDatePlaceholdersWithDate(placeholder, date, job.English)
+		result, err := ReplaceDatePlaceholdersWithDate(placeholder, date, 1, FormatOptions{Language: job.English})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
 		if result != expected {
 			t.Errorf("Placeholder %s: expected %s, got %s", placeholder, expected, result)
 		}
@@ -49,70 +66,269 @@ func TestReplaceDatePlaceholdersWithDate(t *testing.T) {
 	t.Run("Full custom string", func(t *testing.T) {
 		format := "Today is {WD}, {MN} {D}, {YYYY}"
 		expected := "Today is Wednesday, March 5, 2025"
-		result := ReplaceDatePlaceholdersWithDate(format, date, job.English)
+		result, err := ReplaceDatePlaceholdersWithDate(format, date, 1, FormatOptions{Language: job.English})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
 		if result != expected {
 			t.Errorf("Expected %s, got %s", expected, result)
 		}
 	})
 }
 
-func TestGetShortFormName(t *testing.T) {
+func TestFormatDatesIndex(t *testing.T) {
+	dates := []time.Time{
+		time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.January, 3, 0, 0, 0, 0, time.UTC),
+	}
+
+	expected := []string{"1: 01", "2: 02", "3: 03"}
+	formatted, err := FormatDates(slices.Values(dates), "{idx}: {DD}", FormatOptions{Language: job.English})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := slices.Collect(formatted)
+
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Errorf("At index %d: expected %s, got %s", i, expected[i], result[i])
+		}
+	}
+}
+
+func TestISOWeekPlaceholders(t *testing.T) {
 	tests := []struct {
 		name     string
-		input    string
-		lang     job.Language
+		date     time.Time
 		expected string
 	}{
 		{
-			name:     "English short form",
-			input:    "Monday",
-			lang:     job.English,
-			expected: "Mon",
+			name:     "First of January in the last ISO week of the previous year",
+			date:     time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: "2020-W53-5",
 		},
 		{
-			name:     "German short form",
-			input:    "Dienstag",
-			lang:     job.German,
-			expected: "Die",
+			name:     "End of December in the first ISO week of the next year",
+			date:     time.Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC),
+			expected: "2025-W01-1",
 		},
 		{
-			name:     "Spanish short form",
-			input:    "Martes",
-			lang:     job.Spanish,
-			expected: "Mar",
+			name:     "Sunday is the last ISO weekday",
+			date:     time.Date(2025, time.December, 7, 0, 0, 0, 0, time.UTC),
+			expected: "2025-W49-7",
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ReplaceDatePlaceholdersWithDate("{GGGG}-W{WW}-{N}", tt.date, 1, FormatOptions{Language: job.English})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestOrdinalDay(t *testing.T) {
+	tests := []struct {
+		lang     job.Language
+		day      int
+		expected string
+	}{
+		{job.English, 1, "1st"},
+		{job.English, 2, "2nd"},
+		{job.English, 3, "3rd"},
+		{job.English, 4, "4th"},
+		{job.English, 11, "11th"},
+		{job.English, 12, "12th"},
+		{job.English, 13, "13th"},
+		{job.English, 22, "22nd"},
+		{job.English, 31, "31st"},
+		{job.French, 1, "1er"},
+		{job.French, 2, "2"},
+		{job.Spanish, 3, "3.º"},
+		{job.German, 7, "7."},
+		{job.Swiss, 7, "7."},
+		{job.Italian, 1, "1º"},
+		{job.Italian, 8, "8"},
+		{job.Portuguese, 1, "1º"},
+		{job.Dutch, 5, "5e"},
+		{job.Russian, 5, "5-е"},
+		{job.Chinese, 5, "5日"},
+		{job.Arabic, 5, "5"},
+		{job.Hindi, 5, "5"},
+	}
+
+	for _, tt := range tests {
+		date := time.Date(2025, time.March, tt.day, 0, 0, 0, 0, time.UTC)
+		result, err := ReplaceDatePlaceholdersWithDate("{Do}", date, 1, FormatOptions{Language: tt.lang, Digits: job.LatinDigits})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result != tt.expected {
+			t.Errorf("{Do} for day %d in language %v: expected %s, got %s", tt.day, tt.lang, tt.expected, result)
+		}
+	}
+}
+
+func TestTimePlaceholders(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     time.Time
+		lang     job.Language
+		expected string
+	}{
 		{
-			name:     "Dutch short form",
-			input:    "Woensdag",
-			lang:     job.Dutch,
-			expected: "Woe",
+			name:     "Midnight",
+			date:     time.Date(2025, 10, 2, 0, 5, 9, 0, time.UTC),
+			lang:     job.English,
+			expected: "00:05:09 12 AM",
 		},
 		{
-			name:     "Hindi no short form",
-			input:    "सोमवार",
-			lang:     job.Hindi,
-			expected: "सोमवार",
+			name:     "Noon",
+			date:     time.Date(2025, 10, 2, 12, 0, 0, 0, time.UTC),
+			lang:     job.English,
+			expected: "12:00:00 12 PM",
 		},
 		{
-			name:     "Chinese no short form",
-			input:    "星期一",
-			lang:     job.Chinese,
-			expected: "星期一",
+			name:     "Evening in Spanish",
+			date:     time.Date(2025, 10, 2, 18, 30, 0, 0, time.UTC),
+			lang:     job.Spanish,
+			expected: "18:30:00 06 p. m.",
 		},
 		{
-			name:     "Short input string",
-			input:    "Lundi",
-			lang:     job.French,
-			expected: "Lun",
+			name:     "Morning in Chinese",
+			date:     time.Date(2025, 10, 2, 9, 15, 0, 0, time.UTC),
+			lang:     job.Chinese,
+			expected: "09:15:00 09 上午",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := GetShortFormName(tt.input, tt.lang)
+			result, err := ReplaceDatePlaceholdersWithDate("{hh}:{mm}:{ss} {HH12} {AMPM}", tt.date, 1, FormatOptions{Language: tt.lang})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
 			if result != tt.expected {
-				t.Errorf("GetShortFormName(%q, %v) = %q; want %q", tt.input, tt.lang, result, tt.expected)
+				t.Errorf("expected %s, got %s", tt.expected, result)
 			}
 		})
 	}
 }
+
+func TestJulianDayNumber(t *testing.T) {
+	tests := map[time.Time]int{
+		time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC):  2451545,
+		time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC):  2440588,
+		time.Date(1582, time.October, 15, 0, 0, 0, 0, time.UTC): 2299161,
+	}
+
+	for date, expected := range tests {
+		if result := JulianDayNumber(date); result != expected {
+			t.Errorf("JulianDayNumber(%v) = %d; want %d", date, result, expected)
+		}
+	}
+}
+
+func TestQuarter(t *testing.T) {
+	expected := []int{1, 1, 1, 2, 2, 2, 3, 3, 3, 4, 4, 4}
+	for i, want := range expected {
+		date := time.Date(2025, time.Month(i+1), 15, 0, 0, 0, 0, time.UTC)
+		if result := Quarter(date); result != want {
+			t.Errorf("Quarter(%v) = %d; want %d", date, result, want)
+		}
+	}
+}
+
+func TestShortNames(t *testing.T) {
+	date := time.Date(2025, time.February, 3, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		lang     job.Language
+		expected string
+	}{
+		{job.English, "Mon M Feb F"},
+		{job.German, "Mo. M Feb. F"},
+		{job.French, "lun. L févr. F"},
+		{job.Spanish, "lun L feb F"},
+		{job.Swiss, "mä. m feb f"},
+		{job.Dutch, "ma M feb F"},
+		{job.Portuguese, "seg. S fev. F"},
+		{job.Russian, "пн П февр. Ф"},
+		{job.Chinese, "周一 一 2月 2"},
+		{job.Arabic, "الاثنين ن فبراير ف"},
+		{job.Hindi, "सोम सो फ़र॰ फ़"},
+	}
+
+	for _, tt := range tests {
+		result, err := ReplaceDatePlaceholdersWithDate("{wd} {wd1} {mn} {mn1}", date, 1, FormatOptions{Language: tt.lang})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result != tt.expected {
+			t.Errorf("short names in language %v: expected %q, got %q", tt.lang, tt.expected, result)
+		}
+	}
+}
+
+func TestGenitiveMonthNames(t *testing.T) {
+	date := time.Date(2025, time.December, 7, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		format   string
+		options  FormatOptions
+		expected string
+	}{
+		{"{D} {MNg} {YYYY}", FormatOptions{Language: job.Russian}, "7 декабря 2025"},
+		{"{MN} {YYYY}", FormatOptions{Language: job.Russian}, "декабрь 2025"},
+		{"{D} {MNg} {YYYY}", FormatOptions{Language: job.German}, "7 Dezember 2025"},
+		{"{WD:title}, {D} {MNg}", FormatOptions{Language: job.Russian}, "Воскресенье, 7 декабря"},
+		{"{D} {MNg}", FormatOptions{Language: job.Russian, Calendar: job.PersianCalendar}, "16 азер"},
+		{"{WD} {D} {MN}", FormatOptions{Language: job.French}, "dimanche 7 décembre"},
+		{"{WD:title} {D} {MN:title}", FormatOptions{Language: job.Spanish}, "Domingo 7 Diciembre"},
+	}
+
+	for _, tt := range tests {
+		result, err := ReplaceDatePlaceholdersWithDate(tt.format, date, 1, tt.options)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result != tt.expected {
+			t.Errorf("expected %q, got %q", tt.expected, result)
+		}
+	}
+}
+
+func TestRegionalLocales(t *testing.T) {
+	date := time.Date(2025, time.January, 7, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		lang     job.Language
+		format   string
+		expected string
+	}{
+		{"pl", "{WD}, {D} {MNg} {YYYY}", "wtorek, 7 stycznia 2025"},
+		{"pl", "{MN:title}", "Styczeń"},
+		{"ja", "{YYYY}年{M}月{D}日 {WD}", "2025年1月7日 火曜日"},
+		{"ko", "{YYYY}년 {MN} {D}일 {WD}", "2025년 1월 7일 화요일"},
+		{"de-AT", "{D}. {MN} {YYYY}", "7. Jänner 2025"},
+		{"de-CH", "{D}. {MN} {YYYY}", "7. Januar 2025"},
+		{"ar-MA", "{D} {MN} {YYYY}", "7 يناير 2025"},
+		{"ar-EG", "{D} {MN} {YYYY}", "٧ يناير ٢٠٢٥"},
+		{"fa", "{D} {MN}", "۷ ژانویه"},
+		{"en-GB", "{HH12} {AMPM}", "12 am"},
+		{"fr-CA", "{D} {mn}", "7 janv."},
+	}
+
+	for _, tt := range tests {
+		result, err := ReplaceDatePlaceholdersWithDate(tt.format, date, 1, FormatOptions{Language: tt.lang})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result != tt.expected {
+			t.Errorf("%q in %v: expected %q, got %q", tt.format, tt.lang, tt.expected, result)
+		}
+	}
+}
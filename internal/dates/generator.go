@@ -1,50 +1,186 @@
 package dates
 
 import (
+	"iter"
 	"pdate/internal/constants"
 	"pdate/internal/job"
+	"pdate/internal/locale"
+	"pdate/internal/messages"
+	"slices"
 	"time"
 )
 
-func GetDates(j *job.Job) []string {
+// GetDates builds the pipeline for a job. Nothing is generated until the
+// returned sequence is iterated, so the output starts right away. Only the
+// reverse stage holds all dates in memory, --last and --sample hold the
+// dates they keep.
+func GetDates(j *job.Job) (iter.Seq[string], error) {
 	if j.Help {
-		return []string{constants.HelpMessage}
+		return slices.Values([]string{messages.Help(string(j.Language))}), nil
 	}
 	if j.Version {
-		return []string{constants.Version}
+		return slices.Values([]string{constants.Version}), nil
 	}
-	allDates := GetAllDates(j.DatesInput)
-	ignoredWeekdays := IgnoreWeekdays(allDates, j.IgnoredWeekdays)
+	format, err := ConvertFormat(j.Format, j.FormatStyle)
+	if err != nil {
+		return nil, err
+	}
+	now := Today(time.Now(), j.Location)
+	if IsSubDay(j.Step) {
+		j = InZoneJob(j)
+		now = WallClock(time.Now(), j.Location)
+		if format == constants.DefaultInputFormat {
+			format = constants.DefaultInputFormatDateTime
+		}
+	}
+	options := FormatOptions{j.Language, j.Calendar, j.Digits, j.WeekStart, j.Location}
+	l := locale.Get(string(j.Language))
+	ignored := j.IgnoredWeekdays
+	if j.WorkdaysOnly {
+		ignored = append(slices.Clip(ignored), WeekendDays(j.Weekend, l)...)
+	}
+	include := NewInclude(j)
+	selection := NewSelection(j)
+	if j.Count > 0 && (AllWeekdays(ignored) || include.ExcludesAll(ignored)) {
+		return nil, messages.CountWithoutWeekdays
+	}
+	if j.Count > 0 && include.NeverMatches(ignored) {
+		return nil, messages.CountWithoutMatches
+	}
+	var where Where
+	if j.Where != "" {
+		where, err = CompileWhere(j.Where, WhereContext{j.Holidays, WeekendDays(j.Weekend, l)})
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Steps of weeks go from the first day of a week to the next.
+	weeks := j.Step.Unit == job.Week
+	firstDay := FirstDayOfWeek(j.WeekStart, l)
+	if j.Count > 0 {
+		start := GetStartDate(j.DatesInput, now)
+		if weeks {
+			start = AlignToWeek(start, firstDay, !j.Reversed)
+		}
+		allDates := ApplySetOperations(GetTimesFrom(start, j.Step, !j.Reversed), j, firstDay, !j.Reversed)
+		filtered := FilterWhere(IncludeOnly(IgnoreWeekdays(allDates, ignored), include), where)
+		selected := Select(Limit(filtered, j.Count), selection)
+		if j.Grid {
+			return Grid(selected, options, firstDay, MinimalDaysOfWeek(j.WeekStart, l)), nil
+		}
+		return FormatDates(selected, format, options)
+	}
+	var allDates iter.Seq[time.Time]
+	if j.Input != nil {
+		allDates = GetInputDates(j.Input.Ranges(), j.Step)
+	} else if len(j.Ranges) > 0 {
+		allDates = GetRangeDates(j.Ranges, j.Step, firstDay, true)
+	} else if weeks {
+		allDates = GetAllWeeks(j.DatesInput, now, j.Step, firstDay)
+	} else {
+		allDates = GetAllDates(j.DatesInput, now, j.Step)
+	}
+	allDates = ApplySetOperations(allDates, j, firstDay, true)
+	filtered := Select(FilterWhere(IncludeOnly(IgnoreWeekdays(allDates, ignored), include), where), selection)
 	if j.Reversed {
-		ignoredWeekdays = ReverseOrder(ignoredWeekdays)
+		filtered = ReverseOrder(filtered)
 	}
-	return FormatDates(ignoredWeekdays, j.Format, j.Language)
+	if j.Grid {
+		return Grid(filtered, options, firstDay, MinimalDaysOfWeek(j.WeekStart, l)), nil
+	}
+	return FormatDates(filtered, format, options)
 }
 
-func GetAllDates(dates []time.Time) []time.Time {
+// GetAllDates uses now as the end of the range if there is no end date, which
+// is today for steps of days and the current time for smaller steps.
+func GetAllDates(dates []time.Time, now time.Time, step job.Step) iter.Seq[time.Time] {
 	switch len(dates) {
 	case 2:
-		return GetDatesFromTo(dates[0], dates[1])
+		return GetTimesFromTo(dates[0], dates[1], step)
 	case 1:
-		return GetDatesFromTo(dates[0], time.Now())
+		return GetTimesFromTo(dates[0], now, step)
 	default:
-		return GetDatesFromTo(time.Now(), time.Now())
+		return GetTimesFromTo(now, now, step)
+	}
+}
+
+func GetStartDate(dates []time.Time, now time.Time) time.Time {
+	if len(dates) > 0 {
+		return dates[0]
 	}
+	return now
 }
 
-func GetDatesFromTo(from time.Time, to time.Time) []time.Time {
+// GetDatesFrom yields the days from start onwards, or backwards if forward
+// is false. The sequence only ends at the limits of the supported years, so
+// the caller is expected to stop it.
+func GetDatesFrom(start time.Time, forward bool) iter.Seq[time.Time] {
+	return GetTimesFrom(CivilDate(start), job.Step{Amount: 1, Unit: job.Day}, forward)
+}
+
+func GetTimesFrom(start time.Time, step job.Step, forward bool) iter.Seq[time.Time] {
+	direction := 1
+	if !forward {
+		direction = -1
+	}
+	return func(yield func(time.Time) bool) {
+		for date := start; date.Year() >= 1 && date.Year() <= 9999; date = Advance(date, step, direction) {
+			if !yield(date) {
+				return
+			}
+		}
+	}
+}
+
+func GetDatesFromTo(from time.Time, to time.Time) iter.Seq[time.Time] {
+	return GetTimesFromTo(CivilDate(from), CivilDate(to), job.Step{Amount: 1, Unit: job.Day})
+}
+
+// GetTimesFromTo steps from the earlier to the later time. Steps of days or
+// weeks include every step on or before the last calendar day, smaller steps
+// stop at the exact end time.
+func GetTimesFromTo(from time.Time, to time.Time, step job.Step) iter.Seq[time.Time] {
 	var lower = from
 	var upper = to
 	if upper.Before(lower) {
 		upper, lower = lower, upper
 	}
-	var dates []time.Time
-	dates = append(dates, lower)
-	for IsADayBefore(lower, upper) {
-		lower = lower.Add(time.Hour * 24)
-		dates = append(dates, lower)
+	return func(yield func(time.Time) bool) {
+		for date := lower; !IsPastEnd(date, upper, step); date = Advance(date, step, 1) {
+			if !yield(date) {
+				return
+			}
+		}
+	}
+}
+
+func IsPastEnd(date time.Time, end time.Time, step job.Step) bool {
+	if IsSubDay(step) {
+		return date.After(end)
+	}
+	return IsADayBefore(end, date)
+}
+
+func IsSubDay(step job.Step) bool {
+	return step.Unit == job.Hour || step.Unit == job.Minute || step.Unit == job.Second
+}
+
+// Advance moves the date by the given number of steps, negative numbers go
+// back in time. A step without an amount counts as a single unit.
+func Advance(date time.Time, step job.Step, steps int) time.Time {
+	amount := max(step.Amount, 1) * steps
+	switch step.Unit {
+	case job.Week:
+		return date.AddDate(0, 0, 7*amount)
+	case job.Hour:
+		return date.Add(time.Duration(amount) * time.Hour)
+	case job.Minute:
+		return date.Add(time.Duration(amount) * time.Minute)
+	case job.Second:
+		return date.Add(time.Duration(amount) * time.Second)
+	default:
+		return date.AddDate(0, 0, amount)
 	}
-	return dates
 }
 
 func IsADayBefore(before time.Time, after time.Time) bool {
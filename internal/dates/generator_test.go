@@ -1,6 +1,12 @@
 package dates
 
 import (
+	"errors"
+	"iter"
+	"pdate/internal/constants"
+	"pdate/internal/job"
+	"pdate/internal/messages"
+	"slices"
 	"testing"
 	"time"
 )
@@ -39,7 +45,7 @@ func TestGetDatesFromTo(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := GetDatesFromTo(tt.from, tt.to)
+			result := slices.Collect(GetDatesFromTo(tt.from, tt.to))
 			if len(result) != tt.expectedSize {
 				t.Errorf("IsADayBefore() = %v, expected %v", len(result), tt.expectedSize)
 			}
@@ -101,3 +107,502 @@ func TestIsADayBefore(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDatesCountWithoutWorkdays(t *testing.T) {
+	j := job.Job{
+		DatesInput:      []time.Time{time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC)},
+		IgnoredWeekdays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+		WorkdaysOnly:    true,
+		Count:           1,
+	}
+	if _, err := GetDates(&j); !errors.Is(err, messages.CountWithoutWeekdays) {
+		t.Errorf("expected the count error, got %v", err)
+	}
+}
+
+func TestGetDatesCountWithoutIncludedWeekdays(t *testing.T) {
+	j := job.Job{
+		DatesInput:   []time.Time{time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC)},
+		OnlyWeekdays: []time.Weekday{time.Saturday},
+		WorkdaysOnly: true,
+		Count:        1,
+	}
+	if _, err := GetDates(&j); !errors.Is(err, messages.CountWithoutWeekdays) {
+		t.Errorf("expected the count error, got %v", err)
+	}
+}
+
+func TestGetDatesCountWithoutMatches(t *testing.T) {
+	j := job.Job{
+		Count:      3,
+		Step:       job.Step{Amount: 1, Unit: job.Second},
+		OnlyDays:   []int{31},
+		OnlyMonths: []time.Month{time.February},
+	}
+	if _, err := GetDates(&j); !errors.Is(err, messages.CountWithoutMatches) {
+		t.Errorf("expected the count error, got %v", err)
+	}
+}
+
+func TestGetDatesInvalidWhere(t *testing.T) {
+	j := job.Job{Where: "day >"}
+	if _, err := GetDates(&j); !errors.Is(err, messages.InvalidExpression) {
+		t.Errorf("expected the expression error, got %v", err)
+	}
+}
+
+func TestGetDatesFromToStopsEarly(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	var result []time.Time
+	for date := range GetDatesFromTo(from, to) {
+		result = append(result, date)
+		if len(result) == 3 {
+			break
+		}
+	}
+
+	if len(result) != 3 || !result[2].Equal(time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected to stop after the third date, got %v", result)
+	}
+}
+
+func TestGetDates(t *testing.T) {
+	tests := []struct {
+		name     string
+		job      job.Job
+		expected []string
+	}{
+		{
+			name: "range with ignored weekdays",
+			job: job.Job{
+				DatesInput:      []time.Time{time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC), time.Date(2025, 10, 7, 0, 0, 0, 0, time.UTC)},
+				IgnoredWeekdays: []time.Weekday{time.Saturday, time.Sunday},
+				Format:          "{idx} {DD} {wd}",
+			},
+			expected: []string{"1 02 Thu", "2 03 Fri", "3 06 Mon", "4 07 Tue"},
+		},
+		{
+			name: "reversed range",
+			job: job.Job{
+				DatesInput: []time.Time{time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC), time.Date(2025, 10, 4, 0, 0, 0, 0, time.UTC)},
+				Reversed:   true,
+				Format:     "{idx} {DD}",
+			},
+			expected: []string{"1 04", "2 03", "3 02"},
+		},
+		{
+			name: "count after filtering",
+			job: job.Job{
+				DatesInput:      []time.Time{time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC)},
+				IgnoredWeekdays: []time.Weekday{time.Saturday, time.Sunday},
+				Format:          "{DD} {wd}",
+				Count:           4,
+			},
+			expected: []string{"02 Thu", "03 Fri", "06 Mon", "07 Tue"},
+		},
+		{
+			name: "count backwards",
+			job: job.Job{
+				DatesInput: []time.Time{time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC)},
+				Reversed:   true,
+				Format:     "{MM}-{DD}",
+				Count:      3,
+			},
+			expected: []string{"10-02", "10-01", "09-30"},
+		},
+		{
+			name: "sub-day steps use a date and time format",
+			job: job.Job{
+				DatesInput: []time.Time{time.Date(2025, 10, 2, 8, 0, 0, 0, time.UTC), time.Date(2025, 10, 2, 9, 0, 0, 0, time.UTC)},
+				Format:     constants.DefaultInputFormat,
+				Step:       job.Step{Amount: 30, Unit: job.Minute},
+			},
+			expected: []string{"2025-10-02T08:00", "2025-10-02T08:30", "2025-10-02T09:00"},
+		},
+		{
+			name: "count with hours",
+			job: job.Job{
+				DatesInput: []time.Time{time.Date(2025, 10, 2, 22, 0, 0, 0, time.UTC)},
+				Format:     "{D} {hh}",
+				Step:       job.Step{Amount: 1, Unit: job.Hour},
+				Count:      3,
+			},
+			expected: []string{"2 22", "2 23", "3 00"},
+		},
+		{
+			name: "weeks start on the first day of the locale",
+			job: job.Job{
+				DatesInput: []time.Time{time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC), time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)},
+				Language:   "de",
+				Format:     "{DD} {wd}",
+				Step:       job.Step{Amount: 1, Unit: job.Week},
+			},
+			expected: []string{"06 Mo.", "13 Mo.", "20 Mo."},
+		},
+		{
+			name: "weeks start on the day of --week-start",
+			job: job.Job{
+				DatesInput: []time.Time{time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC), time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC)},
+				Language:   "de",
+				WeekStart:  job.SundayStart,
+				Format:     "{DD} {wd}",
+				Step:       job.Step{Amount: 1, Unit: job.Week},
+			},
+			expected: []string{"05 So.", "12 So.", "19 So."},
+		},
+		{
+			name: "counted weeks backwards",
+			job: job.Job{
+				DatesInput: []time.Time{time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC)},
+				Language:   "en-US",
+				Reversed:   true,
+				Format:     "{MM}-{DD}",
+				Step:       job.Step{Amount: 1, Unit: job.Week},
+				Count:      2,
+			},
+			expected: []string{"09-28", "09-21"},
+		},
+		{
+			name: "workdays of the locale",
+			job: job.Job{
+				DatesInput:   []time.Time{time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC), time.Date(2025, 10, 5, 0, 0, 0, 0, time.UTC)},
+				Language:     "he",
+				Digits:       job.LatinDigits,
+				WorkdaysOnly: true,
+				Format:       "{DD}",
+			},
+			expected: []string{"02", "05"},
+		},
+		{
+			name: "workdays of a weekend profile together with -i",
+			job: job.Job{
+				DatesInput:      []time.Time{time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC)},
+				IgnoredWeekdays: []time.Weekday{time.Monday},
+				Weekend:         job.FridaySaturdayWeekend,
+				WorkdaysOnly:    true,
+				Format:          "{DD} {wd}",
+				Count:           3,
+			},
+			expected: []string{"02 Thu", "05 Sun", "07 Tue"},
+		},
+		{
+			name: "invoice days with a count",
+			job: job.Job{
+				DatesInput: []time.Time{time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC)},
+				OnlyDays:   []int{15, -1},
+				Format:     "{YYYY}-{MM}-{DD}",
+				Count:      4,
+			},
+			expected: []string{"2025-01-31", "2025-02-15", "2025-02-28", "2025-03-15"},
+		},
+		{
+			name: "only Mondays of April in reverse",
+			job: job.Job{
+				DatesInput:   []time.Time{time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)},
+				OnlyWeekdays: []time.Weekday{time.Monday},
+				OnlyMonths:   []time.Month{time.April},
+				Format:       "{YYYY}-{MM}-{DD}",
+				Reversed:     true,
+			},
+			expected: []string{"2025-04-28", "2025-04-21", "2025-04-14", "2025-04-07"},
+		},
+		{
+			name: "where expression with holidays",
+			job: job.Job{
+				DatesInput: []time.Time{time.Date(2025, 12, 20, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)},
+				Where:      "not weekend and not holiday and weekday != we",
+				Holidays:   []time.Time{time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC)},
+				Format:     "{DD}",
+			},
+			expected: []string{"22", "23", "29", "30"},
+		},
+		{
+			name: "every second workday after an offset before reversing",
+			job: job.Job{
+				DatesInput:      []time.Time{time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 10, 14, 0, 0, 0, 0, time.UTC)},
+				IgnoredWeekdays: []time.Weekday{time.Saturday, time.Sunday},
+				Offset:          1,
+				Every:           2,
+				Last:            3,
+				Reversed:        true,
+				Format:          "{DD}",
+			},
+			expected: []string{"14", "10", "08"},
+		},
+		{
+			name: "sample with a seed",
+			job: job.Job{
+				DatesInput: []time.Time{time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 10, 3, 0, 0, 0, 0, time.UTC)},
+				Sample:     3,
+				Seed:       1,
+				Seeded:     true,
+				Format:     "{DD}",
+			},
+			expected: []string{"01", "02", "03"},
+		},
+		{
+			name: "ranges with set operations",
+			job: job.Job{
+				Ranges: []job.Range{
+					{From: time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC), To: time.Date(2025, 4, 2, 0, 0, 0, 0, time.UTC)},
+					{From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+				},
+				Union:     []job.Range{{From: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), To: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)}},
+				Intersect: []job.Range{{From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)}},
+				Minus:     []job.Range{{From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}},
+				Format:    "{MM}-{DD}",
+				Reversed:  true,
+			},
+			expected: []string{"03-31", "03-30", "01-03", "01-02"},
+		},
+		{
+			name: "stdin in the order of the input",
+			job: job.Job{
+				Input: rangeInput{
+					{From: time.Date(2025, 10, 10, 0, 0, 0, 0, time.UTC), To: time.Date(2025, 10, 13, 0, 0, 0, 0, time.UTC)},
+					{From: time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)},
+				},
+				IgnoredWeekdays: []time.Weekday{time.Saturday, time.Sunday},
+				Format:          "{DD}",
+				Reversed:        true,
+			},
+			expected: []string{"01", "13", "10"},
+		},
+		{
+			name:     "version",
+			job:      job.Job{Version: true},
+			expected: []string{constants.Version},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := GetDates(&tt.job)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := slices.Collect(result); !slices.Equal(got, tt.expected) {
+				t.Errorf("GetDates() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// rangeInput is an Input which yields its ranges.
+type rangeInput []job.Range
+
+func (in rangeInput) Ranges() iter.Seq[job.Range] { return slices.Values(in) }
+func (in rangeInput) Err() error                  { return nil }
+
+func TestGetDatesDaylightSavingTime(t *testing.T) {
+	zurich := mustLoadLocation(t, "Europe/Zurich")
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		expected []string
+	}{
+		{
+			name:     "spring forward skips 02:00",
+			from:     time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2025, 3, 30, 4, 0, 0, 0, time.UTC),
+			expected: []string{"00:00+01:00 1743289200", "01:00+01:00 1743292800", "03:00+02:00 1743296400", "04:00+02:00 1743300000"},
+		},
+		{
+			name:     "fall back repeats 02:00",
+			from:     time.Date(2025, 10, 26, 1, 0, 0, 0, time.UTC),
+			to:       time.Date(2025, 10, 26, 3, 0, 0, 0, time.UTC),
+			expected: []string{"01:00+02:00 1761433200", "02:00+02:00 1761436800", "02:00+01:00 1761440400", "03:00+01:00 1761444000"},
+		},
+	}
+
+	for _, tt := range tests {
+		j := job.Job{
+			DatesInput: []time.Time{tt.from, tt.to},
+			Format:     "{hh}:{mm}{Z} {U}",
+			Step:       job.Step{Amount: 1, Unit: job.Hour},
+			Location:   zurich,
+		}
+		result, err := GetDates(&j)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if dates := slices.Collect(result); !slices.Equal(dates, tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, dates)
+		}
+	}
+}
+
+func TestGetDatesUnixTimeOfTimezone(t *testing.T) {
+	j := job.Job{
+		DatesInput: []time.Time{time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC)},
+		Format:     "{U}",
+		Location:   mustLoadLocation(t, "Europe/Zurich"),
+	}
+	result, err := GetDates(&j)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dates := slices.Collect(result); !slices.Equal(dates, []string{"1743289200"}) {
+		t.Errorf("expected the midnight of Zurich, got %v", dates)
+	}
+}
+
+func TestGetDatesInvalidFormat(t *testing.T) {
+	j := job.Job{Format: "{XX}"}
+	if _, err := GetDates(&j); err == nil {
+		t.Error("expected an error for an unknown placeholder")
+	}
+}
+
+func TestGetDatesFrom(t *testing.T) {
+	start := time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		forward  bool
+		expected []time.Time
+	}{
+		{
+			name:    "forward over a leap day",
+			forward: true,
+			expected: []time.Time{
+				time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:    "backward",
+			forward: false,
+			expected: []time.Time{
+				time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 26, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 25, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 24, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := slices.Collect(Limit(GetDatesFrom(start, tt.forward), len(tt.expected)))
+			if !slices.EqualFunc(result, tt.expected, time.Time.Equal) {
+				t.Errorf("GetDatesFrom() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetDatesFromEndsAtSupportedYears(t *testing.T) {
+	start := time.Date(9999, 12, 30, 0, 0, 0, 0, time.UTC)
+	result := slices.Collect(GetDatesFrom(start, true))
+	if len(result) != 2 {
+		t.Errorf("expected the sequence to end after 9999-12-31, got %d dates", len(result))
+	}
+}
+
+func TestGetTimesFromTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		step     job.Step
+		expected []time.Time
+	}{
+		{
+			name: "quarter hours",
+			from: time.Date(2025, 10, 2, 8, 0, 0, 0, time.UTC),
+			to:   time.Date(2025, 10, 2, 8, 40, 0, 0, time.UTC),
+			step: job.Step{Amount: 15, Unit: job.Minute},
+			expected: []time.Time{
+				time.Date(2025, 10, 2, 8, 0, 0, 0, time.UTC),
+				time.Date(2025, 10, 2, 8, 15, 0, 0, time.UTC),
+				time.Date(2025, 10, 2, 8, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "hours over midnight",
+			from: time.Date(2025, 10, 2, 22, 0, 0, 0, time.UTC),
+			to:   time.Date(2025, 10, 3, 1, 0, 0, 0, time.UTC),
+			step: job.Step{Amount: 1, Unit: job.Hour},
+			expected: []time.Time{
+				time.Date(2025, 10, 2, 22, 0, 0, 0, time.UTC),
+				time.Date(2025, 10, 2, 23, 0, 0, 0, time.UTC),
+				time.Date(2025, 10, 3, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 10, 3, 1, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "seconds in reversed order of input",
+			from: time.Date(2025, 10, 2, 8, 0, 20, 0, time.UTC),
+			to:   time.Date(2025, 10, 2, 8, 0, 0, 0, time.UTC),
+			step: job.Step{Amount: 10, Unit: job.Second},
+			expected: []time.Time{
+				time.Date(2025, 10, 2, 8, 0, 0, 0, time.UTC),
+				time.Date(2025, 10, 2, 8, 0, 10, 0, time.UTC),
+				time.Date(2025, 10, 2, 8, 0, 20, 0, time.UTC),
+			},
+		},
+		{
+			name: "days keep the time and include the last calendar day",
+			from: time.Date(2025, 10, 2, 8, 0, 0, 0, time.UTC),
+			to:   time.Date(2025, 10, 4, 0, 0, 0, 0, time.UTC),
+			step: job.Step{Amount: 1, Unit: job.Day},
+			expected: []time.Time{
+				time.Date(2025, 10, 2, 8, 0, 0, 0, time.UTC),
+				time.Date(2025, 10, 3, 8, 0, 0, 0, time.UTC),
+				time.Date(2025, 10, 4, 8, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "weeks",
+			from: time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
+			step: job.Step{Amount: 1, Unit: job.Week},
+			expected: []time.Time{
+				time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 10, 9, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 10, 16, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := slices.Collect(GetTimesFromTo(tt.from, tt.to, tt.step))
+			if !slices.EqualFunc(result, tt.expected, time.Time.Equal) {
+				t.Errorf("GetTimesFromTo() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAdvance(t *testing.T) {
+	date := time.Date(2025, 3, 30, 1, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		step     job.Step
+		steps    int
+		expected time.Time
+	}{
+		{"one day", job.Step{Amount: 1, Unit: job.Day}, 1, time.Date(2025, 3, 31, 1, 30, 0, 0, time.UTC)},
+		{"two weeks back", job.Step{Amount: 2, Unit: job.Week}, -1, time.Date(2025, 3, 16, 1, 30, 0, 0, time.UTC)},
+		{"three hours", job.Step{Amount: 3, Unit: job.Hour}, 1, time.Date(2025, 3, 30, 4, 30, 0, 0, time.UTC)},
+		{"twice 45 minutes", job.Step{Amount: 45, Unit: job.Minute}, 2, time.Date(2025, 3, 30, 3, 0, 0, 0, time.UTC)},
+		{"30 seconds back", job.Step{Amount: 30, Unit: job.Second}, -1, time.Date(2025, 3, 30, 1, 29, 30, 0, time.UTC)},
+		{"missing amount", job.Step{Unit: job.Day}, 1, time.Date(2025, 3, 31, 1, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Advance(date, tt.step, tt.steps); !result.Equal(tt.expected) {
+				t.Errorf("Advance() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
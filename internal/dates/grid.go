@@ -0,0 +1,131 @@
+package dates
+
+import (
+	"iter"
+	"pdate/internal/locale"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Grid lays the dates out as a calendar of months, like cal does. Every
+// month with a date gets a title, a row with the weekdays starting on
+// firstDay and a row for every week, led by its locale week. Days of the
+// month which aren't among the dates stay blank, so the grid shows what the
+// filters kept. The dates are expected in order, a month which comes back
+// later is printed again.
+func Grid(dates iter.Seq[time.Time], options FormatOptions, firstDay time.Weekday, minimalDays int) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		var month time.Time
+		var days [32]bool
+		started := false
+		for date := range dates {
+			year, m, day := date.Date()
+			first := time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+			if started && first != month {
+				if !yieldMonth(yield, month, days, options, firstDay, minimalDays) || !yield("") {
+					return
+				}
+				days = [32]bool{}
+			}
+			month, started = first, true
+			days[day] = true
+		}
+		if started {
+			yieldMonth(yield, month, days, options, firstDay, minimalDays)
+		}
+	}
+}
+
+// yieldMonth yields the lines of the month which starts on first and in
+// which the days are marked.
+func yieldMonth(yield func(string) bool, first time.Time, days [32]bool, options FormatOptions, firstDay time.Weekday, minimalDays int) bool {
+	l := locale.Get(string(options.Language))
+	native := UsesNativeDigits(options.Digits, l)
+	title, _ := CompileFormat("{MN} {YYYY}")
+	if !yield(title.Apply(first, 1, options)) {
+		return false
+	}
+	names := gridWeekdays(l)
+	header := []byte("  ")
+	for i := range 7 {
+		header = append(append(header, ' '), names[(int(firstDay)+i)%7]...)
+	}
+	if !yield(strings.TrimRight(string(header), " ")) {
+		return false
+	}
+	next := first.AddDate(0, 1, 0)
+	var row []byte
+	for start := AlignToWeek(first, firstDay, false); start.Before(next); start = start.AddDate(0, 0, 7) {
+		_, week := LocaleWeek(start, firstDay, minimalDays)
+		row = appendGridNumber(row[:0], week, native, l)
+		for date := start; date.Before(start.AddDate(0, 0, 7)); date = date.AddDate(0, 0, 1) {
+			row = append(row, ' ')
+			if date.Month() != first.Month() || !days[date.Day()] {
+				row = append(row, "  "...)
+				continue
+			}
+			row = appendGridNumber(row, date.Day(), native, l)
+		}
+		if !yield(strings.TrimRight(string(row), " ")) {
+			return false
+		}
+	}
+	return true
+}
+
+// appendGridNumber appends a number of one or two digits right-aligned in a
+// cell of two, in the digits of the locale if native is set.
+func appendGridNumber(buf []byte, number int, native bool, l *locale.Locale) []byte {
+	start := len(buf)
+	if number < 10 {
+		buf = append(buf, ' ')
+	}
+	buf = AppendPadded(buf, number, 1)
+	if native {
+		buf = LocalizeDigits(buf, start, digitsValue, l)
+	}
+	return buf
+}
+
+// gridWeekdays returns the weekdays of the locale shortened to a cell, from
+// Sunday on. Abbreviations which can't be told apart that way, like the
+// Arabic ones which all start with the article, give way to the narrow
+// names.
+func gridWeekdays(l *locale.Locale) []string {
+	names := make([]string, 7)
+	seen := make(map[string]bool)
+	for i, abbreviation := range l.WeekdayAbbreviations {
+		names[i] = gridWeekday(abbreviation)
+		seen[names[i]] = true
+	}
+	if len(seen) == 7 {
+		return names
+	}
+	for i, narrow := range l.WeekdayNarrowNames {
+		names[i] = gridWeekday(narrow)
+	}
+	return names
+}
+
+// gridWeekday shortens the abbreviation of a weekday to a cell of two
+// columns: two letters with their marks, or one character of the wide
+// scripts of East Asia.
+func gridWeekday(abbreviation string) string {
+	var name []rune
+	width := 0
+	for _, r := range abbreviation {
+		w := 1
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+			w = 2
+		} else if unicode.Is(unicode.M, r) {
+			w = 0
+		}
+		if width+w > 2 || r == '.' {
+			break
+		}
+		name = append(name, r)
+		width += w
+	}
+	return string(name) + strings.Repeat(" ", 2-width)
+}
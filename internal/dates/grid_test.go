@@ -0,0 +1,122 @@
+package dates
+
+import (
+	"pdate/internal/constants"
+	"pdate/internal/job"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestGrid(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		j        *job.Job
+		expected []string
+	}{
+		{
+			name: "workdays in German",
+			from: time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC),
+			j:    &job.Job{Language: job.German, WorkdaysOnly: true},
+			expected: []string{
+				"Oktober 2025",
+				"   Mo Di Mi Do Fr Sa So",
+				"40        1  2  3",
+				"41  6  7  8  9 10",
+				"42 13 14 15 16 17",
+				"43 20 21 22 23 24",
+				"44 27 28 29 30 31",
+			},
+		},
+		{
+			name: "turn of the year in the US",
+			from: time.Date(2025, 12, 28, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC),
+			j:    &job.Job{Language: "en-US"},
+			expected: []string{
+				"December 2025",
+				"   Su Mo Tu We Th Fr Sa",
+				"49",
+				"50",
+				"51",
+				"52",
+				" 1 28 29 30 31",
+				"",
+				"January 2026",
+				"   Su Mo Tu We Th Fr Sa",
+				" 1              1  2  3",
+				" 2",
+				" 3",
+				" 4",
+				" 5",
+			},
+		},
+		{
+			name: "week start",
+			from: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+			j:    &job.Job{Language: "en-US", WeekStart: job.MondayStart},
+			expected: []string{
+				"February 2026",
+				"   Mo Tu We Th Fr Sa Su",
+				" 5                    1",
+				" 6",
+				" 7",
+				" 8",
+				" 9",
+			},
+		},
+		{
+			name: "narrow names and native digits",
+			from: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			j:    &job.Job{Language: "ar-EG", Digits: job.NativeDigits},
+			expected: []string{
+				"مارس ٢٠٢٥",
+				"   س  ح  ن  ث  ر  خ  ج",
+				"١٠  ١",
+				"١١",
+				"١٢",
+				"١٣",
+				"١٤",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt.j.Format = constants.DefaultInputFormat
+		tt.j.Step = job.Step{Amount: 1, Unit: job.Day}
+		tt.j.DatesInput = []time.Time{tt.from, tt.to}
+		tt.j.Grid = true
+		result, err := GetDates(tt.j)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if lines := slices.Collect(result); !slices.Equal(lines, tt.expected) {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.expected, lines)
+		}
+	}
+}
+
+func TestGridWeekday(t *testing.T) {
+	tests := []struct {
+		abbreviation string
+		expected     string
+	}{
+		{"Mon", "Mo"},
+		{"Mo.", "Mo"},
+		{"月", "月"},
+		{"周一", "周"},
+		{"T", "T "},
+		{"रवि", "रवि"},
+	}
+
+	for _, tt := range tests {
+		if name := gridWeekday(tt.abbreviation); name != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.abbreviation, tt.expected, name)
+		}
+	}
+}
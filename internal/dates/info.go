@@ -0,0 +1,131 @@
+package dates
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"pdate/internal/job"
+	"pdate/internal/messages"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Info is the record pdate info prints about a date. The date is written in
+// the -f format and the weekday in the language of -l, Lines translates the
+// labels.
+type Info struct {
+	Date          string   `json:"date"`
+	Weekday       string   `json:"weekday"`
+	ISOWeek       string   `json:"iso_week"`
+	DayOfYear     int      `json:"day_of_year"`
+	Quarter       int      `json:"quarter"`
+	DaysFromToday int      `json:"days_from_today"`
+	LeapYear      bool     `json:"leap_year"`
+	Holidays      []string `json:"holidays"`
+}
+
+// GetInfo describes the date of the job, or today without one, as lines of
+// text or as JSON.
+func GetInfo(j *job.Job) (iter.Seq[string], error) {
+	today := Today(time.Now(), j.Location)
+	info, err := NewInfo(GetStartDate(j.DatesInput, today), today, j)
+	if err != nil {
+		return nil, err
+	}
+	if j.JSON {
+		record, err := json.MarshalIndent(info, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return slices.Values([]string{string(record)}), nil
+	}
+	return slices.Values(info.Lines(string(j.Language))), nil
+}
+
+// NewInfo describes the date as seen from today. Holidays of --holidays
+// without a name are listed with their date.
+func NewInfo(date time.Time, today time.Time, j *job.Job) (Info, error) {
+	format, err := ConvertFormat(j.Format, j.FormatStyle)
+	if err != nil {
+		return Info{}, err
+	}
+	compiled, err := CompileFormat(format)
+	if err != nil {
+		return Info{}, err
+	}
+	options := FormatOptions{j.Language, j.Calendar, j.Digits, j.WeekStart, j.Location}
+	weekday, _ := CompileFormat("{WD}")
+	year, week := date.ISOWeek()
+	info := Info{
+		Date:          compiled.Apply(date, 1, options),
+		Weekday:       weekday.Apply(date, 1, options),
+		ISOWeek:       strconv.Itoa(year) + "-W" + string(AppendPadded(nil, week, 2)),
+		DayOfYear:     date.YearDay(),
+		Quarter:       Quarter(date),
+		DaysFromToday: JulianDayNumber(date) - JulianDayNumber(today),
+		LeapYear:      DaysInYear(date) == 366,
+		Holidays:      []string{},
+	}
+	for i, holiday := range j.Holidays {
+		if CivilDate(holiday) != CivilDate(date) {
+			continue
+		}
+		name := holiday.Format(time.DateOnly)
+		if i < len(j.HolidayNames) && j.HolidayNames[i] != "" {
+			name = j.HolidayNames[i]
+		}
+		info.Holidays = append(info.Holidays, name)
+	}
+	return info, nil
+}
+
+// Lines writes the record as lines of text, with the labels in the
+// language of the tag and the values aligned behind them.
+func (info Info) Lines(tag string) []string {
+	translate := func(m messages.Message) string { return messages.Translate(m, tag) }
+	relative := translate(messages.InfoToday)
+	switch {
+	case info.DaysFromToday == 1:
+		relative = translate(messages.InfoTomorrow)
+	case info.DaysFromToday == -1:
+		relative = translate(messages.InfoYesterday)
+	case info.DaysFromToday > 1:
+		relative = fmt.Sprintf(translate(messages.InfoInDays), info.DaysFromToday)
+	case info.DaysFromToday < -1:
+		relative = fmt.Sprintf(translate(messages.InfoDaysAgo), -info.DaysFromToday)
+	}
+	leapYear := translate(messages.InfoNo)
+	if info.LeapYear {
+		leapYear = translate(messages.InfoYes)
+	}
+	holidays := "-"
+	if len(info.Holidays) > 0 {
+		holidays = strings.Join(info.Holidays, ", ")
+	}
+	fields := []struct {
+		label messages.Message
+		value string
+	}{
+		{messages.InfoDate, info.Date},
+		{messages.InfoWeekday, info.Weekday},
+		{messages.InfoISOWeek, info.ISOWeek},
+		{messages.InfoDayOfYear, strconv.Itoa(info.DayOfYear)},
+		{messages.InfoQuarter, strconv.Itoa(info.Quarter)},
+		{messages.InfoFromToday, relative},
+		{messages.InfoLeapYear, leapYear},
+		{messages.InfoHolidays, holidays},
+	}
+	width := 0
+	for _, f := range fields {
+		width = max(width, utf8.RuneCountInString(translate(f.label)))
+	}
+	lines := make([]string, len(fields))
+	for i, f := range fields {
+		label := translate(f.label) + ":"
+		lines[i] = label + strings.Repeat(" ", width+3-utf8.RuneCountInString(label)) + f.value
+	}
+	return lines
+}
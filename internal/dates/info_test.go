@@ -0,0 +1,142 @@
+package dates
+
+import (
+	"pdate/internal/constants"
+	"pdate/internal/job"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewInfo(t *testing.T) {
+	today := time.Date(2025, 10, 19, 0, 0, 0, 0, time.UTC)
+	j := &job.Job{
+		Format:       constants.DefaultInputFormat,
+		Language:     job.German,
+		Holidays:     []time.Time{time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC)},
+		HolidayNames: []string{"Weihnachten", "", "Stephanstag"},
+	}
+	info, err := NewInfo(time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), today, j)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := Info{
+		Date:          "2025-12-25",
+		Weekday:       "Donnerstag",
+		ISOWeek:       "2025-W52",
+		DayOfYear:     359,
+		Quarter:       4,
+		DaysFromToday: 67,
+		LeapYear:      false,
+		Holidays:      []string{"Weihnachten", "2025-12-25"},
+	}
+	if !reflect.DeepEqual(info, want) {
+		t.Errorf("NewInfo() = %+v, expected %+v", info, want)
+	}
+}
+
+func TestInfoLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     time.Time
+		expected []string
+	}{
+		{
+			name: "leap day in the past",
+			date: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			expected: []string{
+				"Date:         2024-02-29",
+				"Weekday:      Thursday",
+				"ISO week:     2024-W09",
+				"Day of year:  60",
+				"Quarter:      1",
+				"From today:   598 days ago",
+				"Leap year:    yes",
+				"Holidays:     -",
+			},
+		},
+		{
+			name: "ISO week of the next year",
+			date: time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC),
+			expected: []string{
+				"Date:         2025-12-29",
+				"Weekday:      Monday",
+				"ISO week:     2026-W01",
+				"Day of year:  363",
+				"Quarter:      4",
+				"From today:   in 71 days",
+				"Leap year:    no",
+				"Holidays:     -",
+			},
+		},
+		{
+			name: "tomorrow",
+			date: time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
+			expected: []string{
+				"Date:         2025-10-20",
+				"Weekday:      Monday",
+				"ISO week:     2025-W43",
+				"Day of year:  293",
+				"Quarter:      4",
+				"From today:   tomorrow",
+				"Leap year:    no",
+				"Holidays:     -",
+			},
+		},
+	}
+
+	today := time.Date(2025, 10, 19, 0, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := NewInfo(tt.date, today, &job.Job{Format: constants.DefaultInputFormat, Language: job.English})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := info.Lines("en"); !slices.Equal(got, tt.expected) {
+				t.Errorf("Lines() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestInfoLinesTranslated(t *testing.T) {
+	info := Info{Date: "2025-12-25", Weekday: "Donnerstag", ISOWeek: "2025-W52", DayOfYear: 359, Quarter: 4, DaysFromToday: -3, Holidays: []string{"Weihnachten"}}
+	expected := []string{
+		"Datum:           2025-12-25",
+		"Wochentag:       Donnerstag",
+		"ISO-Woche:       2025-W52",
+		"Tag des Jahres:  359",
+		"Quartal:         4",
+		"Ab heute:        vor 3 Tagen",
+		"Schaltjahr:      nein",
+		"Feiertage:       Weihnachten",
+	}
+	if got := info.Lines("de-CH"); !slices.Equal(got, expected) {
+		t.Errorf("Lines() = %q, expected %q", got, expected)
+	}
+}
+
+func TestGetInfoJSON(t *testing.T) {
+	j := &job.Job{
+		DatesInput: []time.Time{time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		Format:     "{DD}.{MM}.{YYYY}",
+		Language:   job.English,
+		Location:   time.UTC,
+		JSON:       true,
+	}
+	result, err := GetInfo(j)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := slices.Collect(result)
+	if len(got) != 1 {
+		t.Fatalf("expected a single JSON record, got %v", got)
+	}
+	for _, field := range []string{`"date": "29.02.2024"`, `"iso_week": "2024-W09"`, `"leap_year": true`, `"holidays": []`} {
+		if !strings.Contains(got[0], field) {
+			t.Errorf("expected %s in %s", field, got[0])
+		}
+	}
+}
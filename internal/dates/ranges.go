@@ -0,0 +1,131 @@
+package dates
+
+import (
+	"iter"
+	"pdate/internal/job"
+	"time"
+)
+
+// Union merges two sequences which are sorted in the same direction,
+// ascending if forward is true and descending otherwise, and yields every
+// date once. It streams, so one of the sequences can be endless like the one
+// of --count.
+func Union(a iter.Seq[time.Time], b iter.Seq[time.Time], forward bool) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		nextA, stopA := iter.Pull(a)
+		defer stopA()
+		nextB, stopB := iter.Pull(b)
+		defer stopB()
+		x, okA := nextA()
+		y, okB := nextB()
+		for okA || okB {
+			switch {
+			case !okB || okA && comesFirst(x, y, forward):
+				if !yield(x) {
+					return
+				}
+				x, okA = nextA()
+			case !okA || comesFirst(y, x, forward):
+				if !yield(y) {
+					return
+				}
+				y, okB = nextB()
+			default:
+				if !yield(x) {
+					return
+				}
+				x, okA = nextA()
+				y, okB = nextB()
+			}
+		}
+	}
+}
+
+// Intersect keeps the dates which are in one of the ranges.
+func Intersect(dates iter.Seq[time.Time], ranges []job.Range, step job.Step) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		for date := range dates {
+			if InRanges(date, ranges, step) && !yield(date) {
+				return
+			}
+		}
+	}
+}
+
+// Minus removes the dates which are in one of the ranges.
+func Minus(dates iter.Seq[time.Time], ranges []job.Range, step job.Step) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		for date := range dates {
+			if !InRanges(date, ranges, step) && !yield(date) {
+				return
+			}
+		}
+	}
+}
+
+// InRanges reports whether the date is in one of the ranges. Like the end
+// of a range, the ends count as calendar days for steps of days or weeks
+// and as exact times for smaller steps.
+func InRanges(date time.Time, ranges []job.Range, step job.Step) bool {
+	for _, r := range ranges {
+		if !IsPastEnd(r.From, date, step) && !IsPastEnd(date, r.To, step) {
+			return true
+		}
+	}
+	return false
+}
+
+func comesFirst(a time.Time, b time.Time, forward bool) bool {
+	if forward {
+		return a.Before(b)
+	}
+	return a.After(b)
+}
+
+// GetRangeDates steps through every range and merges the dates into one
+// sorted sequence without duplicates. Steps of weeks start at the first day
+// of a week in every range, like GetAllWeeks does for a single range.
+func GetRangeDates(ranges []job.Range, step job.Step, firstDay time.Weekday, forward bool) iter.Seq[time.Time] {
+	var merged iter.Seq[time.Time] = func(yield func(time.Time) bool) {}
+	for _, r := range ranges {
+		dates := GetTimesFromTo(r.From, r.To, step)
+		if step.Unit == job.Week {
+			dates = GetWeeksFromTo(r.From, r.To, step, firstDay)
+		}
+		if !forward {
+			dates = ReverseOrder(dates)
+		}
+		merged = Union(merged, dates, forward)
+	}
+	return merged
+}
+
+// GetInputDates steps through the ranges of --stdin in the order they are
+// read, without sorting them or removing duplicates.
+func GetInputDates(ranges iter.Seq[job.Range], step job.Step) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		for r := range ranges {
+			for date := range GetTimesFromTo(r.From, r.To, step) {
+				if !yield(date) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ApplySetOperations adds the dates of --union to the dates, then keeps the
+// ones in the ranges of --intersect and removes the ones in the ranges of
+// --minus.
+func ApplySetOperations(dates iter.Seq[time.Time], j *job.Job, firstDay time.Weekday, forward bool) iter.Seq[time.Time] {
+	if len(j.Union) > 0 {
+		dates = Union(dates, GetRangeDates(j.Union, j.Step, firstDay, forward), forward)
+	}
+	if len(j.Intersect) > 0 {
+		dates = Intersect(dates, j.Intersect, j.Step)
+	}
+	if len(j.Minus) > 0 {
+		dates = Minus(dates, j.Minus, j.Step)
+	}
+	return dates
+}
@@ -0,0 +1,115 @@
+package dates
+
+import (
+	"pdate/internal/job"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestUnion(t *testing.T) {
+	a := []time.Time{october(1), october(3), october(5)}
+	b := []time.Time{october(2), october(3), october(6)}
+
+	got := slices.Collect(Union(slices.Values(a), slices.Values(b), true))
+	want := []time.Time{october(1), october(2), october(3), october(5), october(6)}
+	if !slices.Equal(got, want) {
+		t.Errorf("Union() = %v, want %v", got, want)
+	}
+
+	slices.Reverse(a)
+	slices.Reverse(b)
+	slices.Reverse(want)
+	got = slices.Collect(Union(slices.Values(a), slices.Values(b), false))
+	if !slices.Equal(got, want) {
+		t.Errorf("Union() backwards = %v, want %v", got, want)
+	}
+}
+
+func TestUnionStopsEarly(t *testing.T) {
+	endless := GetTimesFrom(october(1), job.Step{Amount: 2, Unit: job.Day}, true)
+	ranges := GetRangeDates([]job.Range{{From: october(2), To: october(4)}}, job.Step{Amount: 1, Unit: job.Day}, time.Monday, true)
+
+	var got []time.Time
+	for date := range Union(endless, ranges, true) {
+		got = append(got, date)
+		if len(got) == 5 {
+			break
+		}
+	}
+
+	want := []time.Time{october(1), october(2), october(3), october(4), october(5)}
+	if !slices.Equal(got, want) {
+		t.Errorf("Union() = %v, want %v", got, want)
+	}
+}
+
+func TestIntersectAndMinus(t *testing.T) {
+	dates := days(1, 10)
+	ranges := []job.Range{{From: october(2), To: october(3)}, {From: october(9), To: october(20)}}
+	day := job.Step{Amount: 1, Unit: job.Day}
+
+	got := slices.Collect(Intersect(slices.Values(dates), ranges, day))
+	want := []time.Time{october(2), october(3), october(9), october(10)}
+	if !slices.Equal(got, want) {
+		t.Errorf("Intersect() = %v, want %v", got, want)
+	}
+
+	got = slices.Collect(Minus(slices.Values(dates), ranges, day))
+	want = []time.Time{october(1), october(4), october(5), october(6), october(7), october(8)}
+	if !slices.Equal(got, want) {
+		t.Errorf("Minus() = %v, want %v", got, want)
+	}
+}
+
+func TestInRanges(t *testing.T) {
+	ranges := []job.Range{{From: october(2), To: october(3)}}
+	tests := []struct {
+		name string
+		date time.Time
+		step job.Step
+		want bool
+	}{
+		{"Start", october(2), job.Step{Amount: 1, Unit: job.Day}, true},
+		{"Time on the last day", october(3).Add(15 * time.Hour), job.Step{Amount: 1, Unit: job.Day}, true},
+		{"Day after", october(4), job.Step{Amount: 1, Unit: job.Day}, false},
+		{"Time after the end for steps of hours", october(3).Add(15 * time.Hour), job.Step{Amount: 1, Unit: job.Hour}, false},
+		{"End for steps of hours", october(3), job.Step{Amount: 1, Unit: job.Hour}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InRanges(tt.date, ranges, tt.step); got != tt.want {
+				t.Errorf("InRanges(%v) = %v, want %v", tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetRangeDates(t *testing.T) {
+	ranges := []job.Range{
+		{From: october(8), To: october(10)},
+		{From: october(1), To: october(2)},
+		{From: october(2), To: october(3)},
+	}
+	day := job.Step{Amount: 1, Unit: job.Day}
+
+	got := slices.Collect(GetRangeDates(ranges, day, time.Monday, true))
+	want := []time.Time{october(1), october(2), october(3), october(8), october(9), october(10)}
+	if !slices.Equal(got, want) {
+		t.Errorf("GetRangeDates() = %v, want %v", got, want)
+	}
+
+	got = slices.Collect(GetRangeDates(ranges, day, time.Monday, false))
+	slices.Reverse(want)
+	if !slices.Equal(got, want) {
+		t.Errorf("GetRangeDates() backwards = %v, want %v", got, want)
+	}
+
+	weeks := []job.Range{{From: october(1), To: october(31)}}
+	got = slices.Collect(GetRangeDates(weeks, job.Step{Amount: 1, Unit: job.Week}, time.Monday, true))
+	want = []time.Time{october(6), october(13), october(20), october(27)}
+	if !slices.Equal(got, want) {
+		t.Errorf("GetRangeDates() of weeks = %v, want %v", got, want)
+	}
+}
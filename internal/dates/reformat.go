@@ -0,0 +1,219 @@
+package dates
+
+import (
+	"pdate/internal/job"
+	"pdate/internal/locale"
+	"pdate/internal/messages"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// inputField is the part of a date a placeholder of --in reads.
+type inputField int
+
+const (
+	yearField inputField = iota
+	shortYearField
+	monthField
+	dayField
+	weekdayField
+	hourField
+	minuteField
+	secondField
+	fieldCount
+)
+
+// inputPlaceholder reads a number with the digits pattern or one of the
+// names, whose position is the value. Months count from one, and the names
+// of a locale may list them more than once, like the genitive names after
+// the names on their own.
+type inputPlaceholder struct {
+	field  inputField
+	digits string
+	names  func(l *locale.Locale) []string
+}
+
+var inputPlaceholders = map[string]inputPlaceholder{
+	"YYYY": {field: yearField, digits: `\d{4}`},
+	"YY":   {field: shortYearField, digits: `\d{2}`},
+	"MM":   {field: monthField, digits: `\d{2}`},
+	"M":    {field: monthField, digits: `\d{1,2}`},
+	"DD":   {field: dayField, digits: `\d{2}`},
+	"D":    {field: dayField, digits: `\d{1,2}`},
+	"hh":   {field: hourField, digits: `\d{2}`},
+	"mm":   {field: minuteField, digits: `\d{2}`},
+	"ss":   {field: secondField, digits: `\d{2}`},
+	"MN":   {field: monthField, names: func(l *locale.Locale) []string { return slices.Concat(l.MonthNames, l.MonthGenitiveNames) }},
+	"mn":   {field: monthField, names: func(l *locale.Locale) []string { return l.MonthAbbreviations }},
+	"WD":   {field: weekdayField, names: func(l *locale.Locale) []string { return l.WeekdayNames }},
+	"wd":   {field: weekdayField, names: func(l *locale.Locale) []string { return l.WeekdayAbbreviations }},
+}
+
+// inputGroup is a placeholder of --in with the names it reads, which are
+// nil for numbers.
+type inputGroup struct {
+	field inputField
+	names []string
+}
+
+// Reformatter finds the dates of the --in format in a text and replaces
+// them with the dates in the -f format. Names in the text are read in the
+// language of -l or in English, so that English texts can be translated.
+type Reformatter struct {
+	pattern *regexp.Regexp
+	groups  []inputGroup
+	// digitsAtStart and digitsAtEnd tell whether the format starts or ends
+	// with a number, which mustn't be part of a longer one in the text.
+	digitsAtStart bool
+	digitsAtEnd   bool
+	format        Format
+	options       FormatOptions
+	index         int
+}
+
+func NewReformatter(j *job.Job) (*Reformatter, error) {
+	in, err := ConvertFormat(j.InputFormat, j.FormatStyle)
+	if err != nil {
+		return nil, err
+	}
+	compiledIn, err := CompileFormat(in)
+	if err != nil {
+		return nil, err
+	}
+	out, err := ConvertFormat(j.Format, j.FormatStyle)
+	if err != nil {
+		return nil, err
+	}
+	format, err := CompileFormat(out)
+	if err != nil {
+		return nil, err
+	}
+	r := &Reformatter{format: format, options: FormatOptions{j.Language, j.Calendar, j.Digits, j.WeekStart, j.Location}}
+	locales := []*locale.Locale{locale.Get(string(j.Language)), locale.Get(string(job.English))}
+	if err := r.compileInput(compiledIn, locales); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// compileInput turns the tokens of the --in format into a regular
+// expression with a group for every placeholder. Names are looked up in the
+// locales in order.
+func (r *Reformatter) compileInput(in Format, locales []*locale.Locale) error {
+	var pattern strings.Builder
+	var found [fieldCount]bool
+	for i, t := range in.tokens {
+		if t.placeholder == nil {
+			pattern.WriteString(regexp.QuoteMeta(t.literal))
+			continue
+		}
+		p, supported := inputPlaceholders[t.name]
+		if !supported || len(t.modifiers) > 0 {
+			return messages.UnsupportedInputPlaceholder
+		}
+		found[p.field] = true
+		group := inputGroup{field: p.field}
+		if p.names == nil {
+			pattern.WriteString("(" + p.digits + ")")
+			r.digitsAtStart = r.digitsAtStart || i == 0
+			r.digitsAtEnd = i == len(in.tokens)-1
+		} else {
+			for _, l := range locales {
+				group.names = append(group.names, p.names(l)...)
+			}
+			pattern.WriteString("((?i:" + namesPattern(group.names) + "))")
+		}
+		r.groups = append(r.groups, group)
+	}
+	if !(found[yearField] || found[shortYearField]) || !found[monthField] || !found[dayField] {
+		return messages.IncompleteInputFormat
+	}
+	r.pattern = regexp.MustCompile(pattern.String())
+	return nil
+}
+
+// namesPattern matches one of the names, the longest first so that a name
+// isn't cut at one of its prefixes.
+func namesPattern(names []string) string {
+	sorted := slices.Clone(names)
+	slices.SortStableFunc(sorted, func(a string, b string) int { return len(b) - len(a) })
+	for i, name := range sorted {
+		sorted[i] = regexp.QuoteMeta(name)
+	}
+	return strings.Join(sorted, "|")
+}
+
+// Replace replaces every date of the text and leaves the rest as it is.
+// Matches which aren't a valid date, like 2025-02-30, stay unchanged. {idx}
+// counts the replaced dates over all texts.
+func (r *Reformatter) Replace(text string) string {
+	var buf []byte
+	replaced := false
+	last := 0
+	for _, match := range r.pattern.FindAllStringSubmatchIndex(text, -1) {
+		date, valid := r.read(text, match)
+		if !valid {
+			continue
+		}
+		r.index++
+		buf = append(buf, text[last:match[0]]...)
+		buf = r.format.AppendTo(buf, date, r.index, r.options)
+		last = match[1]
+		replaced = true
+	}
+	if !replaced {
+		return text
+	}
+	return string(append(buf, text[last:]...))
+}
+
+// read reads the date of a match. Placeholders of the same field have to
+// agree and a weekday has to be the one of the date.
+func (r *Reformatter) read(text string, match []int) (time.Time, bool) {
+	if r.digitsAtStart && match[0] > 0 && isDigit(text[match[0]-1]) {
+		return time.Time{}, false
+	}
+	if r.digitsAtEnd && match[1] < len(text) && isDigit(text[match[1]]) {
+		return time.Time{}, false
+	}
+	var values [fieldCount]int
+	var found [fieldCount]bool
+	for i, g := range r.groups {
+		value, valid := g.read(text[match[2+2*i]:match[3+2*i]])
+		if !valid || found[g.field] && values[g.field] != value {
+			return time.Time{}, false
+		}
+		values[g.field], found[g.field] = value, true
+	}
+	year := values[yearField]
+	if !found[yearField] {
+		year = 2000 + values[shortYearField]
+	}
+	month, day := values[monthField], values[dayField]
+	hour, minute, second := values[hourField], values[minuteField], values[secondField]
+	date := time.Date(year, time.Month(month), day, hour, minute, second, 0, time.UTC)
+	valid := year >= 1 && date.Year() == year && int(date.Month()) == month && date.Day() == day &&
+		hour < 24 && minute < 60 && second < 60
+	if found[weekdayField] && int(date.Weekday()) != values[weekdayField] {
+		return time.Time{}, false
+	}
+	return date, valid
+}
+
+func (g inputGroup) read(value string) (int, bool) {
+	if g.names == nil {
+		number, err := strconv.Atoi(value)
+		return number, err == nil
+	}
+	i := slices.IndexFunc(g.names, func(name string) bool { return strings.EqualFold(name, value) })
+	if i < 0 {
+		return 0, false
+	}
+	if g.field == monthField {
+		return i%12 + 1, true
+	}
+	return i % 7, true
+}
@@ -0,0 +1,99 @@
+package dates
+
+import (
+	"errors"
+	"pdate/internal/job"
+	"pdate/internal/messages"
+	"testing"
+)
+
+func TestReformatter(t *testing.T) {
+	tests := []struct {
+		name     string
+		job      job.Job
+		text     string
+		expected string
+	}{
+		{
+			name:     "ISO dates in German",
+			job:      job.Job{InputFormat: "{YYYY}-{MM}-{DD}", Format: "{D}. {MN} {YYYY}", Language: job.German},
+			text:     "Released on 2025-10-05 (see #12), fixed on 2025-12-24.\n",
+			expected: "Released on 5. Oktober 2025 (see #12), fixed on 24. Dezember 2025.\n",
+		},
+		{
+			name:     "invalid dates and longer numbers stay",
+			job:      job.Job{InputFormat: "{YYYY}-{MM}-{DD}", Format: "{DD}.{MM}."},
+			text:     "2025-02-30 12025-01-01 2025-01-011 2025-01-01T10:00",
+			expected: "2025-02-30 12025-01-01 2025-01-011 01.01.T10:00",
+		},
+		{
+			name:     "English names ignore case",
+			job:      job.Job{InputFormat: "{WD}, {MN} {D}, {YYYY}", Format: "{idx} {YYYY}-{MM}-{DD}"},
+			text:     "sunday, OCTOBER 5, 2025 and Monday, October 5, 2025 and Monday, May 5, 2025",
+			expected: "1 2025-10-05 and Monday, October 5, 2025 and 2 2025-05-05",
+		},
+		{
+			name:     "German names",
+			job:      job.Job{InputFormat: "{wd}, {D}. {MN} {YYYY}", Format: "{YYYY}-{MM}-{DD}", Language: job.German},
+			text:     "Mo., 3. März 2025 und Mittwoch, 3. märz 2025",
+			expected: "2025-03-03 und Mittwoch, 3. märz 2025",
+		},
+		{
+			name:     "genitive names in Russian",
+			job:      job.Job{InputFormat: "{D} {MN} {YYYY}", Format: "{YYYY}-{MM}-{DD}", Language: job.Russian},
+			text:     "3 марта 2025, 3 март 2025, 3 March 2025",
+			expected: "2025-03-03, 2025-03-03, 2025-03-03",
+		},
+		{
+			name:     "short years and times",
+			job:      job.Job{InputFormat: "{D}/{M}/{YY} {hh}:{mm}", Format: "{YYYY}-{MM}-{DD}T{hh}:{mm}"},
+			text:     "from 1/2/25 09:30 to 1/2/25 24:00",
+			expected: "from 2025-02-01T09:30 to 1/2/25 24:00",
+		},
+		{
+			name:     "strftime style",
+			job:      job.Job{InputFormat: "%d.%m.%Y", Format: "%Y-%m-%d", FormatStyle: job.Strftime},
+			text:     "Stand: 31.12.2025",
+			expected: "Stand: 2025-12-31",
+		},
+		{
+			name:     "text without dates",
+			job:      job.Job{InputFormat: "{YYYY}-{MM}-{DD}", Format: "{DD}"},
+			text:     "no dates in here",
+			expected: "no dates in here",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reformatter, err := NewReformatter(&tt.job)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := reformatter.Replace(tt.text); got != tt.expected {
+				t.Errorf("Replace() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewReformatterErrors(t *testing.T) {
+	tests := []struct {
+		format string
+		want   error
+	}{
+		{"{YYYY}-{MM}", messages.IncompleteInputFormat},
+		{"{YYYY}-{MM}-{DD} {Q}", messages.UnsupportedInputPlaceholder},
+		{"{YYYY}-{MM}-{DD:pad3}", messages.UnsupportedInputPlaceholder},
+		{"{YYYY}-{MM}-{XX}", messages.UnknownPlaceholder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			_, err := NewReformatter(&job.Job{InputFormat: tt.format, Format: "{DD}"})
+			if !errors.Is(err, tt.want) {
+				t.Errorf("NewReformatter(%q) returned %v, want %v", tt.format, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package dates
+
+import (
+	"iter"
+	"math/rand/v2"
+	"pdate/internal/job"
+	"slices"
+	"time"
+)
+
+// Selection holds the options which pick dates by their position after
+// filtering. They are applied in the order --offset, --every, --first,
+// --last and --sample, zero turns an option off.
+type Selection struct {
+	Offset int
+	Every  int
+	First  int
+	Last   int
+	Sample int
+	Seed   uint64
+}
+
+// NewSelection takes the selection from the job. Without --seed the sample
+// is drawn with a random seed.
+func NewSelection(j *job.Job) Selection {
+	seed := uint64(j.Seed)
+	if !j.Seeded {
+		seed = rand.Uint64()
+	}
+	return Selection{j.Offset, j.Every, j.First, j.Last, j.Sample, seed}
+}
+
+// Select applies the selection to the dates. --last and --sample have to
+// read the whole sequence, but only hold the dates they keep.
+func Select(dates iter.Seq[time.Time], s Selection) iter.Seq[time.Time] {
+	if s.Offset > 0 || s.Every > 1 {
+		dates = Every(dates, max(s.Every, 1), s.Offset)
+	}
+	if s.First > 0 {
+		dates = Limit(dates, s.First)
+	}
+	if s.Last > 0 {
+		dates = Last(dates, s.Last)
+	}
+	if s.Sample > 0 {
+		dates = Sample(dates, s.Sample, s.Seed)
+	}
+	return dates
+}
+
+// Every skips the first offset dates and then keeps every nth date,
+// starting with the first one after the offset.
+func Every(dates iter.Seq[time.Time], n int, offset int) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		i := 0
+		for date := range dates {
+			position := i - offset
+			i++
+			if position >= 0 && position%n == 0 && !yield(date) {
+				return
+			}
+		}
+	}
+}
+
+// Last keeps the last n dates in a ring buffer.
+func Last(dates iter.Seq[time.Time], n int) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		ring := make([]time.Time, 0, n)
+		next := 0
+		for date := range dates {
+			if len(ring) < n {
+				ring = append(ring, date)
+				continue
+			}
+			ring[next] = date
+			next = (next + 1) % n
+		}
+		for i := range ring {
+			if !yield(ring[(next+i)%len(ring)]) {
+				return
+			}
+		}
+	}
+}
+
+// sampled is a date of a sample with its position in the sequence.
+type sampled struct {
+	position int
+	date     time.Time
+}
+
+// Sample draws n dates with reservoir sampling and yields them in the order
+// of the sequence. The same seed draws the same dates from the same
+// sequence.
+func Sample(dates iter.Seq[time.Time], n int, seed uint64) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		random := rand.New(rand.NewPCG(seed, 0))
+		reservoir := make([]sampled, 0, n)
+		i := 0
+		for date := range dates {
+			if len(reservoir) < n {
+				reservoir = append(reservoir, sampled{i, date})
+			} else if r := random.IntN(i + 1); r < n {
+				reservoir[r] = sampled{i, date}
+			}
+			i++
+		}
+		slices.SortFunc(reservoir, func(a sampled, b sampled) int { return a.position - b.position })
+		for _, s := range reservoir {
+			if !yield(s.date) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package dates
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func october(day int) time.Time {
+	return time.Date(2025, 10, day, 0, 0, 0, 0, time.UTC)
+}
+
+// days returns the days of October 2025 from the first to the last day.
+func days(first int, last int) []time.Time {
+	var dates []time.Time
+	for day := first; day <= last; day++ {
+		dates = append(dates, october(day))
+	}
+	return dates
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		name      string
+		selection Selection
+		want      []time.Time
+	}{
+		{
+			name:      "Nothing selected",
+			selection: Selection{},
+			want:      days(1, 10),
+		},
+		{
+			name:      "Every third",
+			selection: Selection{Every: 3},
+			want:      []time.Time{october(1), october(4), october(7), october(10)},
+		},
+		{
+			name:      "Offset",
+			selection: Selection{Offset: 7},
+			want:      days(8, 10),
+		},
+		{
+			name:      "Every third after an offset",
+			selection: Selection{Every: 3, Offset: 1},
+			want:      []time.Time{october(2), october(5), october(8)},
+		},
+		{
+			name:      "First",
+			selection: Selection{First: 2},
+			want:      days(1, 2),
+		},
+		{
+			name:      "Last",
+			selection: Selection{Last: 3},
+			want:      days(8, 10),
+		},
+		{
+			name:      "Last of more dates than there are",
+			selection: Selection{Last: 20},
+			want:      days(1, 10),
+		},
+		{
+			name:      "First and then last",
+			selection: Selection{First: 5, Last: 2},
+			want:      days(4, 5),
+		},
+		{
+			name:      "Sample of more dates than there are",
+			selection: Selection{Sample: 20},
+			want:      days(1, 10),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Collect(Select(slices.Values(days(1, 10)), tt.selection))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Select() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSample(t *testing.T) {
+	dates := days(1, 31)
+	first := slices.Collect(Sample(slices.Values(dates), 5, 42))
+	second := slices.Collect(Sample(slices.Values(dates), 5, 42))
+	if len(first) != 5 || !slices.Equal(first, second) {
+		t.Fatalf("expected the same 5 dates for the same seed, got %v and %v", first, second)
+	}
+	if !slices.IsSortedFunc(first, func(a time.Time, b time.Time) int { return a.Compare(b) }) {
+		t.Errorf("expected the sample in the order of the dates, got %v", first)
+	}
+	other := slices.Collect(Sample(slices.Values(dates), 5, 7))
+	if slices.Equal(first, other) {
+		t.Errorf("expected another sample for another seed, got %v twice", first)
+	}
+}
+
+func TestEveryStopsEarly(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	var got []time.Time
+	for date := range Every(GetDatesFromTo(from, to), 7, 2) {
+		got = append(got, date)
+		if len(got) == 2 {
+			break
+		}
+	}
+
+	want := []time.Time{time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)}
+	if !slices.Equal(got, want) {
+		t.Errorf("Every() = %v, want %v", got, want)
+	}
+}
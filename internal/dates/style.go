@@ -0,0 +1,141 @@
+package dates
+
+import (
+	"pdate/internal/job"
+	"pdate/internal/messages"
+	"strings"
+)
+
+var strftimeToPlaceholder = map[string]string{
+	"Y":  "{YYYY}",
+	"y":  "{YY}",
+	"m":  "{MM}",
+	"-m": "{M}",
+	"d":  "{DD}",
+	"-d": "{D}",
+	"a":  "{wd}",
+	"A":  "{WD}",
+	"b":  "{mn}",
+	"h":  "{mn}",
+	"B":  "{MN}",
+	"F":  "{YYYY}-{MM}-{DD}",
+	"D":  "{MM}/{DD}/{YY}",
+	"j":  "{DOY}",
+	"V":  "{WW}",
+	"G":  "{GGGG}",
+	"u":  "{N}",
+	"q":  "{Q}",
+	"s":  "{U}",
+	"H":  "{hh}",
+	"M":  "{mm}",
+	"S":  "{ss}",
+	"I":  "{HH12}",
+	"p":  "{AMPM}",
+	"T":  "{hh}:{mm}:{ss}",
+	"R":  "{hh}:{mm}",
+	":z": "{Z}",
+	"n":  "\n",
+	"t":  "\t",
+	"%":  "%",
+}
+
+// goLayoutElements is ordered so that longer elements are matched before
+// their prefixes. An empty placeholder marks an element pdate can't print.
+var goLayoutElements = []struct {
+	element     string
+	placeholder string
+}{
+	{"January", "{MN}"},
+	{"Jan", "{mn}"},
+	{"Monday", "{WD}"},
+	{"Mon", "{wd}"},
+	{"MST", ""},
+	{"2006", "{YYYY}"},
+	{"002", "{DOY}"},
+	{"01", "{MM}"},
+	{"02", "{DD}"},
+	{"03", "{HH12}"},
+	{"04", "{mm}"},
+	{"05", "{ss}"},
+	{"06", "{YY}"},
+	{"15", "{hh}"},
+	{"__2", ""},
+	{"_2", ""},
+	{"1", "{M}"},
+	{"2", "{D}"},
+	{"3", ""},
+	{"4", ""},
+	{"5", ""},
+	{"PM", "{AMPM}"},
+	{"pm", "{AMPM:lower}"},
+	{"-07:00", "{Z}"},
+	{"Z07", ""},
+	{"-07", ""},
+}
+
+func ConvertFormat(format string, style job.FormatStyle) (string, error) {
+	switch style {
+	case job.Strftime:
+		return StrftimeToPlaceholders(format)
+	case job.GoLayout:
+		return GoLayoutToPlaceholders(format)
+	default:
+		return format, nil
+	}
+}
+
+func StrftimeToPlaceholders(format string) (string, error) {
+	var result strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			WriteEscaped(&result, format[i])
+			continue
+		}
+		directive := ""
+		if i+1 < len(format) {
+			directive = format[i+1 : i+2]
+		}
+		if (directive == "-" || directive == ":") && i+2 < len(format) {
+			directive = format[i+1 : i+3]
+		}
+		placeholder, found := strftimeToPlaceholder[directive]
+		if !found {
+			return "", messages.UnsupportedStrftime
+		}
+		result.WriteString(placeholder)
+		i += len(directive)
+	}
+	return result.String(), nil
+}
+
+func GoLayoutToPlaceholders(layout string) (string, error) {
+	var result strings.Builder
+	for i := 0; i < len(layout); {
+		matched := false
+		for _, e := range goLayoutElements {
+			if strings.HasPrefix(layout[i:], e.element) {
+				if e.placeholder == "" {
+					return "", messages.UnsupportedGoLayout
+				}
+				result.WriteString(e.placeholder)
+				i += len(e.element)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			WriteEscaped(&result, layout[i])
+			i++
+		}
+	}
+	return result.String(), nil
+}
+
+// WriteEscaped writes a literal character so that the placeholder compiler
+// doesn't read it as the start or end of a placeholder.
+func WriteEscaped(result *strings.Builder, c byte) {
+	if c == '{' || c == '}' {
+		result.WriteByte(c)
+	}
+	result.WriteByte(c)
+}
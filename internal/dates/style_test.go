@@ -0,0 +1,183 @@
+package dates
+
+import (
+	"errors"
+	"pdate/internal/job"
+	"testing"
+)
+
+func TestStrftimeToPlaceholders(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  error
+	}{
+		{
+			name:     "ISO date",
+			input:    "%Y-%m-%d",
+			expected: "{YYYY}-{MM}-{DD}",
+		},
+		{
+			name:     "Names and unpadded numbers",
+			input:    "%A, %-d %B %y (%a %b)",
+			expected: "{WD}, {D} {MN} {YY} ({wd} {mn})",
+		},
+		{
+			name:     "Shortcuts and escapes",
+			input:    "%F %D 100%%",
+			expected: "{YYYY}-{MM}-{DD} {MM}/{DD}/{YY} 100%",
+		},
+		{
+			name:     "Week and day numbers",
+			input:    "%G-W%V-%u %j %q %s",
+			expected: "{GGGG}-W{WW}-{N} {DOY} {Q} {U}",
+		},
+		{
+			name:     "Braces are escaped",
+			input:    "{%Y}",
+			expected: "{{{YYYY}}}",
+		},
+		{
+			name:     "Time directives",
+			input:    "%H:%M:%S %I%p %T %R%:z",
+			expected: "{hh}:{mm}:{ss} {HH12}{AMPM} {hh}:{mm}:{ss} {hh}:{mm}{Z}",
+		},
+		{
+			name:    "Unsupported directive",
+			input:   "%Y %Q",
+			wantErr: errors.New("unsupported strftime directive found"),
+		},
+		{
+			name:    "Trailing percent sign",
+			input:   "%Y %",
+			wantErr: errors.New("unsupported strftime directive found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StrftimeToPlaceholders(tt.input)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("StrftimeToPlaceholders(%q) = %q; want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGoLayoutToPlaceholders(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  error
+	}{
+		{
+			name:     "ISO date",
+			input:    "2006-01-02",
+			expected: "{YYYY}-{MM}-{DD}",
+		},
+		{
+			name:     "Names and unpadded numbers",
+			input:    "Monday, 2 January 06 (Mon Jan 1)",
+			expected: "{WD}, {D} {MN} {YY} ({wd} {mn} {M})",
+		},
+		{
+			name:     "Offset of the timezone",
+			input:    "15:04-07:00",
+			expected: "{hh}:{mm}{Z}",
+		},
+		{
+			name:     "Day of year",
+			input:    "2006.002",
+			expected: "{YYYY}.{DOY}",
+		},
+		{
+			name:     "Literal text is kept",
+			input:    "Day: 02.01.",
+			expected: "Day: {DD}.{MM}.",
+		},
+		{
+			name:     "Braces are escaped",
+			input:    "{2006}",
+			expected: "{{{YYYY}}}",
+		},
+		{
+			name:     "Time elements",
+			input:    "2006-01-02 15:04:05 03PM pm",
+			expected: "{YYYY}-{MM}-{DD} {hh}:{mm}:{ss} {HH12}{AMPM} {AMPM:lower}",
+		},
+		{
+			name:    "Timezones are not supported",
+			input:   "2006-01-02 15:04 MST",
+			wantErr: errors.New("unsupported go layout element found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := GoLayoutToPlaceholders(tt.input)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("GoLayoutToPlaceholders(%q) = %q; want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		style    job.FormatStyle
+		expected string
+	}{
+		{
+			name:     "Placeholder style is untouched",
+			input:    "{YYYY} %Y 2006",
+			style:    job.Placeholder,
+			expected: "{YYYY} %Y 2006",
+		},
+		{
+			name:     "Strftime style",
+			input:    "%d.%m.%Y",
+			style:    job.Strftime,
+			expected: "{DD}.{MM}.{YYYY}",
+		},
+		{
+			name:     "Go style",
+			input:    "02.01.2006",
+			style:    job.GoLayout,
+			expected: "{DD}.{MM}.{YYYY}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ConvertFormat(tt.input, tt.style)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("ConvertFormat(%q, %v) = %q; want %q", tt.input, tt.style, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package dates
+
+import (
+	"iter"
+	"pdate/internal/job"
+	"time"
+)
+
+// All dates are handled as the midnight of their calendar day in UTC. UTC
+// has no daylight saving time, so stepping from one day to the next can
+// never skip or repeat a day. Steps smaller than a day go through real
+// instants in the timezone of --tz instead, so that they skip the hour lost
+// when the clocks go forward and repeat the one gained when they go back.
+
+// CivilDate drops the time and zone of t but keeps its calendar day.
+func CivilDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// Today returns the calendar day of now as seen in loc. A nil location is
+// the local one.
+func Today(now time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.Local
+	}
+	return CivilDate(now.In(loc))
+}
+
+// WallClock returns now in loc, cut to the minute.
+func WallClock(now time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.Local
+	}
+	local := now.In(loc)
+	return local.Add(-time.Duration(local.Second())*time.Second - time.Duration(local.Nanosecond()))
+}
+
+// InZone reads the date and time of t, which are given in UTC like the dates
+// of the command line, as a time in loc. A time which loc skips is moved
+// forward by the gap, e.g. 02:30 to 03:30 when the clocks go forward.
+func InZone(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.Local
+	}
+	year, month, day := t.Date()
+	return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// Instant returns the instant of the date: a time of a sub-day step already
+// is one, a calendar day in UTC is its midnight in loc.
+func Instant(date time.Time, loc *time.Location) time.Time {
+	if date.Location() != time.UTC || loc == nil {
+		return date
+	}
+	return InZone(date, loc)
+}
+
+// InZoneJob returns a copy of the job whose dates and ranges are read as
+// times in its location, for steps smaller than a day.
+func InZoneJob(j *job.Job) *job.Job {
+	zoned := *j
+	zoned.DatesInput = make([]time.Time, len(j.DatesInput))
+	for i, date := range j.DatesInput {
+		zoned.DatesInput[i] = InZone(date, j.Location)
+	}
+	zoned.Ranges = rangesInZone(j.Ranges, j.Location)
+	zoned.Union = rangesInZone(j.Union, j.Location)
+	zoned.Intersect = rangesInZone(j.Intersect, j.Location)
+	zoned.Minus = rangesInZone(j.Minus, j.Location)
+	if j.Input != nil {
+		zoned.Input = zonedInput{j.Input, j.Location}
+	}
+	return &zoned
+}
+
+func rangesInZone(ranges []job.Range, loc *time.Location) []job.Range {
+	var zoned []job.Range
+	for _, r := range ranges {
+		zoned = append(zoned, job.Range{From: InZone(r.From, loc), To: InZone(r.To, loc)})
+	}
+	return zoned
+}
+
+// zonedInput reads the ranges of the input as times in loc.
+type zonedInput struct {
+	job.Input
+	loc *time.Location
+}
+
+func (in zonedInput) Ranges() iter.Seq[job.Range] {
+	return func(yield func(job.Range) bool) {
+		for r := range in.Input.Ranges() {
+			if !yield(job.Range{From: InZone(r.From, in.loc), To: InZone(r.To, in.loc)}) {
+				return
+			}
+		}
+	}
+}
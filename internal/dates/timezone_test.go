@@ -0,0 +1,176 @@
+package dates
+
+import (
+	"slices"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("couldn't load timezone %s: %v", name, err)
+	}
+	return loc
+}
+
+func TestToday(t *testing.T) {
+	tests := []struct {
+		name     string
+		now      time.Time
+		zone     string
+		expected time.Time
+	}{
+		{
+			name:     "Zurich is already on the next day",
+			now:      time.Date(2025, 3, 29, 23, 30, 0, 0, time.UTC),
+			zone:     "Europe/Zurich",
+			expected: time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Zurich after the spring forward",
+			now:      time.Date(2025, 3, 30, 1, 30, 0, 0, time.UTC),
+			zone:     "Europe/Zurich",
+			expected: time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Zurich in the repeated hour of the fall back",
+			now:      time.Date(2025, 10, 26, 1, 30, 0, 0, time.UTC),
+			zone:     "Europe/Zurich",
+			expected: time.Date(2025, 10, 26, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "New York is still on the previous day",
+			now:      time.Date(2025, 11, 2, 4, 30, 0, 0, time.UTC),
+			zone:     "America/New_York",
+			expected: time.Date(2025, 11, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "New York before the fall back at midnight",
+			now:      time.Date(2025, 11, 2, 3, 59, 0, 0, time.UTC),
+			zone:     "America/New_York",
+			expected: time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Santiago skips midnight when DST starts",
+			now:      time.Date(2025, 9, 7, 4, 0, 0, 0, time.UTC),
+			zone:     "America/Santiago",
+			expected: time.Date(2025, 9, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Lord Howe Island with a half hour DST shift",
+			now:      time.Date(2025, 4, 5, 13, 15, 0, 0, time.UTC),
+			zone:     "Australia/Lord_Howe",
+			expected: time.Date(2025, 4, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Samoa skipped the 30th of December 2011",
+			now:      time.Date(2011, 12, 30, 10, 0, 0, 0, time.UTC),
+			zone:     "Pacific/Apia",
+			expected: time.Date(2011, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Kiritimati is fourteen hours ahead",
+			now:      time.Date(2025, 12, 31, 10, 0, 0, 0, time.UTC),
+			zone:     "Pacific/Kiritimati",
+			expected: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Today(tt.now, mustLoadLocation(t, tt.zone))
+			if !result.Equal(tt.expected) {
+				t.Errorf("Today() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTodayWithoutLocation(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	if !Today(now, nil).Equal(Today(now, time.Local)) {
+		t.Error("expected a missing location to be the local one")
+	}
+}
+
+func TestGetDatesFromToAcrossDST(t *testing.T) {
+	tests := []struct {
+		name string
+		zone string
+		from time.Time
+		to   time.Time
+		size int
+	}{
+		{
+			name: "Zurich spring forward",
+			zone: "Europe/Zurich",
+			from: time.Date(2025, 3, 29, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+			size: 4,
+		},
+		{
+			name: "Zurich fall back",
+			zone: "Europe/Zurich",
+			from: time.Date(2025, 10, 24, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2025, 10, 28, 0, 0, 0, 0, time.UTC),
+			size: 5,
+		},
+		{
+			name: "New York fall back",
+			zone: "America/New_York",
+			from: time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2025, 11, 4, 0, 0, 0, 0, time.UTC),
+			size: 5,
+		},
+		{
+			name: "Santiago spring forward at midnight",
+			zone: "America/Santiago",
+			from: time.Date(2025, 9, 5, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2025, 9, 9, 0, 0, 0, 0, time.UTC),
+			size: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := mustLoadLocation(t, tt.zone)
+			// Midnight in the zone, like a date which was resolved there.
+			from := time.Date(tt.from.Year(), tt.from.Month(), tt.from.Day(), 0, 0, 0, 0, loc)
+			to := time.Date(tt.to.Year(), tt.to.Month(), tt.to.Day(), 0, 0, 0, 0, loc)
+
+			result := slices.Collect(GetDatesFromTo(from, to))
+
+			if len(result) != tt.size {
+				t.Fatalf("expected %d dates, got %d: %v", tt.size, len(result), result)
+			}
+			for i, date := range result {
+				expected := tt.from.AddDate(0, 0, i)
+				if !date.Equal(expected) {
+					t.Errorf("date %d = %v, expected %v", i, date, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestCivilDate(t *testing.T) {
+	zurich := mustLoadLocation(t, "Europe/Zurich")
+	input := time.Date(2025, 10, 26, 23, 59, 0, 0, zurich)
+	expected := time.Date(2025, 10, 26, 0, 0, 0, 0, time.UTC)
+	if result := CivilDate(input); !result.Equal(expected) {
+		t.Errorf("CivilDate() = %v, expected %v", result, expected)
+	}
+}
+
+func TestWallClock(t *testing.T) {
+	zurich := mustLoadLocation(t, "Europe/Zurich")
+	now := time.Date(2025, 10, 26, 1, 30, 45, 0, time.UTC)
+	expected := time.Date(2025, 10, 26, 1, 30, 0, 0, time.UTC)
+	result := WallClock(now, zurich)
+	if !result.Equal(expected) || result.Hour() != 2 {
+		t.Errorf("WallClock() = %v, expected the second 02:30 of %v", result, expected)
+	}
+}
@@ -0,0 +1,134 @@
+package dates
+
+import (
+	"iter"
+	"pdate/internal/job"
+	"pdate/internal/locale"
+	"time"
+)
+
+var weekStartDays = map[job.WeekStart]time.Weekday{
+	job.MondayStart:   time.Monday,
+	job.SundayStart:   time.Sunday,
+	job.SaturdayStart: time.Saturday,
+}
+
+// weekStartMinimalDays are the days week 1 needs in the new year for the
+// weeks of --week-start: the ISO weeks of Europe for Monday and the weeks of
+// the US and the Middle East, where week 1 holds January 1, for the others.
+var weekStartMinimalDays = map[job.WeekStart]int{
+	job.MondayStart:   4,
+	job.SundayStart:   1,
+	job.SaturdayStart: 1,
+}
+
+var weekendProfiles = map[job.Weekend][]time.Weekday{
+	job.SaturdaySundayWeekend: {time.Saturday, time.Sunday},
+	job.FridaySaturdayWeekend: {time.Friday, time.Saturday},
+	job.FridayWeekend:         {time.Friday},
+	job.SundayWeekend:         {time.Sunday},
+}
+
+// FirstDayOfWeek returns the day weeks start on, the one chosen with
+// --week-start or else the one of the locale.
+func FirstDayOfWeek(start job.WeekStart, l *locale.Locale) time.Weekday {
+	if day, found := weekStartDays[start]; found {
+		return day
+	}
+	return l.FirstDay
+}
+
+// MinimalDaysOfWeek returns the days the first week of a year needs in that
+// year, the ones that go with --week-start or else the ones of the locale,
+// so that an override doesn't mix up the numbering of two regions.
+func MinimalDaysOfWeek(start job.WeekStart, l *locale.Locale) int {
+	if days, found := weekStartMinimalDays[start]; found {
+		return days
+	}
+	return l.MinimalDays
+}
+
+// WeekendDays returns the days of the weekend, the ones of the --weekend
+// profile or else the days from the start to the end of the weekend of the
+// locale.
+func WeekendDays(weekend job.Weekend, l *locale.Locale) []time.Weekday {
+	if days, found := weekendProfiles[weekend]; found {
+		return days
+	}
+	days := []time.Weekday{l.WeekendStart}
+	for day := l.WeekendStart; day != l.WeekendEnd; {
+		day = (day + 1) % 7
+		days = append(days, day)
+	}
+	return days
+}
+
+// LocaleWeek returns the week-numbering year and the week of the date for
+// weeks starting on firstDay. Week 1 is the first week with at least
+// minimalDays days in the year, so Monday and 4 give the ISO weeks and
+// Sunday and 1 the weeks of the US, where week 1 holds January 1.
+func LocaleWeek(date time.Time, firstDay time.Weekday, minimalDays int) (year int, week int) {
+	day := CivilDate(date)
+	year = day.Year()
+	start := firstWeekStart(year, firstDay, minimalDays)
+	if day.Before(start) {
+		year--
+		start = firstWeekStart(year, firstDay, minimalDays)
+	} else if next := firstWeekStart(year+1, firstDay, minimalDays); !day.Before(next) {
+		year++
+		start = next
+	}
+	return year, int(day.Sub(start).Hours())/(24*7) + 1
+}
+
+// firstWeekStart returns the first day of week 1 of the year, which is the
+// week holding day minimalDays of January.
+func firstWeekStart(year int, firstDay time.Weekday, minimalDays int) time.Time {
+	day := time.Date(year, time.January, max(minimalDays, 1), 0, 0, 0, 0, time.UTC)
+	return day.AddDate(0, 0, -daysSinceWeekStart(day, firstDay))
+}
+
+func daysSinceWeekStart(date time.Time, firstDay time.Weekday) int {
+	return (int(date.Weekday()) - int(firstDay) + 7) % 7
+}
+
+// AlignToWeek moves the date to the first day of a week, forward to the
+// next one unless it already is one, or backward if forward is false. The
+// time of the date is kept.
+func AlignToWeek(date time.Time, firstDay time.Weekday, forward bool) time.Time {
+	days := daysSinceWeekStart(date, firstDay)
+	if days == 0 {
+		return date
+	}
+	if forward {
+		return date.AddDate(0, 0, 7-days)
+	}
+	return date.AddDate(0, 0, -days)
+}
+
+// GetAllWeeks resolves the range of the dates like GetAllDates does and
+// steps through it like GetWeeksFromTo.
+func GetAllWeeks(dates []time.Time, now time.Time, step job.Step, firstDay time.Weekday) iter.Seq[time.Time] {
+	from, to := now, now
+	switch len(dates) {
+	case 2:
+		from, to = dates[0], dates[1]
+	case 1:
+		from = dates[0]
+	}
+	return GetWeeksFromTo(from, to, step, firstDay)
+}
+
+// GetWeeksFromTo steps through the range from its first day of a week. A
+// range which holds no first day of a week has no dates, instead of one
+// which isn't the start of a week.
+func GetWeeksFromTo(from time.Time, to time.Time, step job.Step, firstDay time.Weekday) iter.Seq[time.Time] {
+	if to.Before(from) {
+		from, to = to, from
+	}
+	start := AlignToWeek(from, firstDay, true)
+	if IsPastEnd(start, to, step) {
+		return func(yield func(time.Time) bool) {}
+	}
+	return GetTimesFromTo(start, to, step)
+}
@@ -0,0 +1,185 @@
+package dates
+
+import (
+	"pdate/internal/job"
+	"pdate/internal/locale"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestLocaleWeek(t *testing.T) {
+	tests := []struct {
+		name        string
+		date        time.Time
+		firstDay    time.Weekday
+		minimalDays int
+		year        int
+		week        int
+	}{
+		{"ISO week of January 1", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), time.Monday, 4, 2020, 53},
+		{"ISO week of December 30", time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), time.Monday, 4, 2025, 1},
+		{"US week of January 1", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), time.Sunday, 1, 2021, 1},
+		{"US week at the end of December", time.Date(2025, 12, 28, 0, 0, 0, 0, time.UTC), time.Sunday, 1, 2026, 1},
+		{"US week before the end of December", time.Date(2025, 12, 27, 0, 0, 0, 0, time.UTC), time.Sunday, 1, 2025, 52},
+		{"Saturday weeks", time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC), time.Saturday, 1, 2025, 2},
+		{"Monday weeks with one day", time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC), time.Monday, 1, 2026, 1},
+		{"Time of day is ignored", time.Date(2025, 1, 5, 23, 59, 0, 0, time.UTC), time.Sunday, 1, 2025, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year, week := LocaleWeek(tt.date, tt.firstDay, tt.minimalDays)
+			if year != tt.year || week != tt.week {
+				t.Errorf("expected %d-W%02d, got %d-W%02d", tt.year, tt.week, year, week)
+			}
+		})
+	}
+}
+
+func TestLocaleWeekMatchesISOWeek(t *testing.T) {
+	for date := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC); date.Year() < 2030; date = date.AddDate(0, 0, 1) {
+		isoYear, isoWeek := date.ISOWeek()
+		if year, week := LocaleWeek(date, time.Monday, 4); year != isoYear || week != isoWeek {
+			t.Fatalf("%s: expected %d-W%02d, got %d-W%02d", date.Format(time.DateOnly), isoYear, isoWeek, year, week)
+		}
+	}
+}
+
+func TestLocaleWeekPlaceholders(t *testing.T) {
+	date := time.Date(2025, 12, 28, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		language  job.Language
+		weekStart job.WeekStart
+		expected  string
+	}{
+		{"en-US", job.DefaultWeekStart, "2026-01 2025-W52"},
+		{"de", job.DefaultWeekStart, "2025-52 2025-W52"},
+		{"de", job.SundayStart, "2026-01 2025-W52"},
+		{"en-US", job.MondayStart, "2025-52 2025-W52"},
+		{"ar-EG", job.DefaultWeekStart, "2026-01 2025-W52"},
+	}
+
+	for _, tt := range tests {
+		options := FormatOptions{Language: tt.language, Digits: job.LatinDigits, WeekStart: tt.weekStart}
+		result, err := ReplaceDatePlaceholdersWithDate("{gggg}-{ww} {GGGG}-W{WW}", date, 1, options)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result != tt.expected {
+			t.Errorf("%s/%v: expected %s, got %s", tt.language, tt.weekStart, tt.expected, result)
+		}
+	}
+}
+
+func TestFirstDayOfWeek(t *testing.T) {
+	us, _ := locale.Lookup("en-US")
+	if day := FirstDayOfWeek(job.DefaultWeekStart, us); day != time.Sunday {
+		t.Errorf("expected the first day of the locale, got %v", day)
+	}
+	if day := FirstDayOfWeek(job.SaturdayStart, us); day != time.Saturday {
+		t.Errorf("expected --week-start to win, got %v", day)
+	}
+}
+
+func TestMinimalDaysOfWeek(t *testing.T) {
+	us, _ := locale.Lookup("en-US")
+	de, _ := locale.Lookup("de")
+	tests := []struct {
+		l         *locale.Locale
+		weekStart job.WeekStart
+		expected  int
+	}{
+		{us, job.DefaultWeekStart, 1},
+		{de, job.DefaultWeekStart, 4},
+		{us, job.MondayStart, 4},
+		{de, job.SundayStart, 1},
+		{de, job.SaturdayStart, 1},
+	}
+
+	for _, tt := range tests {
+		if days := MinimalDaysOfWeek(tt.weekStart, tt.l); days != tt.expected {
+			t.Errorf("%s/%v: expected %d, got %d", tt.l.Tag, tt.weekStart, tt.expected, days)
+		}
+	}
+}
+
+func TestWeekendDays(t *testing.T) {
+	tests := []struct {
+		tag      string
+		weekend  job.Weekend
+		expected []time.Weekday
+	}{
+		{"de", job.DefaultWeekend, []time.Weekday{time.Saturday, time.Sunday}},
+		{"he", job.DefaultWeekend, []time.Weekday{time.Friday, time.Saturday}},
+		{"ar-SA", job.DefaultWeekend, []time.Weekday{time.Friday, time.Saturday}},
+		{"fa", job.DefaultWeekend, []time.Weekday{time.Friday}},
+		{"hi", job.DefaultWeekend, []time.Weekday{time.Sunday}},
+		{"he", job.SaturdaySundayWeekend, []time.Weekday{time.Saturday, time.Sunday}},
+		{"de", job.FridaySaturdayWeekend, []time.Weekday{time.Friday, time.Saturday}},
+		{"de", job.FridayWeekend, []time.Weekday{time.Friday}},
+		{"de", job.SundayWeekend, []time.Weekday{time.Sunday}},
+	}
+
+	for _, tt := range tests {
+		l, _ := locale.Lookup(tt.tag)
+		if days := WeekendDays(tt.weekend, l); !slices.Equal(days, tt.expected) {
+			t.Errorf("%s/%v: expected %v, got %v", tt.tag, tt.weekend, tt.expected, days)
+		}
+	}
+}
+
+func TestAlignToWeek(t *testing.T) {
+	thursday := time.Date(2025, 10, 2, 8, 0, 0, 0, time.UTC)
+	if date := AlignToWeek(thursday, time.Monday, true); !date.Equal(time.Date(2025, 10, 6, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the next Monday, got %v", date)
+	}
+	if date := AlignToWeek(thursday, time.Monday, false); !date.Equal(time.Date(2025, 9, 29, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the previous Monday, got %v", date)
+	}
+	if date := AlignToWeek(thursday, time.Thursday, true); !date.Equal(thursday) {
+		t.Errorf("expected the date itself, got %v", date)
+	}
+}
+
+func TestGetWeeksFromTo(t *testing.T) {
+	weekly := job.Step{Amount: 1, Unit: job.Week}
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		step     job.Step
+		expected []time.Time
+	}{
+		{
+			name:     "range without a first day of a week",
+			from:     time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC),
+			step:     weekly,
+			expected: nil,
+		},
+		{
+			name:     "range without a first day of a week in reverse",
+			from:     time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			step:     job.Step{Amount: 2, Unit: job.Week},
+			expected: nil,
+		},
+		{
+			name:     "range ending on a first day of a week",
+			from:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC),
+			step:     weekly,
+			expected: []time.Time{time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Collect(GetWeeksFromTo(tt.from, tt.to, tt.step, time.Monday))
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("GetWeeksFromTo() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
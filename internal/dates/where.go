@@ -0,0 +1,435 @@
+package dates
+
+import (
+	"iter"
+	"pdate/internal/messages"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Where is a compiled --where expression like "weekday in (mo,fr) and day
+// <= 7 and not holiday". It's parsed once by CompileWhere and then evaluated
+// for every date.
+type Where struct {
+	condition func(date time.Time) bool
+}
+
+// WhereContext holds the days the names holiday and weekend of an
+// expression stand for.
+type WhereContext struct {
+	Holidays []time.Time
+	Weekend  []time.Weekday
+}
+
+// whereExpr is a compiled part of an expression, either a number or a
+// condition. The kinds are checked while compiling, so numbers are never
+// used as conditions. Numbers which are weekdays or months have a kind, and
+// a weekday or month code is only compared with a number of its kind.
+type whereExpr struct {
+	number    func(date time.Time) int
+	condition func(date time.Time) bool
+	kind      whereKind
+	constant  bool
+}
+
+// whereKind tells whether a number is a weekday, a month or any other
+// number.
+type whereKind int
+
+const (
+	plainKind whereKind = iota
+	weekdayKind
+	monthKind
+)
+
+// whereConstant is a weekday or month code with its number.
+type whereConstant struct {
+	value int
+	kind  whereKind
+}
+
+// whereRange is an entry of the set after in, a single number has the same
+// start and end. Ranges with a start after the end wrap around, e.g. nov..feb.
+type whereRange struct {
+	from int
+	to   int
+}
+
+var whereNumbers = map[string]func(date time.Time) int{
+	"year":        func(date time.Time) int { return date.Year() },
+	"month":       func(date time.Time) int { return int(date.Month()) },
+	"day":         func(date time.Time) int { return date.Day() },
+	"weekday":     ISOWeekday,
+	"week":        func(date time.Time) int { _, week := date.ISOWeek(); return week },
+	"quarter":     func(date time.Time) int { return (int(date.Month()) + 2) / 3 },
+	"doy":         func(date time.Time) int { return date.YearDay() },
+	"hour":        func(date time.Time) int { return date.Hour() },
+	"minute":      func(date time.Time) int { return date.Minute() },
+	"second":      func(date time.Time) int { return date.Second() },
+	"daysinmonth": DaysInMonth,
+	"nthweekday":  func(date time.Time) int { return (date.Day()-1)/7 + 1 },
+}
+
+var whereConditions = map[string]func(date time.Time) bool{
+	"isleapyear":            func(date time.Time) bool { return DaysInYear(date) == 366 },
+	"islastdayofmonth":      func(date time.Time) bool { return date.Day() == DaysInMonth(date) },
+	"isfirstweekdayofmonth": func(date time.Time) bool { return date.Day() <= 7 },
+	"islastweekdayofmonth":  func(date time.Time) bool { return date.Day()+7 > DaysInMonth(date) },
+}
+
+// whereNumberKinds are the kinds of the numbers which are weekdays or
+// months.
+var whereNumberKinds = map[string]whereKind{
+	"weekday": weekdayKind,
+	"month":   monthKind,
+}
+
+// whereConstants are the weekday codes, numbered like weekday from 1
+// (Monday) to 7 (Sunday), and the month codes.
+var whereConstants = map[string]whereConstant{
+	"mo": {1, weekdayKind}, "tu": {2, weekdayKind}, "we": {3, weekdayKind}, "th": {4, weekdayKind},
+	"fr": {5, weekdayKind}, "sa": {6, weekdayKind}, "su": {7, weekdayKind},
+	"jan": {1, monthKind}, "feb": {2, monthKind}, "mar": {3, monthKind}, "apr": {4, monthKind},
+	"may": {5, monthKind}, "jun": {6, monthKind}, "jul": {7, monthKind}, "aug": {8, monthKind},
+	"sep": {9, monthKind}, "oct": {10, monthKind}, "nov": {11, monthKind}, "dec": {12, monthKind},
+}
+
+var whereComparisons = map[string]func(a int, b int) bool{
+	"=":  func(a int, b int) bool { return a == b },
+	"==": func(a int, b int) bool { return a == b },
+	"!=": func(a int, b int) bool { return a != b },
+	"<":  func(a int, b int) bool { return a < b },
+	"<=": func(a int, b int) bool { return a <= b },
+	">":  func(a int, b int) bool { return a > b },
+	">=": func(a int, b int) bool { return a >= b },
+}
+
+var whereKeywords = []string{"and", "or", "not", "in"}
+
+// CompileWhere compiles an expression of the grammar
+//
+//	or         = and { "or" and }
+//	and        = not { "and" not }
+//	not        = "not" not | comparison
+//	comparison = operand [ operator operand | [ "not" ] "in" set ]
+//	operand    = number | name [ "()" ] | "(" or ")"
+//	set        = "(" constant [ ".." constant ] { "," constant [ ".." constant ] } ")"
+//
+// where names are the numbers, conditions and constants above and the
+// conditions holiday and weekend. Names and keywords ignore case.
+func CompileWhere(expression string, context WhereContext) (Where, error) {
+	tokens, err := lexWhere(expression)
+	if err != nil {
+		return Where{}, err
+	}
+	p := whereParser{tokens: tokens, context: context}
+	e, err := p.parseOr()
+	if err != nil {
+		return Where{}, err
+	}
+	if p.pos < len(p.tokens) {
+		return Where{}, messages.InvalidExpression
+	}
+	return Where{e.condition}, nil
+}
+
+// Matches reports whether the date fulfills the expression.
+func (w Where) Matches(date time.Time) bool {
+	return w.condition(date)
+}
+
+// FilterWhere keeps the dates which fulfill the expression. It streams like
+// IgnoreWeekdays and IncludeOnly, the zero Where keeps every date.
+func FilterWhere(dates iter.Seq[time.Time], where Where) iter.Seq[time.Time] {
+	if where.condition == nil {
+		return dates
+	}
+	return func(yield func(time.Time) bool) {
+		for date := range dates {
+			if where.Matches(date) && !yield(date) {
+				return
+			}
+		}
+	}
+}
+
+// lexWhere splits an expression into numbers, names, operators and
+// parentheses.
+func lexWhere(expression string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		end := i + 1
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+			continue
+		case isDigit(c):
+			for end < len(expression) && isDigit(expression[end]) {
+				end++
+			}
+		case isLetter(c):
+			for end < len(expression) && (isLetter(expression[end]) || isDigit(expression[end])) {
+				end++
+			}
+		case strings.ContainsRune("=!<>.", rune(c)) && end < len(expression) && isTwoCharOperator(expression[i:end+1]):
+			end++
+		case strings.ContainsRune("=<>(),", rune(c)):
+		default:
+			return nil, messages.InvalidExpression
+		}
+		tokens = append(tokens, expression[i:end])
+		i = end
+	}
+	return tokens, nil
+}
+
+func isTwoCharOperator(s string) bool {
+	return s == "==" || s == "!=" || s == "<=" || s == ">=" || s == ".."
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_'
+}
+
+type whereParser struct {
+	tokens  []string
+	pos     int
+	context WhereContext
+}
+
+func (p *whereParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *whereParser) next() string {
+	token := p.peek()
+	p.pos++
+	return token
+}
+
+// keyword skips the next token if it is the keyword.
+func (p *whereParser) keyword(word string) bool {
+	if strings.EqualFold(p.peek(), word) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *whereParser) parseOr() (whereExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return whereExpr{}, err
+	}
+	for p.keyword("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return whereExpr{}, err
+		}
+		l, r := left.condition, right.condition
+		left = whereExpr{condition: func(date time.Time) bool { return l(date) || r(date) }}
+	}
+	return left, nil
+}
+
+func (p *whereParser) parseAnd() (whereExpr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return whereExpr{}, err
+	}
+	for p.keyword("and") {
+		right, err := p.parseNot()
+		if err != nil {
+			return whereExpr{}, err
+		}
+		l, r := left.condition, right.condition
+		left = whereExpr{condition: func(date time.Time) bool { return l(date) && r(date) }}
+	}
+	return left, nil
+}
+
+// parseNot parses a negated condition or a comparison, which has to be a
+// condition as well.
+func (p *whereParser) parseNot() (whereExpr, error) {
+	if p.keyword("not") {
+		e, err := p.parseNot()
+		if err != nil {
+			return whereExpr{}, err
+		}
+		condition := e.condition
+		return whereExpr{condition: func(date time.Time) bool { return !condition(date) }}, nil
+	}
+	e, err := p.parseComparison()
+	if err != nil {
+		return whereExpr{}, err
+	}
+	if e.condition == nil {
+		return whereExpr{}, messages.MismatchedTypes
+	}
+	return e, nil
+}
+
+func (p *whereParser) parseComparison() (whereExpr, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return whereExpr{}, err
+	}
+	if compare, found := whereComparisons[p.peek()]; found {
+		p.pos++
+		right, err := p.parseOperand()
+		if err != nil {
+			return whereExpr{}, err
+		}
+		if left.number == nil || right.number == nil || !sameKind(left, right) {
+			return whereExpr{}, messages.MismatchedTypes
+		}
+		l, r := left.number, right.number
+		return whereExpr{condition: func(date time.Time) bool { return compare(l(date), r(date)) }}, nil
+	}
+	negated := p.pos+1 < len(p.tokens) && strings.EqualFold(p.peek(), "not") &&
+		strings.EqualFold(p.tokens[p.pos+1], "in")
+	if negated {
+		p.pos++
+	}
+	if !p.keyword("in") {
+		return left, nil
+	}
+	if left.number == nil {
+		return whereExpr{}, messages.MismatchedTypes
+	}
+	set, err := p.parseSet(left.kind)
+	if err != nil {
+		return whereExpr{}, err
+	}
+	number := left.number
+	return whereExpr{condition: func(date time.Time) bool { return inSet(set, number(date)) != negated }}, nil
+}
+
+func (p *whereParser) parseOperand() (whereExpr, error) {
+	token := p.next()
+	if token == "(" {
+		e, err := p.parseOr()
+		if err != nil {
+			return whereExpr{}, err
+		}
+		if p.next() != ")" {
+			return whereExpr{}, messages.InvalidExpression
+		}
+		return e, nil
+	}
+	if number, err := strconv.Atoi(token); err == nil {
+		return whereExpr{number: func(time.Time) int { return number }}, nil
+	}
+	if !isWhereName(token) {
+		return whereExpr{}, messages.InvalidExpression
+	}
+	// Names can be written like functions, e.g. isLastWeekdayOfMonth().
+	if p.peek() == "(" && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1] == ")" {
+		p.pos += 2
+	}
+	return p.lookupName(strings.ToLower(token))
+}
+
+func (p *whereParser) lookupName(name string) (whereExpr, error) {
+	if number, found := whereNumbers[name]; found {
+		return whereExpr{number: number, kind: whereNumberKinds[name]}, nil
+	}
+	if condition, found := whereConditions[name]; found {
+		return whereExpr{condition: condition}, nil
+	}
+	if c, found := whereConstants[name]; found {
+		return whereExpr{number: func(time.Time) int { return c.value }, kind: c.kind, constant: true}, nil
+	}
+	switch name {
+	case "holiday":
+		holidays := map[time.Time]bool{}
+		for _, holiday := range p.context.Holidays {
+			holidays[CivilDate(holiday)] = true
+		}
+		return whereExpr{condition: func(date time.Time) bool { return holidays[CivilDate(date)] }}, nil
+	case "weekend":
+		weekend := p.context.Weekend
+		return whereExpr{condition: func(date time.Time) bool { return slices.Contains(weekend, date.Weekday()) }}, nil
+	}
+	return whereExpr{}, messages.UnknownName
+}
+
+// parseSet parses the set after in, whose codes have to be of the kind of
+// the number before in.
+func (p *whereParser) parseSet(kind whereKind) ([]whereRange, error) {
+	if p.next() != "(" {
+		return nil, messages.InvalidExpression
+	}
+	var set []whereRange
+	for {
+		from, err := p.parseConstant(kind)
+		if err != nil {
+			return nil, err
+		}
+		to := from
+		if p.peek() == ".." {
+			p.pos++
+			if to, err = p.parseConstant(kind); err != nil {
+				return nil, err
+			}
+		}
+		set = append(set, whereRange{from, to})
+		switch p.next() {
+		case ")":
+			return set, nil
+		case ",":
+		default:
+			return nil, messages.InvalidExpression
+		}
+	}
+}
+
+// parseConstant parses a number or a weekday or month code of a set.
+func (p *whereParser) parseConstant(kind whereKind) (int, error) {
+	token := p.next()
+	if number, err := strconv.Atoi(token); err == nil {
+		return number, nil
+	}
+	if c, found := whereConstants[strings.ToLower(token)]; found {
+		if c.kind != kind {
+			return 0, messages.MismatchedTypes
+		}
+		return c.value, nil
+	}
+	if isWhereName(token) {
+		return 0, messages.UnknownName
+	}
+	return 0, messages.InvalidExpression
+}
+
+// sameKind reports whether a weekday or month code on one side of a
+// comparison matches the kind of the other side. Plain numbers can be
+// compared with anything.
+func sameKind(a whereExpr, b whereExpr) bool {
+	return (!a.constant || a.kind == b.kind) && (!b.constant || b.kind == a.kind)
+}
+
+func isWhereName(token string) bool {
+	if token == "" || !isLetter(token[0]) {
+		return false
+	}
+	return !slices.ContainsFunc(whereKeywords, func(keyword string) bool { return strings.EqualFold(token, keyword) })
+}
+
+func inSet(set []whereRange, number int) bool {
+	for _, r := range set {
+		if r.from <= r.to && r.from <= number && number <= r.to {
+			return true
+		}
+		if r.from > r.to && (number >= r.from || number <= r.to) {
+			return true
+		}
+	}
+	return false
+}
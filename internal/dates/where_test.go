@@ -0,0 +1,174 @@
+package dates
+
+import (
+	"errors"
+	"pdate/internal/messages"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCompileWhere(t *testing.T) {
+	context := WhereContext{
+		Holidays: []time.Time{time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)},
+		Weekend:  []time.Weekday{time.Saturday, time.Sunday},
+	}
+	tests := []struct {
+		name       string
+		expression string
+		date       time.Time
+		want       bool
+	}{
+		{
+			name:       "Weekday in a set",
+			expression: "weekday in (mo,fr) and day <= 7",
+			date:       time.Date(2025, 12, 5, 0, 0, 0, 0, time.UTC),
+			want:       true,
+		},
+		{
+			name:       "Weekday not in a set",
+			expression: "weekday in (mo, fr)",
+			date:       time.Date(2025, 12, 4, 0, 0, 0, 0, time.UTC),
+			want:       false,
+		},
+		{
+			name:       "Holiday",
+			expression: "not holiday",
+			date:       time.Date(2025, 12, 25, 9, 30, 0, 0, time.UTC),
+			want:       false,
+		},
+		{
+			name:       "Weekend",
+			expression: "weekend or holiday",
+			date:       time.Date(2025, 12, 6, 0, 0, 0, 0, time.UTC),
+			want:       true,
+		},
+		{
+			name:       "Last Friday of the month as a function",
+			expression: "weekday == fr and isLastWeekdayOfMonth()",
+			date:       time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC),
+			want:       true,
+		},
+		{
+			name:       "Second Tuesday",
+			expression: "weekday = tu and nthWeekday = 2",
+			date:       time.Date(2025, 10, 14, 0, 0, 0, 0, time.UTC),
+			want:       true,
+		},
+		{
+			name:       "And binds stronger than or",
+			expression: "day = 1 or day = 2 and month = 1",
+			date:       time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			want:       true,
+		},
+		{
+			name:       "Parentheses",
+			expression: "(day = 1 or day = 2) and month = 1",
+			date:       time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			want:       false,
+		},
+		{
+			name:       "Range wrapping around the end of the year",
+			expression: "month in (nov..feb)",
+			date:       time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+			want:       true,
+		},
+		{
+			name:       "Not in",
+			expression: "quarter not in (1..3) AND week != 52",
+			date:       time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+			want:       true,
+		},
+		{
+			name:       "Codes and plain numbers of the same kind",
+			expression: "weekday = 5 and fr = weekday and month in (1, feb..mar) and day != month",
+			date:       time.Date(2025, 2, 7, 0, 0, 0, 0, time.UTC),
+			want:       true,
+		},
+		{
+			name:       "Time of the day",
+			expression: "hour >= 9 and hour < 17 and minute = 30",
+			date:       time.Date(2025, 10, 1, 16, 30, 0, 0, time.UTC),
+			want:       true,
+		},
+		{
+			name:       "Leap year and last day",
+			expression: "isLeapYear and isLastDayOfMonth and doy = 60",
+			date:       time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			want:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			where, err := CompileWhere(tt.expression, context)
+			if err != nil {
+				t.Fatalf("CompileWhere(%q) returned %v", tt.expression, err)
+			}
+			if got := where.Matches(tt.date); got != tt.want {
+				t.Errorf("Matches(%v) = %v, want %v", tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileWhereErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       error
+	}{
+		{"", messages.InvalidExpression},
+		{"day <=", messages.InvalidExpression},
+		{"day = 1 and", messages.InvalidExpression},
+		{"(day = 1", messages.InvalidExpression},
+		{"day = 1)", messages.InvalidExpression},
+		{"day ! 1", messages.InvalidExpression},
+		{"weekday in mo", messages.InvalidExpression},
+		{"weekday in (mo fr)", messages.InvalidExpression},
+		{"birthday", messages.UnknownName},
+		{"weekday in (xx)", messages.UnknownName},
+		{"day", messages.MismatchedTypes},
+		{"holiday > 1", messages.MismatchedTypes},
+		{"not day", messages.MismatchedTypes},
+		{"weekend in (1)", messages.MismatchedTypes},
+		{"month = mo", messages.MismatchedTypes},
+		{"mo = jan", messages.MismatchedTypes},
+		{"day = jan", messages.MismatchedTypes},
+		{"weekday in (jan..mar)", messages.MismatchedTypes},
+		{"month not in (mo, dec)", messages.MismatchedTypes},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			if _, err := CompileWhere(tt.expression, WhereContext{}); !errors.Is(err, tt.want) {
+				t.Errorf("CompileWhere(%q) returned %v, want %v", tt.expression, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterWhere(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	where, err := CompileWhere("weekday = mo and isFirstWeekdayOfMonth", WhereContext{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []time.Time
+	for date := range FilterWhere(GetDatesFromTo(from, to), where) {
+		got = append(got, date)
+		if len(got) == 3 {
+			break
+		}
+	}
+
+	want := []time.Time{
+		time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC),
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("FilterWhere() = %v, want %v", got, want)
+	}
+}
@@ -1,8 +1,9 @@
 package job
 
 import (
-	"errors"
+	"iter"
 	"pdate/internal/constants"
+	"pdate/internal/messages"
 	"time"
 )
 
@@ -14,23 +15,106 @@ const (
 	Option
 )
 
-type Language int
+// Language is a BCP 47 tag of one of the locales in internal/locale, like
+// de-CH or zh-Hant. The constants are the languages pdate has names for
+// beyond the locale database, e.g. of the months of other calendars.
+type Language string
 
 const (
-	English Language = iota
-	Spanish
-	French
-	German
-	Swiss
-	Italian
-	Portuguese
-	Dutch
-	Russian
-	Chinese
-	Arabic
-	Hindi
+	English    Language = "en"
+	Spanish    Language = "es"
+	French     Language = "fr"
+	German     Language = "de"
+	Swiss      Language = "gsw"
+	Italian    Language = "it"
+	Portuguese Language = "pt"
+	Dutch      Language = "nl"
+	Russian    Language = "ru"
+	Chinese    Language = "zh"
+	Arabic     Language = "ar"
+	Hindi      Language = "hi"
+	Japanese   Language = "ja"
+	Hebrew     Language = "he"
+	Persian    Language = "fa"
 )
 
+type FormatStyle int
+
+const (
+	Placeholder FormatStyle = iota
+	Strftime
+	GoLayout
+)
+
+type Calendar int
+
+const (
+	GregorianCalendar Calendar = iota
+	IslamicCalendar
+	HebrewCalendar
+	PersianCalendar
+	BuddhistCalendar
+	JapaneseCalendar
+	JulianCalendar
+	ChineseCalendar
+)
+
+type Digits int
+
+const (
+	DefaultDigits Digits = iota
+	LatinDigits
+	NativeDigits
+)
+
+// WeekStart is the first day of the week. DefaultWeekStart takes it from the
+// locale of the language.
+type WeekStart int
+
+const (
+	DefaultWeekStart WeekStart = iota
+	MondayStart
+	SundayStart
+	SaturdayStart
+)
+
+// Weekend is a profile of the weekdays --workdays-only leaves out.
+// DefaultWeekend takes the weekend of the locale of the language.
+type Weekend int
+
+const (
+	DefaultWeekend Weekend = iota
+	SaturdaySundayWeekend
+	FridaySaturdayWeekend
+	FridayWeekend
+	SundayWeekend
+)
+
+// Command is what pdate does. DatesCommand prints dates, the others are
+// chosen by a subcommand as the first argument.
+type Command int
+
+const (
+	DatesCommand Command = iota
+	ReformatCommand
+	InfoCommand
+)
+
+type StepUnit int
+
+const (
+	Day StepUnit = iota
+	Week
+	Hour
+	Minute
+	Second
+)
+
+type Step struct {
+	Amount int
+	Unit   StepUnit
+}
+
 type Job struct {
 	DatesInput      []time.Time
 	PosArguments    []Argument
@@ -40,33 +124,136 @@ type Job struct {
 	Version         bool
 	Help            bool
 	Language        Language
+	FormatStyle     FormatStyle
+	Count           int
+	Location        *time.Location
+	Step            Step
+	Calendar        Calendar
+	Digits          Digits
+	WeekStart       WeekStart
+	Weekend         Weekend
+	WorkdaysOnly    bool
+	// The include-only filters of --only, --days, --months, --weeks and
+	// --doy. Negative days count from the end of the month or year.
+	OnlyWeekdays   []time.Weekday
+	OnlyDays       []int
+	OnlyMonths     []time.Month
+	OnlyWeeks      []int
+	OnlyDaysOfYear []int
+	// Where is the --where expression, which is compiled when the dates are
+	// generated. Holidays are the days of --holidays it calls holiday,
+	// HolidayNames the text after each date in the file.
+	Where        string
+	Holidays     []time.Time
+	HolidayNames []string
+	// The selection of --offset, --every, --first, --last and --sample,
+	// zero turns an option off. Seeded tells whether --seed set the Seed.
+	Offset int
+	Every  int
+	First  int
+	Last   int
+	Sample int
+	Seed   int64
+	Seeded bool
+	// Ranges are the ranges given instead of the dates, like
+	// 2025-01-01..2025-01-31. The dates of --union are added to them, then
+	// the ones of --intersect are kept and the ones of --minus removed.
+	Ranges    []Range
+	Union     []Range
+	Intersect []Range
+	Minus     []Range
+	// Input holds the dates and ranges read with - or --stdin, which are
+	// printed in the order they are read.
+	Input Input
+	// Command is the subcommand, InputFormat the format of the dates
+	// reformat finds in the text given with --in. JSON prints the record of
+	// info as JSON.
+	Command     Command
+	InputFormat string
+	JSON        bool
+	// Grid prints the dates as a calendar of months with --grid.
+	Grid bool
+}
+
+// Input reads dates and ranges line by line. Like a bufio.Scanner, Ranges
+// stops at the first line which can't be read and Err returns its error.
+type Input interface {
+	Ranges() iter.Seq[Range]
+	Err() error
+}
+
+// Range is a range of dates from From to To, both are included.
+type Range struct {
+	From time.Time
+	To   time.Time
 }
 
 func New() *Job {
 	return &Job{
-		[]time.Time{},
-		[]Argument{},
-		[]time.Weekday{},
-		false,
-		constants.DefaultInputFormat,
-		false,
-		false,
-		English,
+		DatesInput:      []time.Time{},
+		PosArguments:    []Argument{},
+		IgnoredWeekdays: []time.Weekday{},
+		Format:          constants.DefaultInputFormat,
+		Language:        English,
+		Location:        time.Local,
+		Step:            Step{1, Day},
 	}
 }
 
 func Validate(job *Job) error {
 	if InvalidNumberOfDates(job) {
-		return errors.New("wrong number of dates provided")
+		return messages.WrongNumberOfDates
 	}
 	if DatesNextToEachOther(job) {
-		return errors.New("the two dates are not next to each other")
+		return messages.DatesNotNextToEachOther
 	}
 	if DatesBetweenOptions(job) {
-		return errors.New("dates are in between options")
+		return messages.DatesBetweenOptions
 	}
 	if DoubleWeekday(job) {
-		return errors.New("double weekdays for ignore -i flag detected")
+		return messages.DoubleWeekday
+	}
+	if CountWithEndDate(job) {
+		return messages.CountWithEndDate
+	}
+	if CountWithoutWeekdays(job) {
+		return messages.CountWithoutWeekdays
+	}
+	if SeedWithoutSample(job) {
+		return messages.SeedWithoutSample
+	}
+	if DatesWithRanges(job) {
+		return messages.DatesWithRanges
+	}
+	if StdinWithDates(job) {
+		return messages.StdinWithDates
+	}
+	if ReformatWithoutInputFormat(job) {
+		return messages.NoInputFormat
+	}
+	if InputFormatWithoutReformat(job) {
+		return messages.InputFormatWithoutReformat
+	}
+	if ReformatWithDates(job) {
+		return messages.ReformatWithDates
+	}
+	if InfoWithDates(job) {
+		return messages.InfoWithDates
+	}
+	if InfoWithCount(job) {
+		return messages.InfoWithCount
+	}
+	if InfoWithFilters(job) {
+		return messages.InfoWithFilters
+	}
+	if JSONWithoutInfo(job) {
+		return messages.JSONWithoutInfo
+	}
+	if GridWithCommand(job) {
+		return messages.GridWithCommand
+	}
+	if GridWithFormat(job) {
+		return messages.GridWithFormat
 	}
 	return nil
 }
@@ -78,17 +265,16 @@ func InvalidNumberOfDates(job *Job) bool {
 	return false
 }
 
+// DatesNextToEachOther reports whether the dates or ranges are split by
+// other arguments.
 func DatesNextToEachOther(job *Job) bool {
 	dateIndex := -1
 	for i, arg := range job.PosArguments {
 		if arg == Date {
-			if dateIndex != -1 {
-				if i-1 != dateIndex {
-					return true
-				}
-			} else {
-				dateIndex = i
+			if dateIndex != -1 && i-1 != dateIndex {
+				return true
 			}
+			dateIndex = i
 		}
 	}
 	return false
@@ -116,3 +302,89 @@ func DoubleWeekday(job *Job) bool {
 	}
 	return false
 }
+
+// CountWithEndDate reports whether --count is used with an end date, which
+// ranges have as well.
+func CountWithEndDate(job *Job) bool {
+	return job.Count > 0 && (len(job.DatesInput) > 1 || len(job.Ranges) > 0)
+}
+
+func CountWithoutWeekdays(job *Job) bool {
+	if job.Count == 0 {
+		return false
+	}
+	weekdays := make(map[time.Weekday]bool)
+	for _, wd := range job.IgnoredWeekdays {
+		weekdays[wd] = true
+	}
+	return len(weekdays) == 7
+}
+
+func SeedWithoutSample(job *Job) bool {
+	return job.Seeded && job.Sample == 0
+}
+
+func DatesWithRanges(job *Job) bool {
+	return len(job.DatesInput) > 0 && len(job.Ranges) > 0
+}
+
+// StdinWithDates reports whether dates are given besides the ones of
+// --stdin. --union would add dates out of order, so it's rejected as well.
+func StdinWithDates(job *Job) bool {
+	hasDates := len(job.DatesInput) > 0 || len(job.Ranges) > 0 || len(job.Union) > 0 || job.Count > 0
+	return job.Input != nil && hasDates
+}
+
+func ReformatWithoutInputFormat(job *Job) bool {
+	return job.Command == ReformatCommand && job.InputFormat == ""
+}
+
+func InputFormatWithoutReformat(job *Job) bool {
+	return job.Command != ReformatCommand && job.InputFormat != ""
+}
+
+// ReformatWithDates reports whether dates are given to reformat, which only
+// reads the dates of the text on standard input.
+func ReformatWithDates(job *Job) bool {
+	hasDates := len(job.DatesInput) > 0 || len(job.Ranges) > 0 || len(job.Union) > 0 ||
+		len(job.Intersect) > 0 || len(job.Minus) > 0 || job.Count > 0 || job.Input != nil
+	return job.Command == ReformatCommand && hasDates
+}
+
+// InfoWithDates reports whether info is given more than the single date it
+// describes.
+func InfoWithDates(job *Job) bool {
+	hasDates := len(job.DatesInput) > 1 || len(job.Ranges) > 0 || len(job.Union) > 0 ||
+		len(job.Intersect) > 0 || len(job.Minus) > 0 || job.Input != nil
+	return job.Command == InfoCommand && hasDates
+}
+
+func InfoWithCount(job *Job) bool {
+	return job.Command == InfoCommand && job.Count > 0
+}
+
+// InfoWithFilters reports whether info is given options which pick or order
+// dates, which do nothing for the single date it describes.
+func InfoWithFilters(job *Job) bool {
+	filters := len(job.IgnoredWeekdays) > 0 || job.WorkdaysOnly || job.Weekend != DefaultWeekend ||
+		len(job.OnlyWeekdays) > 0 || len(job.OnlyDays) > 0 || len(job.OnlyMonths) > 0 ||
+		len(job.OnlyWeeks) > 0 || len(job.OnlyDaysOfYear) > 0 || job.Where != ""
+	selections := job.Offset > 0 || job.Every > 0 || job.First > 0 || job.Last > 0 || job.Sample > 0 || job.Seeded
+	return job.Command == InfoCommand && (filters || selections || job.Reversed || job.Step.Amount > 1 || job.Step.Unit != Day)
+}
+
+func JSONWithoutInfo(job *Job) bool {
+	return job.JSON && job.Command != InfoCommand
+}
+
+func GridWithCommand(job *Job) bool {
+	return job.Grid && job.Command != DatesCommand
+}
+
+// GridWithFormat reports whether --grid is given options it can't follow:
+// the grid writes the days of Gregorian months in order instead of the -f
+// format.
+func GridWithFormat(job *Job) bool {
+	format := job.Format != constants.DefaultInputFormat
+	return job.Grid && (format || job.Calendar != GregorianCalendar || job.Reversed)
+}
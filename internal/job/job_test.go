@@ -1,6 +1,7 @@
 package job
 
 import (
+	"iter"
 	"pdate/internal/constants"
 	"testing"
 	"time"
@@ -42,6 +43,12 @@ func TestNew(t *testing.T) {
 	if j.Help {
 		t.Error("Expected default Help to be false")
 	}
+	if j.Count != 0 {
+		t.Error("Expected default Count to be 0")
+	}
+	if j.Step != (Step{1, Day}) {
+		t.Error("Expected default Step to be one day")
+	}
 }
 
 func TestInvalidNumberOfDates(t *testing.T) {
@@ -86,6 +93,171 @@ func TestDatesNextToEachOther(t *testing.T) {
 	if DatesNextToEachOther(job) {
 		t.Error("Expected false for single date")
 	}
+
+	// Three ranges next to each other
+	job = createJob(nil, []Argument{Date, Date, Date, Flag, Option}, nil)
+	if DatesNextToEachOther(job) {
+		t.Error("Expected false for ranges next to each other")
+	}
+
+	// Third range split off
+	job = createJob(nil, []Argument{Date, Date, Flag, Date}, nil)
+	if !DatesNextToEachOther(job) {
+		t.Error("Expected true for a range split off")
+	}
+}
+
+func TestDatesWithRanges(t *testing.T) {
+	ranges := []Range{{From: time.Now(), To: time.Now()}}
+
+	job := createJob([]time.Time{time.Now()}, nil, nil)
+	job.Ranges = ranges
+	if !DatesWithRanges(job) {
+		t.Error("Expected true for a date with a range")
+	}
+
+	job = createJob(nil, nil, nil)
+	job.Ranges = ranges
+	if DatesWithRanges(job) {
+		t.Error("Expected false for ranges only")
+	}
+
+	// A count can't be combined with ranges, which have an end
+	job.Count = 5
+	if !CountWithEndDate(job) {
+		t.Error("Expected true for count with a range")
+	}
+}
+
+func TestStdinWithDates(t *testing.T) {
+	job := createJob(nil, nil, nil)
+	job.Input = stdinInput{}
+	if StdinWithDates(job) {
+		t.Error("Expected false for stdin only")
+	}
+
+	job.Count = 3
+	if !StdinWithDates(job) {
+		t.Error("Expected true for stdin with a count")
+	}
+
+	job = createJob(nil, nil, nil)
+	job.Input = stdinInput{}
+	job.Union = []Range{{From: time.Now(), To: time.Now()}}
+	if !StdinWithDates(job) {
+		t.Error("Expected true for stdin with a union")
+	}
+}
+
+// stdinInput is an Input without any lines.
+type stdinInput struct{}
+
+func (stdinInput) Ranges() iter.Seq[Range] { return func(func(Range) bool) {} }
+func (stdinInput) Err() error              { return nil }
+
+func TestReformat(t *testing.T) {
+	job := createJob(nil, nil, nil)
+	job.Command = ReformatCommand
+	if !ReformatWithoutInputFormat(job) {
+		t.Error("Expected true for reformat without --in")
+	}
+
+	job.InputFormat = "{YYYY}-{MM}-{DD}"
+	if ReformatWithoutInputFormat(job) || ReformatWithDates(job) {
+		t.Error("Expected false for reformat with --in")
+	}
+
+	job.Input = stdinInput{}
+	if !ReformatWithDates(job) {
+		t.Error("Expected true for reformat with stdin")
+	}
+
+	job = createJob(nil, nil, nil)
+	job.InputFormat = "{YYYY}-{MM}-{DD}"
+	if !InputFormatWithoutReformat(job) {
+		t.Error("Expected true for --in without reformat")
+	}
+}
+
+func TestInfo(t *testing.T) {
+	job := createJob([]time.Time{time.Now()}, nil, nil)
+	job.Command = InfoCommand
+	job.JSON = true
+	if InfoWithDates(job) || JSONWithoutInfo(job) {
+		t.Error("Expected false for info about a date as JSON")
+	}
+
+	job.DatesInput = append(job.DatesInput, time.Now())
+	if !InfoWithDates(job) {
+		t.Error("Expected true for info with two dates")
+	}
+
+	job = createJob(nil, nil, nil)
+	job.Command = InfoCommand
+	job.Count = 3
+	if InfoWithDates(job) || !InfoWithCount(job) {
+		t.Error("Expected a count instead of dates for info with a count")
+	}
+
+	tests := []struct {
+		name   string
+		change func(job *Job)
+	}{
+		{"where", func(job *Job) { job.Where = "day > 3" }},
+		{"reversed", func(job *Job) { job.Reversed = true }},
+		{"ignored weekdays", func(job *Job) { job.IgnoredWeekdays = []time.Weekday{time.Monday} }},
+		{"only weekdays", func(job *Job) { job.OnlyWeekdays = []time.Weekday{time.Friday} }},
+		{"every", func(job *Job) { job.Every = 2 }},
+		{"sample", func(job *Job) { job.Sample = 1 }},
+		{"step", func(job *Job) { job.Step = Step{2, Day} }},
+	}
+	for _, tt := range tests {
+		job := New()
+		job.Command = InfoCommand
+		if InfoWithFilters(job) {
+			t.Fatal("Expected false for info without filters")
+		}
+		tt.change(job)
+		if !InfoWithFilters(job) {
+			t.Errorf("Expected true for info with %s", tt.name)
+		}
+	}
+
+	job = createJob(nil, nil, nil)
+	job.JSON = true
+	if !JSONWithoutInfo(job) {
+		t.Error("Expected true for --json without info")
+	}
+}
+
+func TestGrid(t *testing.T) {
+	job := New()
+	job.Grid = true
+	if GridWithCommand(job) || GridWithFormat(job) {
+		t.Error("Expected false for a grid of dates")
+	}
+
+	job.Command = InfoCommand
+	if !GridWithCommand(job) {
+		t.Error("Expected true for a grid with info")
+	}
+
+	tests := []struct {
+		name   string
+		change func(job *Job)
+	}{
+		{"format", func(job *Job) { job.Format = "{DD}" }},
+		{"calendar", func(job *Job) { job.Calendar = PersianCalendar }},
+		{"reversed", func(job *Job) { job.Reversed = true }},
+	}
+	for _, tt := range tests {
+		job := New()
+		job.Grid = true
+		tt.change(job)
+		if !GridWithFormat(job) {
+			t.Errorf("Expected true for a grid with %s", tt.name)
+		}
+	}
 }
 
 func TestDatesBetweenOptions(t *testing.T) {
@@ -128,6 +300,55 @@ func TestDoubleWeekday(t *testing.T) {
 	}
 }
 
+func TestCountWithEndDate(t *testing.T) {
+	// Count with a start date
+	job := createJob([]time.Time{time.Now()}, nil, nil)
+	job.Count = 10
+	if CountWithEndDate(job) {
+		t.Error("Expected false for count with a start date")
+	}
+
+	// Count with start and end date
+	job = createJob([]time.Time{time.Now(), time.Now()}, nil, nil)
+	job.Count = 10
+	if !CountWithEndDate(job) {
+		t.Error("Expected true for count with an end date")
+	}
+
+	// Range without count
+	job = createJob([]time.Time{time.Now(), time.Now()}, nil, nil)
+	if CountWithEndDate(job) {
+		t.Error("Expected false for range without count")
+	}
+}
+
+func TestCountWithoutWeekdays(t *testing.T) {
+	allWeekdays := []time.Weekday{
+		time.Monday, time.Tuesday, time.Wednesday, time.Thursday,
+		time.Friday, time.Saturday, time.Sunday,
+	}
+
+	// Count with every weekday ignored
+	job := createJob(nil, nil, allWeekdays)
+	job.Count = 10
+	if !CountWithoutWeekdays(job) {
+		t.Error("Expected true for count with every weekday ignored")
+	}
+
+	// Count with some weekdays ignored
+	job = createJob(nil, nil, allWeekdays[:5])
+	job.Count = 10
+	if CountWithoutWeekdays(job) {
+		t.Error("Expected false for count with weekdays left")
+	}
+
+	// Range with every weekday ignored
+	job = createJob(nil, nil, allWeekdays)
+	if CountWithoutWeekdays(job) {
+		t.Error("Expected false for range without count")
+	}
+}
+
 func TestValidate(t *testing.T) {
 	// Too many dates
 	job := createJob([]time.Time{time.Now(), time.Now(), time.Now()}, nil, nil)
@@ -157,6 +378,22 @@ func TestValidate(t *testing.T) {
 		t.Error("Expected 'double weekday' error")
 	}
 
+	// Count with end date
+	job = createJob([]time.Time{time.Now(), time.Now()}, []Argument{Date, Date}, nil)
+	job.Count = 5
+	err = Validate(job)
+	if err == nil || err.Error() != "count can't be combined with an end date" {
+		t.Error("Expected 'count with end date' error")
+	}
+
+	// Seed without sample
+	job = createJob(nil, nil, nil)
+	job.Seeded = true
+	err = Validate(job)
+	if err == nil || err.Error() != "seed can't be used without sample" {
+		t.Error("Expected 'seed without sample' error")
+	}
+
 	// All valid
 	job = createJob([]time.Time{time.Now(), time.Now()}, []Argument{Date, Date}, []time.Weekday{time.Monday})
 	err = Validate(job)
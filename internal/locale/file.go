@@ -0,0 +1,221 @@
+package locale
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A locale file defines a locale in YAML:
+//
+//	tag: gsw-x-bern
+//	base: gsw
+//	weekdays:
+//	  wide: [Sunntig, Määntig, Zyschtig, Mittwuch, Donnschtig, Friitig, Samschtig]
+//	months:
+//	  wide: [Januar, Februar, März, April, Mai, Juni, Juli, Oguscht, Septämber, Oktober, Novämber, Dezämber]
+//	ordinal:
+//	  rule: suffix
+//	  suffix: "."
+//	first-day: monday
+//	minimal-days: 4
+//
+// Names and settings which are left out are taken from the base locale. A
+// file without base has to list the wide, abbreviated and narrow weekday and
+// month names and the day periods.
+
+var weekdaysByName = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+var ordinalRulesByName = map[string]OrdinalRule{
+	"suffix":  SuffixOrdinal,
+	"english": EnglishOrdinal,
+	"first":   FirstDayOrdinal,
+}
+
+// ErrUnreadableFile wraps the error of a locale file which can't be read,
+// so that the caller can tell it from the errors of its content.
+var ErrUnreadableFile = errors.New("locale file can't be read")
+
+// LoadFile reads a locale file and registers the locale under its tag.
+func LoadFile(path string) (*Locale, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnreadableFile, err)
+	}
+	l, err := ParseFile(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	Register(l)
+	return l, nil
+}
+
+// Register adds a locale to the ones Lookup and Get find, replacing a locale
+// with the same tag.
+func Register(l *Locale) {
+	locales[l.Tag] = l
+}
+
+// ParseFile parses the content of a locale file. All missing entries and
+// wrong counts are reported together.
+func ParseFile(data string) (*Locale, error) {
+	root, err := parseYAML(data)
+	if err != nil {
+		return nil, err
+	}
+	f := fileDecoder{root: root}
+	f.checkKeys(root, "", "tag", "base", "territory", "weekdays", "months", "day-periods", "ordinal", "first-day", "minimal-days")
+
+	l := &Locale{FirstDay: time.Monday, MinimalDays: 1, WeekendStart: time.Saturday, WeekendEnd: time.Sunday}
+	baseTag := f.scalar("base")
+	if baseTag != "" {
+		base, found := Lookup(baseTag)
+		if !found {
+			return nil, fmt.Errorf("base: unknown locale %q", baseTag)
+		}
+		*l = *base
+	}
+	l.Tag = Canonicalize(f.scalar("tag"))
+	if l.Tag == "" {
+		f.errs = append(f.errs, errors.New("tag: missing"))
+	}
+	l.Language, _, _ = strings.Cut(l.Tag, "-")
+	if territory := f.scalar("territory"); territory != "" {
+		l.Territory = strings.ToUpper(territory)
+	}
+
+	f.checkKeys(f.field("weekdays"), "weekdays", "wide", "abbreviated", "narrow")
+	f.names(&l.WeekdayNames, "weekdays.wide", 7)
+	f.names(&l.WeekdayAbbreviations, "weekdays.abbreviated", 7)
+	f.names(&l.WeekdayNarrowNames, "weekdays.narrow", 7)
+	f.checkKeys(f.field("months"), "months", "wide", "genitive", "abbreviated", "narrow")
+	f.names(&l.MonthNames, "months.wide", 12)
+	f.names(&l.MonthAbbreviations, "months.abbreviated", 12)
+	f.names(&l.MonthNarrowNames, "months.narrow", 12)
+	if f.field("months.genitive") != nil {
+		f.names(&l.MonthGenitiveNames, "months.genitive", 12)
+	}
+	f.names(&l.DayPeriods, "day-periods", 2)
+
+	// Without a base the ordinal and the first day can't be taken from
+	// anywhere, like the names.
+	if baseTag == "" && f.field("ordinal") == nil {
+		f.errs = append(f.errs, errors.New("ordinal: missing"))
+	}
+	if baseTag == "" && f.field("first-day") == nil {
+		f.errs = append(f.errs, errors.New("first-day: missing"))
+	}
+	f.checkKeys(f.field("ordinal"), "ordinal", "rule", "suffix")
+	if name := f.scalar("ordinal.rule"); name != "" {
+		rule, found := ordinalRulesByName[name]
+		if !found {
+			f.errs = append(f.errs, fmt.Errorf("ordinal.rule: unknown rule %q, expected suffix, english or first", name))
+		}
+		l.Ordinal = Ordinal{rule, f.scalar("ordinal.suffix")}
+	} else if f.field("ordinal.suffix") != nil {
+		l.Ordinal.Suffix = f.scalar("ordinal.suffix")
+	}
+
+	if name := f.scalar("first-day"); name != "" {
+		day, found := weekdaysByName[strings.ToLower(name)]
+		if !found {
+			f.errs = append(f.errs, fmt.Errorf("first-day: unknown weekday %q", name))
+		}
+		l.FirstDay = day
+	}
+	if value := f.scalar("minimal-days"); value != "" {
+		days, err := strconv.Atoi(value)
+		if err != nil || days < 1 || days > 7 {
+			f.errs = append(f.errs, fmt.Errorf("minimal-days: expected a number from 1 to 7, got %q", value))
+		} else {
+			l.MinimalDays = days
+		}
+	}
+	return l, errors.Join(f.errs...)
+}
+
+// fileDecoder collects the errors of a locale file so that they are all
+// reported at once.
+type fileDecoder struct {
+	root *node
+	errs []error
+}
+
+// field returns the node at a dotted path or nil.
+func (f *fileDecoder) field(path string) *node {
+	n := f.root
+	for key := range strings.SplitSeq(path, ".") {
+		if n == nil || n.kind != mappingNode {
+			return nil
+		}
+		n = n.fields[key]
+	}
+	return n
+}
+
+func (f *fileDecoder) scalar(path string) string {
+	n := f.field(path)
+	if n == nil {
+		return ""
+	}
+	if n.kind != scalarNode {
+		f.errs = append(f.errs, fmt.Errorf("%s: line %d: expected a single value", path, n.line))
+		return ""
+	}
+	return n.value
+}
+
+// names sets the names at the path. A missing entry keeps the names of the
+// base locale and is only an error without one.
+func (f *fileDecoder) names(names *[]string, path string, count int) {
+	n := f.field(path)
+	switch {
+	case n == nil && *names == nil:
+		f.errs = append(f.errs, fmt.Errorf("%s: missing, expected %d names", path, count))
+	case n == nil:
+	case n.kind != sequenceNode:
+		f.errs = append(f.errs, fmt.Errorf("%s: line %d: expected a list of %d names", path, n.line, count))
+	case len(n.items) != count:
+		f.errs = append(f.errs, fmt.Errorf("%s: line %d: expected %d names, got %d", path, n.line, count, len(n.items)))
+	default:
+		for i, name := range n.items {
+			if name == "" {
+				f.errs = append(f.errs, fmt.Errorf("%s: line %d: name %d is empty", path, n.line, i+1))
+			}
+		}
+		*names = n.items
+	}
+}
+
+// checkKeys reports the keys of a mapping which are not known, most likely
+// typing errors.
+func (f *fileDecoder) checkKeys(n *node, path string, known ...string) {
+	if n == nil {
+		return
+	}
+	if n.kind != mappingNode {
+		f.errs = append(f.errs, fmt.Errorf("%s: line %d: expected %s", path, n.line, strings.Join(known, ", ")))
+		return
+	}
+	for _, key := range n.keys {
+		if !slices.Contains(known, key) {
+			name := key
+			if path != "" {
+				name = path + "." + key
+			}
+			f.errs = append(f.errs, fmt.Errorf("%s: line %d: unknown entry", name, n.fields[key].line))
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package locale
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+const bernese = `# Bernese German
+tag: gsw-x-bern
+base: gsw
+weekdays:
+  wide: [Sunntig, Määntig, Zyschtig, Mittwuch, Donnschtig, Friitig, Samschtig]
+months:
+  wide:
+    - Januar
+    - Februar
+    - März
+    - Aprill
+    - Mai
+    - Juni
+    - Juli
+    - Oguscht
+    - Septämber
+    - Oktober
+    - Novämber
+    - Dezämber
+day-periods: ["am Vormittag", 'am Namittag']  # quoted names
+first-day: Monday
+minimal-days: 4
+`
+
+func TestParseFile(t *testing.T) {
+	l, err := ParseFile(bernese)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	base, _ := Lookup("gsw")
+
+	if l.Tag != "gsw-x-bern" || l.Language != "gsw" || l.Territory != "CH" {
+		t.Errorf("unexpected tag %q, language %q or territory %q", l.Tag, l.Language, l.Territory)
+	}
+	if l.WeekdayNames[2] != "Zyschtig" || l.MonthNames[3] != "Aprill" {
+		t.Errorf("unexpected names %v %v", l.WeekdayNames, l.MonthNames)
+	}
+	if !slices.Equal(l.WeekdayAbbreviations, base.WeekdayAbbreviations) || !slices.Equal(l.MonthNarrowNames, base.MonthNarrowNames) {
+		t.Errorf("expected the names left out to come from the base locale")
+	}
+	if !slices.Equal(l.DayPeriods, []string{"am Vormittag", "am Namittag"}) {
+		t.Errorf("unexpected day periods %q", l.DayPeriods)
+	}
+	if l.Ordinal != base.Ordinal || l.FirstDay != time.Monday || l.MinimalDays != 4 {
+		t.Errorf("unexpected ordinal %v, first day %v or minimal days %d", l.Ordinal, l.FirstDay, l.MinimalDays)
+	}
+}
+
+func TestParseFileWithoutBase(t *testing.T) {
+	data := `tag: x-test
+weekdays:
+  wide: [d0, d1, d2, d3, d4, d5, d6]
+  abbreviated: [a0, a1, a2, a3, a4, a5, a6]
+  narrow: [n0, n1, n2, n3, n4, n5, n6]
+months:
+  wide: [m1, m2, m3, m4, m5, m6, m7, m8, m9, m10, m11, m12]
+  abbreviated: [m1, m2, m3, m4, m5, m6, m7, m8, m9, m10, m11, m12]
+  narrow: [1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12]
+day-periods: [am, pm]
+ordinal:
+  rule: first
+  suffix: "st"
+first-day: sunday
+`
+	l, err := ParseFile(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if l.Ordinal != (Ordinal{FirstDayOrdinal, "st"}) || l.FirstDay != time.Sunday {
+		t.Errorf("unexpected ordinal %v or first day %v", l.Ordinal, l.FirstDay)
+	}
+	if l.WeekendStart != time.Saturday || l.WeekendEnd != time.Sunday || l.MinimalDays != 1 || l.NativeDigits != nil {
+		t.Errorf("expected the defaults for the weekend and digits")
+	}
+}
+
+func TestParseFileErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected []string
+	}{
+		{
+			name: "Missing entries without base",
+			data: "tag: x-test\nweekdays:\n  wide: [a, b, c, d, e, f, g]\n",
+			expected: []string{
+				"weekdays.abbreviated: missing, expected 7 names",
+				"weekdays.narrow: missing, expected 7 names",
+				"months.wide: missing, expected 12 names",
+				"months.abbreviated: missing, expected 12 names",
+				"months.narrow: missing, expected 12 names",
+				"day-periods: missing, expected 2 names",
+				"ordinal: missing",
+				"first-day: missing",
+			},
+		},
+		{
+			name: "Wrong counts",
+			data: "tag: x-test\nbase: de\nweekdays:\n  wide: [a, b, c]\nmonths:\n  narrow:\n    - J\n    - F\n",
+			expected: []string{
+				"weekdays.wide: line 4: expected 7 names, got 3",
+				"months.narrow: line 7: expected 12 names, got 2",
+			},
+		},
+		{
+			name:     "Missing tag",
+			data:     "base: de\n",
+			expected: []string{"tag: missing"},
+		},
+		{
+			name: "Unknown entries and values",
+			data: "tag: x-test\nbase: de\nmonth:\n  wide: []\nordinal:\n  rule: second\nfirst-day: someday\nminimal-days: 8\n",
+			expected: []string{
+				"month: line 4: unknown entry",
+				`ordinal.rule: unknown rule "second", expected suffix, english or first`,
+				`first-day: unknown weekday "someday"`,
+				`minimal-days: expected a number from 1 to 7, got "8"`,
+			},
+		},
+		{
+			name:     "Unknown base",
+			data:     "tag: x-test\nbase: xx\n",
+			expected: []string{`base: unknown locale "xx"`},
+		},
+		{
+			name:     "Unterminated list",
+			data:     "tag: x-test\nday-periods: [am, pm\n",
+			expected: []string{"line 2: unterminated list"},
+		},
+		{
+			name:     "Tab indentation",
+			data:     "weekdays:\n\twide: []\n",
+			expected: []string{"line 2: tabs are not allowed for indentation"},
+		},
+		{
+			name:     "Line without key",
+			data:     "tag x-test\n",
+			expected: []string{"line 1: expected key: value"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseFile(tt.data)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			messages := strings.Split(err.Error(), "\n")
+			if !slices.Equal(messages, tt.expected) {
+				t.Errorf("expected errors\n%s\ngot\n%s", strings.Join(tt.expected, "\n"), err)
+			}
+		})
+	}
+}
+
+func TestLoadFileRegistersLocale(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bern.yaml")
+	if err := os.WriteFile(path, []byte(bernese), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadFile(path); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { delete(locales, "gsw-x-bern") })
+	l, found := Lookup("gsw-x-bern")
+	if !found || l.MonthNames[7] != "Oguscht" {
+		t.Errorf("expected the locale of the file to be registered")
+	}
+
+	_, err := LoadFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	if !errors.Is(err, ErrUnreadableFile) || !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected a missing file error, got %v", err)
+	}
+}
@@ -0,0 +1,297 @@
+// Command gen writes locales_gen.go from the CLDR extract in cldr/.
+//
+// Every cldr/<language>.json holds the names of a language as written in its
+// main territory and a list of regional variants, which only list what they
+// change. territories.json holds the first day of the week and the weekend
+// of the territories which differ from Monday and Saturday to Sunday, and
+// the territories whose first week of the year needs more than one day.
+//
+// The data is a condensed extract of the Unicode CLDR. Swiss German keeps the
+// dialect spellings pdate has always used.
+//
+// Run it with go generate in internal/locale.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+type names struct {
+	Wide        []string `json:"wide"`
+	Genitive    []string `json:"genitive"`
+	Abbreviated []string `json:"abbreviated"`
+	Narrow      []string `json:"narrow"`
+}
+
+type digits struct {
+	Native  string `json:"native"`
+	Default string `json:"default"`
+	Han     bool   `json:"han"`
+}
+
+type ordinal struct {
+	Rule   string `json:"rule"`
+	Suffix string `json:"suffix"`
+}
+
+// locale is both the data of a language and the changes of a regional
+// variant, whose empty fields are taken from the language.
+type locale struct {
+	Tag        string    `json:"tag"`
+	Territory  string    `json:"territory"`
+	Weekdays   names     `json:"weekdays"`
+	Months     names     `json:"months"`
+	DayPeriods []string  `json:"dayPeriods"`
+	Ordinal    *ordinal  `json:"ordinal"`
+	Digits     *digits   `json:"digits"`
+	Aliases    []string  `json:"aliases"`
+	Regions    []*locale `json:"regions"`
+
+	language string
+}
+
+type territories struct {
+	FirstDay     map[string][]string `json:"firstDay"`
+	WeekendStart map[string][]string `json:"weekendStart"`
+	WeekendEnd   map[string][]string `json:"weekendEnd"`
+	MinDays      map[string][]string `json:"minDays"`
+}
+
+var weekdays = map[string]string{
+	"sun": "time.Sunday",
+	"mon": "time.Monday",
+	"tue": "time.Tuesday",
+	"wed": "time.Wednesday",
+	"thu": "time.Thursday",
+	"fri": "time.Friday",
+	"sat": "time.Saturday",
+}
+
+var ordinalRules = map[string]string{
+	"suffix":  "SuffixOrdinal",
+	"english": "EnglishOrdinal",
+	"first":   "FirstDayOrdinal",
+}
+
+func main() {
+	if err := run("cldr", "locales_gen.go"); err != nil {
+		fmt.Fprintln(os.Stderr, "gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string, output string) error {
+	var regions territories
+	if err := readJSON(filepath.Join(dir, "territories.json"), &regions); err != nil {
+		return err
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	var all []*locale
+	aliases := map[string]string{}
+	for _, file := range files {
+		tag := strings.TrimSuffix(filepath.Base(file), ".json")
+		if tag == "territories" {
+			continue
+		}
+		var base locale
+		if err := readJSON(file, &base); err != nil {
+			return err
+		}
+		base.Tag = tag
+		base.language, _, _ = strings.Cut(tag, "-")
+		if err := validate(&base); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		for _, alias := range base.Aliases {
+			aliases[alias] = tag
+		}
+		all = append(all, &base)
+		for _, region := range base.Regions {
+			r := inherit(region, &base)
+			if err := validate(r); err != nil {
+				return fmt.Errorf("%s: %s: %w", file, r.Tag, err)
+			}
+			all = append(all, r)
+		}
+	}
+	slices.SortFunc(all, func(a, b *locale) int { return strings.Compare(a.Tag, b.Tag) })
+
+	source, err := write(all, aliases, regions)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(output, source, 0o644)
+}
+
+func readJSON(file string, v any) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+	return nil
+}
+
+func validate(l *locale) error {
+	counts := []struct {
+		names []string
+		count int
+	}{
+		{l.Weekdays.Wide, 7}, {l.Weekdays.Abbreviated, 7}, {l.Weekdays.Narrow, 7},
+		{l.Months.Wide, 12}, {l.Months.Abbreviated, 12}, {l.Months.Narrow, 12},
+		{l.DayPeriods, 2},
+	}
+	for _, c := range counts {
+		if len(c.names) != c.count {
+			return fmt.Errorf("expected %d names, got %v", c.count, c.names)
+		}
+	}
+	if l.Months.Genitive != nil && len(l.Months.Genitive) != 12 {
+		return errors.New("expected 12 genitive month names")
+	}
+	if l.Ordinal == nil || ordinalRules[l.Ordinal.Rule] == "" {
+		return errors.New("missing ordinal rule")
+	}
+	return nil
+}
+
+// inherit fills the empty fields of a regional variant from its language.
+func inherit(region *locale, base *locale) *locale {
+	r := *base
+	r.Tag = region.Tag
+	r.Territory = region.Territory
+	r.Weekdays = mergeNames(region.Weekdays, base.Weekdays)
+	r.Months = mergeNames(region.Months, base.Months)
+	if region.DayPeriods != nil {
+		r.DayPeriods = region.DayPeriods
+	}
+	if region.Ordinal != nil {
+		r.Ordinal = region.Ordinal
+	}
+	if region.Digits != nil {
+		merged := *base.Digits
+		if region.Digits.Default != "" {
+			merged.Default = region.Digits.Default
+		}
+		r.Digits = &merged
+	}
+	return &r
+}
+
+func mergeNames(region names, base names) names {
+	pick := func(a, b []string) []string {
+		if a != nil {
+			return a
+		}
+		return b
+	}
+	return names{
+		Wide:        pick(region.Wide, base.Wide),
+		Genitive:    pick(region.Genitive, base.Genitive),
+		Abbreviated: pick(region.Abbreviated, base.Abbreviated),
+		Narrow:      pick(region.Narrow, base.Narrow),
+	}
+}
+
+// write renders the locales as Go source. Name lists shared by several
+// locales are written once as variables.
+func write(all []*locale, aliases map[string]string, regions territories) ([]byte, error) {
+	shared := map[string]string{}
+	var lists bytes.Buffer
+	list := func(names []string) string {
+		if names == nil {
+			return "nil"
+		}
+		literal := fmt.Sprintf("%#v", names)
+		name, found := shared[literal]
+		if !found {
+			name = fmt.Sprintf("names%d", len(shared))
+			shared[literal] = name
+			fmt.Fprintf(&lists, "\t%s = %s\n", name, literal)
+		}
+		return name
+	}
+
+	var body bytes.Buffer
+	for _, l := range all {
+		fmt.Fprintf(&body, "%q: {\n", l.Tag)
+		fmt.Fprintf(&body, "Tag: %q,\nLanguage: %q,\nTerritory: %q,\n", l.Tag, l.language, l.Territory)
+		fmt.Fprintf(&body, "WeekdayNames: %s,\n", list(l.Weekdays.Wide))
+		fmt.Fprintf(&body, "WeekdayAbbreviations: %s,\n", list(l.Weekdays.Abbreviated))
+		fmt.Fprintf(&body, "WeekdayNarrowNames: %s,\n", list(l.Weekdays.Narrow))
+		fmt.Fprintf(&body, "MonthNames: %s,\n", list(l.Months.Wide))
+		if l.Months.Genitive != nil {
+			fmt.Fprintf(&body, "MonthGenitiveNames: %s,\n", list(l.Months.Genitive))
+		}
+		fmt.Fprintf(&body, "MonthAbbreviations: %s,\n", list(l.Months.Abbreviated))
+		fmt.Fprintf(&body, "MonthNarrowNames: %s,\n", list(l.Months.Narrow))
+		fmt.Fprintf(&body, "DayPeriods: %s,\n", list(l.DayPeriods))
+		fmt.Fprintf(&body, "Ordinal: Ordinal{%s, %q},\n", ordinalRules[l.Ordinal.Rule], l.Ordinal.Suffix)
+		if l.Digits != nil {
+			fmt.Fprintf(&body, "NativeDigits: %s,\n", list(strings.Split(l.Digits.Native, "")))
+			if l.Digits.Default == "native" {
+				body.WriteString("NativeByDefault: true,\n")
+			}
+			if l.Digits.Han {
+				body.WriteString("HanNumerals: true,\n")
+			}
+		}
+		fmt.Fprintf(&body, "FirstDay: %s,\nWeekendStart: %s,\nWeekendEnd: %s,\n",
+			weekday(regions.FirstDay, l.Territory, "mon"),
+			weekday(regions.WeekendStart, l.Territory, "sat"),
+			weekday(regions.WeekendEnd, l.Territory, "sun"))
+		fmt.Fprintf(&body, "MinimalDays: %s,\n", lookup(regions.MinDays, l.Territory, "1"))
+		body.WriteString("},\n")
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Code generated by go run ./gen; DO NOT EDIT.\n\npackage locale\n\nimport \"time\"\n\n")
+	src.WriteString("var locales = map[string]*Locale{\n")
+	src.Write(body.Bytes())
+	src.WriteString("}\n\n")
+	src.WriteString("var aliases = map[string]string{\n")
+	for _, alias := range sortedKeys(aliases) {
+		fmt.Fprintf(&src, "%q: %q,\n", alias, aliases[alias])
+	}
+	src.WriteString("}\n\nvar (\n")
+	src.Write(lists.Bytes())
+	src.WriteString(")\n")
+	return format.Source(src.Bytes())
+}
+
+// weekday returns the weekday listed for the territory, or the fallback.
+func weekday(table map[string][]string, territory string, fallback string) string {
+	return weekdays[lookup(table, territory, fallback)]
+}
+
+// lookup returns the key of the list the territory is in, or the fallback.
+func lookup(table map[string][]string, territory string, fallback string) string {
+	for _, key := range sortedKeys(table) {
+		if slices.Contains(table[key], territory) {
+			return key
+		}
+	}
+	return fallback
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
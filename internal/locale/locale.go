@@ -0,0 +1,139 @@
+// Package locale holds the names and conventions pdate writes dates with,
+// keyed by BCP 47 language tags like de-CH, pt-BR or zh-Hant.
+package locale
+
+//go:generate go run ./gen
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// OrdinalRule selects how a day of the month is written as an ordinal.
+type OrdinalRule int
+
+const (
+	// SuffixOrdinal appends the suffix to every day ("7.", "5e").
+	SuffixOrdinal OrdinalRule = iota
+	// EnglishOrdinal appends st, nd, rd or th.
+	EnglishOrdinal
+	// FirstDayOrdinal only writes the first day of a month as an ordinal
+	// ("1er mai", "2 mai").
+	FirstDayOrdinal
+)
+
+type Ordinal struct {
+	Rule   OrdinalRule
+	Suffix string
+}
+
+// Locale holds the names of a language as written in a territory. The names
+// follow the wide, abbreviated and narrow forms of the Unicode CLDR, weekdays
+// start with Sunday.
+type Locale struct {
+	Tag       string
+	Language  string
+	Territory string
+
+	WeekdayNames         []string
+	WeekdayAbbreviations []string
+	WeekdayNarrowNames   []string
+
+	// MonthNames are the names of the months on their own. Languages which
+	// use another grammatical case for a month inside a date have
+	// MonthGenitiveNames, the others leave it empty.
+	MonthNames         []string
+	MonthGenitiveNames []string
+	MonthAbbreviations []string
+	MonthNarrowNames   []string
+
+	// DayPeriods holds the names for before and after noon.
+	DayPeriods []string
+	Ordinal    Ordinal
+
+	// NativeDigits are the digits 0 to 9 of languages with their own numeral
+	// system. NativeByDefault follows the CLDR default numbering system and
+	// HanNumerals writes quantities as numbers (二十七) instead of digit by
+	// digit.
+	NativeDigits    []string
+	NativeByDefault bool
+	HanNumerals     bool
+
+	// FirstDay and MinimalDays define the weeks of the locale: a week
+	// starts on FirstDay, and the first week of a year is the first one
+	// with at least MinimalDays days in that year. Monday and 4 are the
+	// ISO 8601 weeks.
+	FirstDay     time.Weekday
+	MinimalDays  int
+	WeekendStart time.Weekday
+	WeekendEnd   time.Weekday
+}
+
+// Default is the locale used for tags which are not known.
+var Default = locales["en"]
+
+// Lookup returns the locale of a BCP 47 tag. Tags are matched case
+// insensitively with either - or _ between the subtags, and fall back to
+// the language without its last subtag, so de-DE-1996 finds de-DE and
+// pt-XX finds pt.
+func Lookup(tag string) (*Locale, bool) {
+	tag = Canonicalize(tag)
+	for tag != "" {
+		if alias, found := aliases[tag]; found {
+			tag = alias
+		}
+		if l, found := locales[tag]; found {
+			return l, true
+		}
+		cut := strings.LastIndexByte(tag, '-')
+		if cut == -1 {
+			break
+		}
+		tag = tag[:cut]
+	}
+	return nil, false
+}
+
+// Get returns the locale of a tag found by Lookup, or Default.
+func Get(tag string) *Locale {
+	if l, found := locales[tag]; found {
+		return l
+	}
+	if l, found := Lookup(tag); found {
+		return l
+	}
+	return Default
+}
+
+// Tags returns the tags of all locales in alphabetical order.
+func Tags() []string {
+	tags := make([]string, 0, len(locales))
+	for tag := range locales {
+		tags = append(tags, tag)
+	}
+	slices.Sort(tags)
+	return tags
+}
+
+// Canonicalize writes a tag the way BCP 47 recommends: the language in
+// lower case, the script in title case and the region in upper case. Private
+// use subtags after x are written in lower case.
+func Canonicalize(tag string) string {
+	subtags := strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '_' })
+	private := false
+	for i, subtag := range subtags {
+		switch {
+		case i == 0 || private:
+			subtags[i] = strings.ToLower(subtag)
+		case len(subtag) == 4:
+			subtags[i] = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		case len(subtag) == 2:
+			subtags[i] = strings.ToUpper(subtag)
+		default:
+			subtags[i] = strings.ToLower(subtag)
+			private = subtags[i] == "x"
+		}
+	}
+	return strings.Join(subtags, "-")
+}
@@ -0,0 +1,148 @@
+package locale
+
+import (
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		tag      string
+		expected string
+		found    bool
+	}{
+		{"de", "de", true},
+		{"de-CH", "de-CH", true},
+		{"pt_br", "pt-BR", true},
+		{"ZH-HANT", "zh-Hant", true},
+		{"zh-Hant-TW", "zh-Hant", true},
+		{"zh-Hans-CN", "zh", true},
+		{"de-CH-1996", "de-CH", true},
+		{"pl-XX", "pl", true},
+		{"ch", "gsw", true},
+		{"no", "nb", true},
+		{"xx", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		l, found := Lookup(tt.tag)
+		if found != tt.found {
+			t.Errorf("Lookup(%q) found = %v; want %v", tt.tag, found, tt.found)
+			continue
+		}
+		if found && l.Tag != tt.expected {
+			t.Errorf("Lookup(%q) = %q; want %q", tt.tag, l.Tag, tt.expected)
+		}
+	}
+}
+
+func TestGetFallsBackToDefault(t *testing.T) {
+	if l := Get("xx"); l != Default || l.Tag != "en" {
+		t.Errorf("expected the default locale, got %q", l.Tag)
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	tests := map[string]string{
+		"en":          "en",
+		"EN_us":       "en-US",
+		"zh-hant-tw":  "zh-Hant-TW",
+		"es-419":      "es-419",
+		"de--CH":      "de-CH",
+		"sr-latn-rs_": "sr-Latn-RS",
+	}
+
+	for tag, expected := range tests {
+		if result := Canonicalize(tag); result != expected {
+			t.Errorf("Canonicalize(%q) = %q; want %q", tag, result, expected)
+		}
+	}
+}
+
+func TestPOSIXTag(t *testing.T) {
+	tests := map[string]string{
+		"de_CH.UTF-8":      "de-CH",
+		"pt_BR":            "pt-BR",
+		"ja_JP.eucJP":      "ja-JP",
+		"de_DE.UTF-8@euro": "de-DE",
+		"sr_RS@latin":      "sr-Latn-RS",
+		"fr":               "fr",
+		"C":                "",
+		"C.UTF-8":          "",
+		"POSIX":            "",
+		"":                 "",
+	}
+
+	for name, expected := range tests {
+		if result := POSIXTag(name); result != expected {
+			t.Errorf("POSIXTag(%q) = %q; want %q", name, result, expected)
+		}
+	}
+}
+
+func TestWeekConventions(t *testing.T) {
+	tests := []struct {
+		tag          string
+		firstDay     time.Weekday
+		minimalDays  int
+		weekendStart time.Weekday
+		weekendEnd   time.Weekday
+	}{
+		{"de-DE", time.Monday, 4, time.Saturday, time.Sunday},
+		{"en-US", time.Sunday, 1, time.Saturday, time.Sunday},
+		{"en-GB", time.Monday, 4, time.Saturday, time.Sunday},
+		{"he", time.Sunday, 1, time.Friday, time.Saturday},
+		{"fa", time.Saturday, 1, time.Friday, time.Friday},
+		{"hi", time.Sunday, 1, time.Sunday, time.Sunday},
+	}
+
+	for _, tt := range tests {
+		l, _ := Lookup(tt.tag)
+		if l.FirstDay != tt.firstDay || l.MinimalDays != tt.minimalDays || l.WeekendStart != tt.weekendStart || l.WeekendEnd != tt.weekendEnd {
+			t.Errorf("%s: expected %v/%d, %v-%v, got %v/%d, %v-%v", tt.tag, tt.firstDay, tt.minimalDays, tt.weekendStart, tt.weekendEnd,
+				l.FirstDay, l.MinimalDays, l.WeekendStart, l.WeekendEnd)
+		}
+	}
+}
+
+func TestLocaleData(t *testing.T) {
+	if len(locales) < 100 {
+		t.Errorf("expected at least 100 locales, got %d", len(locales))
+	}
+	for tag, l := range locales {
+		if l.Tag != tag {
+			t.Errorf("locale %q has tag %q", tag, l.Tag)
+		}
+		counts := []struct {
+			names []string
+			count int
+		}{
+			{l.WeekdayNames, 7}, {l.WeekdayAbbreviations, 7}, {l.WeekdayNarrowNames, 7},
+			{l.MonthNames, 12}, {l.MonthAbbreviations, 12}, {l.MonthNarrowNames, 12},
+			{l.DayPeriods, 2},
+		}
+		for _, c := range counts {
+			if len(c.names) != c.count {
+				t.Errorf("%s: expected %d names, got %v", tag, c.count, c.names)
+			}
+			for _, name := range c.names {
+				if name == "" || !utf8.ValidString(name) {
+					t.Errorf("%s: invalid name %q", tag, name)
+				}
+			}
+		}
+		if l.MonthGenitiveNames != nil && len(l.MonthGenitiveNames) != 12 {
+			t.Errorf("%s: expected 12 genitive month names, got %v", tag, l.MonthGenitiveNames)
+		}
+		if l.NativeDigits != nil && len(l.NativeDigits) != 10 {
+			t.Errorf("%s: expected 10 native digits, got %v", tag, l.NativeDigits)
+		}
+	}
+	for alias, tag := range aliases {
+		if _, found := locales[tag]; !found {
+			t.Errorf("alias %q points to the unknown locale %q", alias, tag)
+		}
+	}
+}
@@ -0,0 +1,3140 @@
+// Code generated by go run ./gen; DO NOT EDIT.
+
+package locale
+
+import "time"
+
+var locales = map[string]*Locale{
+	"ar": {
+		Tag:                  "ar",
+		Language:             "ar",
+		Territory:            "EG",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names2,
+		MonthAbbreviations:   names2,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		NativeByDefault:      true,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"ar-AE": {
+		Tag:                  "ar-AE",
+		Language:             "ar",
+		Territory:            "AE",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names2,
+		MonthAbbreviations:   names2,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		NativeByDefault:      true,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"ar-BH": {
+		Tag:                  "ar-BH",
+		Language:             "ar",
+		Territory:            "BH",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names2,
+		MonthAbbreviations:   names2,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		NativeByDefault:      true,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"ar-DZ": {
+		Tag:                  "ar-DZ",
+		Language:             "ar",
+		Territory:            "DZ",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names6,
+		MonthAbbreviations:   names6,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"ar-EG": {
+		Tag:                  "ar-EG",
+		Language:             "ar",
+		Territory:            "EG",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names2,
+		MonthAbbreviations:   names2,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		NativeByDefault:      true,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"ar-IQ": {
+		Tag:                  "ar-IQ",
+		Language:             "ar",
+		Territory:            "IQ",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names7,
+		MonthAbbreviations:   names7,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		NativeByDefault:      true,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"ar-JO": {
+		Tag:                  "ar-JO",
+		Language:             "ar",
+		Territory:            "JO",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names7,
+		MonthAbbreviations:   names7,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		NativeByDefault:      true,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"ar-KW": {
+		Tag:                  "ar-KW",
+		Language:             "ar",
+		Territory:            "KW",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names2,
+		MonthAbbreviations:   names2,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		NativeByDefault:      true,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"ar-LB": {
+		Tag:                  "ar-LB",
+		Language:             "ar",
+		Territory:            "LB",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names7,
+		MonthAbbreviations:   names7,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		NativeByDefault:      true,
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"ar-LY": {
+		Tag:                  "ar-LY",
+		Language:             "ar",
+		Territory:            "LY",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names2,
+		MonthAbbreviations:   names2,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"ar-MA": {
+		Tag:                  "ar-MA",
+		Language:             "ar",
+		Territory:            "MA",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names8,
+		MonthAbbreviations:   names8,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"ar-OM": {
+		Tag:                  "ar-OM",
+		Language:             "ar",
+		Territory:            "OM",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names2,
+		MonthAbbreviations:   names2,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		NativeByDefault:      true,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"ar-QA": {
+		Tag:                  "ar-QA",
+		Language:             "ar",
+		Territory:            "QA",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names2,
+		MonthAbbreviations:   names2,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		NativeByDefault:      true,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"ar-SA": {
+		Tag:                  "ar-SA",
+		Language:             "ar",
+		Territory:            "SA",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names2,
+		MonthAbbreviations:   names2,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		NativeByDefault:      true,
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"ar-SD": {
+		Tag:                  "ar-SD",
+		Language:             "ar",
+		Territory:            "SD",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names2,
+		MonthAbbreviations:   names2,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		NativeByDefault:      true,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"ar-SY": {
+		Tag:                  "ar-SY",
+		Language:             "ar",
+		Territory:            "SY",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names7,
+		MonthAbbreviations:   names7,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		NativeByDefault:      true,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"ar-TN": {
+		Tag:                  "ar-TN",
+		Language:             "ar",
+		Territory:            "TN",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names6,
+		MonthAbbreviations:   names6,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"ar-YE": {
+		Tag:                  "ar-YE",
+		Language:             "ar",
+		Territory:            "YE",
+		WeekdayNames:         names0,
+		WeekdayAbbreviations: names0,
+		WeekdayNarrowNames:   names1,
+		MonthNames:           names2,
+		MonthAbbreviations:   names2,
+		MonthNarrowNames:     names3,
+		DayPeriods:           names4,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names5,
+		NativeByDefault:      true,
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"bg": {
+		Tag:                  "bg",
+		Language:             "bg",
+		Territory:            "BG",
+		WeekdayNames:         names9,
+		WeekdayAbbreviations: names10,
+		WeekdayNarrowNames:   names11,
+		MonthNames:           names12,
+		MonthAbbreviations:   names13,
+		MonthNarrowNames:     names14,
+		DayPeriods:           names15,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"bg-BG": {
+		Tag:                  "bg-BG",
+		Language:             "bg",
+		Territory:            "BG",
+		WeekdayNames:         names9,
+		WeekdayAbbreviations: names10,
+		WeekdayNarrowNames:   names11,
+		MonthNames:           names12,
+		MonthAbbreviations:   names13,
+		MonthNarrowNames:     names14,
+		DayPeriods:           names15,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"ca": {
+		Tag:                  "ca",
+		Language:             "ca",
+		Territory:            "ES",
+		WeekdayNames:         names16,
+		WeekdayAbbreviations: names17,
+		WeekdayNarrowNames:   names18,
+		MonthNames:           names19,
+		MonthAbbreviations:   names20,
+		MonthNarrowNames:     names21,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"ca-AD": {
+		Tag:                  "ca-AD",
+		Language:             "ca",
+		Territory:            "AD",
+		WeekdayNames:         names16,
+		WeekdayAbbreviations: names17,
+		WeekdayNarrowNames:   names18,
+		MonthNames:           names19,
+		MonthAbbreviations:   names20,
+		MonthNarrowNames:     names21,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"ca-ES": {
+		Tag:                  "ca-ES",
+		Language:             "ca",
+		Territory:            "ES",
+		WeekdayNames:         names16,
+		WeekdayAbbreviations: names17,
+		WeekdayNarrowNames:   names18,
+		MonthNames:           names19,
+		MonthAbbreviations:   names20,
+		MonthNarrowNames:     names21,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"cs": {
+		Tag:                  "cs",
+		Language:             "cs",
+		Territory:            "CZ",
+		WeekdayNames:         names23,
+		WeekdayAbbreviations: names24,
+		WeekdayNarrowNames:   names25,
+		MonthNames:           names26,
+		MonthGenitiveNames:   names27,
+		MonthAbbreviations:   names28,
+		MonthNarrowNames:     names29,
+		DayPeriods:           names30,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"cs-CZ": {
+		Tag:                  "cs-CZ",
+		Language:             "cs",
+		Territory:            "CZ",
+		WeekdayNames:         names23,
+		WeekdayAbbreviations: names24,
+		WeekdayNarrowNames:   names25,
+		MonthNames:           names26,
+		MonthGenitiveNames:   names27,
+		MonthAbbreviations:   names28,
+		MonthNarrowNames:     names29,
+		DayPeriods:           names30,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"da": {
+		Tag:                  "da",
+		Language:             "da",
+		Territory:            "DK",
+		WeekdayNames:         names31,
+		WeekdayAbbreviations: names32,
+		WeekdayNarrowNames:   names33,
+		MonthNames:           names34,
+		MonthAbbreviations:   names35,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"da-DK": {
+		Tag:                  "da-DK",
+		Language:             "da",
+		Territory:            "DK",
+		WeekdayNames:         names31,
+		WeekdayAbbreviations: names32,
+		WeekdayNarrowNames:   names33,
+		MonthNames:           names34,
+		MonthAbbreviations:   names35,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"da-GL": {
+		Tag:                  "da-GL",
+		Language:             "da",
+		Territory:            "GL",
+		WeekdayNames:         names31,
+		WeekdayAbbreviations: names32,
+		WeekdayNarrowNames:   names33,
+		MonthNames:           names34,
+		MonthAbbreviations:   names35,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"de": {
+		Tag:                  "de",
+		Language:             "de",
+		Territory:            "DE",
+		WeekdayNames:         names38,
+		WeekdayAbbreviations: names39,
+		WeekdayNarrowNames:   names40,
+		MonthNames:           names41,
+		MonthAbbreviations:   names42,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"de-AT": {
+		Tag:                  "de-AT",
+		Language:             "de",
+		Territory:            "AT",
+		WeekdayNames:         names38,
+		WeekdayAbbreviations: names39,
+		WeekdayNarrowNames:   names40,
+		MonthNames:           names43,
+		MonthAbbreviations:   names44,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"de-BE": {
+		Tag:                  "de-BE",
+		Language:             "de",
+		Territory:            "BE",
+		WeekdayNames:         names38,
+		WeekdayAbbreviations: names39,
+		WeekdayNarrowNames:   names40,
+		MonthNames:           names41,
+		MonthAbbreviations:   names42,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"de-CH": {
+		Tag:                  "de-CH",
+		Language:             "de",
+		Territory:            "CH",
+		WeekdayNames:         names38,
+		WeekdayAbbreviations: names39,
+		WeekdayNarrowNames:   names40,
+		MonthNames:           names41,
+		MonthAbbreviations:   names42,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"de-DE": {
+		Tag:                  "de-DE",
+		Language:             "de",
+		Territory:            "DE",
+		WeekdayNames:         names38,
+		WeekdayAbbreviations: names39,
+		WeekdayNarrowNames:   names40,
+		MonthNames:           names41,
+		MonthAbbreviations:   names42,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"de-LI": {
+		Tag:                  "de-LI",
+		Language:             "de",
+		Territory:            "LI",
+		WeekdayNames:         names38,
+		WeekdayAbbreviations: names39,
+		WeekdayNarrowNames:   names40,
+		MonthNames:           names41,
+		MonthAbbreviations:   names42,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"de-LU": {
+		Tag:                  "de-LU",
+		Language:             "de",
+		Territory:            "LU",
+		WeekdayNames:         names38,
+		WeekdayAbbreviations: names39,
+		WeekdayNarrowNames:   names40,
+		MonthNames:           names41,
+		MonthAbbreviations:   names42,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"el": {
+		Tag:                  "el",
+		Language:             "el",
+		Territory:            "GR",
+		WeekdayNames:         names45,
+		WeekdayAbbreviations: names46,
+		WeekdayNarrowNames:   names47,
+		MonthNames:           names48,
+		MonthGenitiveNames:   names49,
+		MonthAbbreviations:   names50,
+		MonthNarrowNames:     names51,
+		DayPeriods:           names52,
+		Ordinal:              Ordinal{SuffixOrdinal, "η"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"el-CY": {
+		Tag:                  "el-CY",
+		Language:             "el",
+		Territory:            "CY",
+		WeekdayNames:         names45,
+		WeekdayAbbreviations: names46,
+		WeekdayNarrowNames:   names47,
+		MonthNames:           names48,
+		MonthGenitiveNames:   names49,
+		MonthAbbreviations:   names50,
+		MonthNarrowNames:     names51,
+		DayPeriods:           names52,
+		Ordinal:              Ordinal{SuffixOrdinal, "η"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"el-GR": {
+		Tag:                  "el-GR",
+		Language:             "el",
+		Territory:            "GR",
+		WeekdayNames:         names45,
+		WeekdayAbbreviations: names46,
+		WeekdayNarrowNames:   names47,
+		MonthNames:           names48,
+		MonthGenitiveNames:   names49,
+		MonthAbbreviations:   names50,
+		MonthNarrowNames:     names51,
+		DayPeriods:           names52,
+		Ordinal:              Ordinal{SuffixOrdinal, "η"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"en": {
+		Tag:                  "en",
+		Language:             "en",
+		Territory:            "US",
+		WeekdayNames:         names53,
+		WeekdayAbbreviations: names54,
+		WeekdayNarrowNames:   names55,
+		MonthNames:           names56,
+		MonthAbbreviations:   names57,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{EnglishOrdinal, ""},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"en-AU": {
+		Tag:                  "en-AU",
+		Language:             "en",
+		Territory:            "AU",
+		WeekdayNames:         names53,
+		WeekdayAbbreviations: names54,
+		WeekdayNarrowNames:   names55,
+		MonthNames:           names56,
+		MonthAbbreviations:   names57,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names58,
+		Ordinal:              Ordinal{EnglishOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"en-CA": {
+		Tag:                  "en-CA",
+		Language:             "en",
+		Territory:            "CA",
+		WeekdayNames:         names53,
+		WeekdayAbbreviations: names54,
+		WeekdayNarrowNames:   names55,
+		MonthNames:           names56,
+		MonthAbbreviations:   names57,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{EnglishOrdinal, ""},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"en-GB": {
+		Tag:                  "en-GB",
+		Language:             "en",
+		Territory:            "GB",
+		WeekdayNames:         names53,
+		WeekdayAbbreviations: names54,
+		WeekdayNarrowNames:   names55,
+		MonthNames:           names56,
+		MonthAbbreviations:   names57,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names58,
+		Ordinal:              Ordinal{EnglishOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"en-HK": {
+		Tag:                  "en-HK",
+		Language:             "en",
+		Territory:            "HK",
+		WeekdayNames:         names53,
+		WeekdayAbbreviations: names54,
+		WeekdayNarrowNames:   names55,
+		MonthNames:           names56,
+		MonthAbbreviations:   names57,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{EnglishOrdinal, ""},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"en-IE": {
+		Tag:                  "en-IE",
+		Language:             "en",
+		Territory:            "IE",
+		WeekdayNames:         names53,
+		WeekdayAbbreviations: names54,
+		WeekdayNarrowNames:   names55,
+		MonthNames:           names56,
+		MonthAbbreviations:   names57,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names59,
+		Ordinal:              Ordinal{EnglishOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"en-IN": {
+		Tag:                  "en-IN",
+		Language:             "en",
+		Territory:            "IN",
+		WeekdayNames:         names53,
+		WeekdayAbbreviations: names54,
+		WeekdayNarrowNames:   names55,
+		MonthNames:           names56,
+		MonthAbbreviations:   names57,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names58,
+		Ordinal:              Ordinal{EnglishOrdinal, ""},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Sunday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"en-KE": {
+		Tag:                  "en-KE",
+		Language:             "en",
+		Territory:            "KE",
+		WeekdayNames:         names53,
+		WeekdayAbbreviations: names54,
+		WeekdayNarrowNames:   names55,
+		MonthNames:           names56,
+		MonthAbbreviations:   names57,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{EnglishOrdinal, ""},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"en-MT": {
+		Tag:                  "en-MT",
+		Language:             "en",
+		Territory:            "MT",
+		WeekdayNames:         names53,
+		WeekdayAbbreviations: names54,
+		WeekdayNarrowNames:   names55,
+		MonthNames:           names56,
+		MonthAbbreviations:   names57,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{EnglishOrdinal, ""},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"en-NG": {
+		Tag:                  "en-NG",
+		Language:             "en",
+		Territory:            "NG",
+		WeekdayNames:         names53,
+		WeekdayAbbreviations: names54,
+		WeekdayNarrowNames:   names55,
+		MonthNames:           names56,
+		MonthAbbreviations:   names57,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{EnglishOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"en-NZ": {
+		Tag:                  "en-NZ",
+		Language:             "en",
+		Territory:            "NZ",
+		WeekdayNames:         names53,
+		WeekdayAbbreviations: names54,
+		WeekdayNarrowNames:   names55,
+		MonthNames:           names56,
+		MonthAbbreviations:   names57,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names58,
+		Ordinal:              Ordinal{EnglishOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"en-PH": {
+		Tag:                  "en-PH",
+		Language:             "en",
+		Territory:            "PH",
+		WeekdayNames:         names53,
+		WeekdayAbbreviations: names54,
+		WeekdayNarrowNames:   names55,
+		MonthNames:           names56,
+		MonthAbbreviations:   names57,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{EnglishOrdinal, ""},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"en-SG": {
+		Tag:                  "en-SG",
+		Language:             "en",
+		Territory:            "SG",
+		WeekdayNames:         names53,
+		WeekdayAbbreviations: names54,
+		WeekdayNarrowNames:   names55,
+		MonthNames:           names56,
+		MonthAbbreviations:   names57,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{EnglishOrdinal, ""},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"en-US": {
+		Tag:                  "en-US",
+		Language:             "en",
+		Territory:            "US",
+		WeekdayNames:         names53,
+		WeekdayAbbreviations: names54,
+		WeekdayNarrowNames:   names55,
+		MonthNames:           names56,
+		MonthAbbreviations:   names57,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{EnglishOrdinal, ""},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"en-ZA": {
+		Tag:                  "en-ZA",
+		Language:             "en",
+		Territory:            "ZA",
+		WeekdayNames:         names53,
+		WeekdayAbbreviations: names54,
+		WeekdayNarrowNames:   names55,
+		MonthNames:           names56,
+		MonthAbbreviations:   names57,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{EnglishOrdinal, ""},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es": {
+		Tag:                  "es",
+		Language:             "es",
+		Territory:            "ES",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"es-419": {
+		Tag:                  "es-419",
+		Language:             "es",
+		Territory:            "",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names59,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-AR": {
+		Tag:                  "es-AR",
+		Language:             "es",
+		Territory:            "AR",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-BO": {
+		Tag:                  "es-BO",
+		Language:             "es",
+		Territory:            "BO",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-CL": {
+		Tag:                  "es-CL",
+		Language:             "es",
+		Territory:            "CL",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-CO": {
+		Tag:                  "es-CO",
+		Language:             "es",
+		Territory:            "CO",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-CR": {
+		Tag:                  "es-CR",
+		Language:             "es",
+		Territory:            "CR",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-CU": {
+		Tag:                  "es-CU",
+		Language:             "es",
+		Territory:            "CU",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-DO": {
+		Tag:                  "es-DO",
+		Language:             "es",
+		Territory:            "DO",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-EC": {
+		Tag:                  "es-EC",
+		Language:             "es",
+		Territory:            "EC",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-ES": {
+		Tag:                  "es-ES",
+		Language:             "es",
+		Territory:            "ES",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"es-GT": {
+		Tag:                  "es-GT",
+		Language:             "es",
+		Territory:            "GT",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-MX": {
+		Tag:                  "es-MX",
+		Language:             "es",
+		Territory:            "MX",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names59,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-PA": {
+		Tag:                  "es-PA",
+		Language:             "es",
+		Territory:            "PA",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-PE": {
+		Tag:                  "es-PE",
+		Language:             "es",
+		Territory:            "PE",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-PY": {
+		Tag:                  "es-PY",
+		Language:             "es",
+		Territory:            "PY",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-US": {
+		Tag:                  "es-US",
+		Language:             "es",
+		Territory:            "US",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names59,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-UY": {
+		Tag:                  "es-UY",
+		Language:             "es",
+		Territory:            "UY",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"es-VE": {
+		Tag:                  "es-VE",
+		Language:             "es",
+		Territory:            "VE",
+		WeekdayNames:         names60,
+		WeekdayAbbreviations: names61,
+		WeekdayNarrowNames:   names62,
+		MonthNames:           names63,
+		MonthAbbreviations:   names64,
+		MonthNarrowNames:     names65,
+		DayPeriods:           names22,
+		Ordinal:              Ordinal{SuffixOrdinal, ".º"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"et": {
+		Tag:                  "et",
+		Language:             "et",
+		Territory:            "EE",
+		WeekdayNames:         names66,
+		WeekdayAbbreviations: names67,
+		WeekdayNarrowNames:   names67,
+		MonthNames:           names68,
+		MonthAbbreviations:   names69,
+		MonthNarrowNames:     names70,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"et-EE": {
+		Tag:                  "et-EE",
+		Language:             "et",
+		Territory:            "EE",
+		WeekdayNames:         names66,
+		WeekdayAbbreviations: names67,
+		WeekdayNarrowNames:   names67,
+		MonthNames:           names68,
+		MonthAbbreviations:   names69,
+		MonthNarrowNames:     names70,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"fa": {
+		Tag:                  "fa",
+		Language:             "fa",
+		Territory:            "IR",
+		WeekdayNames:         names71,
+		WeekdayAbbreviations: names71,
+		WeekdayNarrowNames:   names72,
+		MonthNames:           names73,
+		MonthAbbreviations:   names73,
+		MonthNarrowNames:     names74,
+		DayPeriods:           names75,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names76,
+		NativeByDefault:      true,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Friday,
+		MinimalDays:          1,
+	},
+	"fa-AF": {
+		Tag:                  "fa-AF",
+		Language:             "fa",
+		Territory:            "AF",
+		WeekdayNames:         names71,
+		WeekdayAbbreviations: names71,
+		WeekdayNarrowNames:   names72,
+		MonthNames:           names73,
+		MonthAbbreviations:   names73,
+		MonthNarrowNames:     names74,
+		DayPeriods:           names75,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names76,
+		NativeByDefault:      true,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Thursday,
+		WeekendEnd:           time.Friday,
+		MinimalDays:          1,
+	},
+	"fa-IR": {
+		Tag:                  "fa-IR",
+		Language:             "fa",
+		Territory:            "IR",
+		WeekdayNames:         names71,
+		WeekdayAbbreviations: names71,
+		WeekdayNarrowNames:   names72,
+		MonthNames:           names73,
+		MonthAbbreviations:   names73,
+		MonthNarrowNames:     names74,
+		DayPeriods:           names75,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names76,
+		NativeByDefault:      true,
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Friday,
+		MinimalDays:          1,
+	},
+	"fi": {
+		Tag:                  "fi",
+		Language:             "fi",
+		Territory:            "FI",
+		WeekdayNames:         names77,
+		WeekdayAbbreviations: names78,
+		WeekdayNarrowNames:   names79,
+		MonthNames:           names80,
+		MonthGenitiveNames:   names81,
+		MonthAbbreviations:   names82,
+		MonthNarrowNames:     names83,
+		DayPeriods:           names84,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"fi-FI": {
+		Tag:                  "fi-FI",
+		Language:             "fi",
+		Territory:            "FI",
+		WeekdayNames:         names77,
+		WeekdayAbbreviations: names78,
+		WeekdayNarrowNames:   names79,
+		MonthNames:           names80,
+		MonthGenitiveNames:   names81,
+		MonthAbbreviations:   names82,
+		MonthNarrowNames:     names83,
+		DayPeriods:           names84,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"fr": {
+		Tag:                  "fr",
+		Language:             "fr",
+		Territory:            "FR",
+		WeekdayNames:         names85,
+		WeekdayAbbreviations: names86,
+		WeekdayNarrowNames:   names87,
+		MonthNames:           names88,
+		MonthAbbreviations:   names89,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "er"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"fr-BE": {
+		Tag:                  "fr-BE",
+		Language:             "fr",
+		Territory:            "BE",
+		WeekdayNames:         names85,
+		WeekdayAbbreviations: names86,
+		WeekdayNarrowNames:   names87,
+		MonthNames:           names88,
+		MonthAbbreviations:   names89,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "er"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"fr-CA": {
+		Tag:                  "fr-CA",
+		Language:             "fr",
+		Territory:            "CA",
+		WeekdayNames:         names85,
+		WeekdayAbbreviations: names86,
+		WeekdayNarrowNames:   names87,
+		MonthNames:           names88,
+		MonthAbbreviations:   names90,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names59,
+		Ordinal:              Ordinal{FirstDayOrdinal, "er"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"fr-CH": {
+		Tag:                  "fr-CH",
+		Language:             "fr",
+		Territory:            "CH",
+		WeekdayNames:         names85,
+		WeekdayAbbreviations: names86,
+		WeekdayNarrowNames:   names87,
+		MonthNames:           names88,
+		MonthAbbreviations:   names89,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "er"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"fr-CI": {
+		Tag:                  "fr-CI",
+		Language:             "fr",
+		Territory:            "CI",
+		WeekdayNames:         names85,
+		WeekdayAbbreviations: names86,
+		WeekdayNarrowNames:   names87,
+		MonthNames:           names88,
+		MonthAbbreviations:   names89,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "er"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"fr-DZ": {
+		Tag:                  "fr-DZ",
+		Language:             "fr",
+		Territory:            "DZ",
+		WeekdayNames:         names85,
+		WeekdayAbbreviations: names86,
+		WeekdayNarrowNames:   names87,
+		MonthNames:           names88,
+		MonthAbbreviations:   names89,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "er"},
+		FirstDay:             time.Saturday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"fr-FR": {
+		Tag:                  "fr-FR",
+		Language:             "fr",
+		Territory:            "FR",
+		WeekdayNames:         names85,
+		WeekdayAbbreviations: names86,
+		WeekdayNarrowNames:   names87,
+		MonthNames:           names88,
+		MonthAbbreviations:   names89,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "er"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"fr-LU": {
+		Tag:                  "fr-LU",
+		Language:             "fr",
+		Territory:            "LU",
+		WeekdayNames:         names85,
+		WeekdayAbbreviations: names86,
+		WeekdayNarrowNames:   names87,
+		MonthNames:           names88,
+		MonthAbbreviations:   names89,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "er"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"fr-MA": {
+		Tag:                  "fr-MA",
+		Language:             "fr",
+		Territory:            "MA",
+		WeekdayNames:         names85,
+		WeekdayAbbreviations: names86,
+		WeekdayNarrowNames:   names87,
+		MonthNames:           names88,
+		MonthAbbreviations:   names89,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "er"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"fr-MC": {
+		Tag:                  "fr-MC",
+		Language:             "fr",
+		Territory:            "MC",
+		WeekdayNames:         names85,
+		WeekdayAbbreviations: names86,
+		WeekdayNarrowNames:   names87,
+		MonthNames:           names88,
+		MonthAbbreviations:   names89,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "er"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"fr-SN": {
+		Tag:                  "fr-SN",
+		Language:             "fr",
+		Territory:            "SN",
+		WeekdayNames:         names85,
+		WeekdayAbbreviations: names86,
+		WeekdayNarrowNames:   names87,
+		MonthNames:           names88,
+		MonthAbbreviations:   names89,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "er"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"fr-TN": {
+		Tag:                  "fr-TN",
+		Language:             "fr",
+		Territory:            "TN",
+		WeekdayNames:         names85,
+		WeekdayAbbreviations: names86,
+		WeekdayNarrowNames:   names87,
+		MonthNames:           names88,
+		MonthAbbreviations:   names89,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "er"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"gsw": {
+		Tag:                  "gsw",
+		Language:             "gsw",
+		Territory:            "CH",
+		WeekdayNames:         names91,
+		WeekdayAbbreviations: names92,
+		WeekdayNarrowNames:   names93,
+		MonthNames:           names94,
+		MonthAbbreviations:   names95,
+		MonthNarrowNames:     names96,
+		DayPeriods:           names97,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"gsw-CH": {
+		Tag:                  "gsw-CH",
+		Language:             "gsw",
+		Territory:            "CH",
+		WeekdayNames:         names91,
+		WeekdayAbbreviations: names92,
+		WeekdayNarrowNames:   names93,
+		MonthNames:           names94,
+		MonthAbbreviations:   names95,
+		MonthNarrowNames:     names96,
+		DayPeriods:           names97,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"gsw-LI": {
+		Tag:                  "gsw-LI",
+		Language:             "gsw",
+		Territory:            "LI",
+		WeekdayNames:         names91,
+		WeekdayAbbreviations: names92,
+		WeekdayNarrowNames:   names93,
+		MonthNames:           names94,
+		MonthAbbreviations:   names95,
+		MonthNarrowNames:     names96,
+		DayPeriods:           names97,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"he": {
+		Tag:                  "he",
+		Language:             "he",
+		Territory:            "IL",
+		WeekdayNames:         names98,
+		WeekdayAbbreviations: names99,
+		WeekdayNarrowNames:   names100,
+		MonthNames:           names101,
+		MonthAbbreviations:   names102,
+		MonthNarrowNames:     names29,
+		DayPeriods:           names103,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"he-IL": {
+		Tag:                  "he-IL",
+		Language:             "he",
+		Territory:            "IL",
+		WeekdayNames:         names98,
+		WeekdayAbbreviations: names99,
+		WeekdayNarrowNames:   names100,
+		MonthNames:           names101,
+		MonthAbbreviations:   names102,
+		MonthNarrowNames:     names29,
+		DayPeriods:           names103,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Friday,
+		WeekendEnd:           time.Saturday,
+		MinimalDays:          1,
+	},
+	"hi": {
+		Tag:                  "hi",
+		Language:             "hi",
+		Territory:            "IN",
+		WeekdayNames:         names104,
+		WeekdayAbbreviations: names105,
+		WeekdayNarrowNames:   names106,
+		MonthNames:           names107,
+		MonthAbbreviations:   names108,
+		MonthNarrowNames:     names109,
+		DayPeriods:           names58,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names110,
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Sunday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"hi-IN": {
+		Tag:                  "hi-IN",
+		Language:             "hi",
+		Territory:            "IN",
+		WeekdayNames:         names104,
+		WeekdayAbbreviations: names105,
+		WeekdayNarrowNames:   names106,
+		MonthNames:           names107,
+		MonthAbbreviations:   names108,
+		MonthNarrowNames:     names109,
+		DayPeriods:           names58,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names110,
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Sunday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"hr": {
+		Tag:                  "hr",
+		Language:             "hr",
+		Territory:            "HR",
+		WeekdayNames:         names111,
+		WeekdayAbbreviations: names112,
+		WeekdayNarrowNames:   names113,
+		MonthNames:           names114,
+		MonthGenitiveNames:   names115,
+		MonthAbbreviations:   names116,
+		MonthNarrowNames:     names117,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"hr-BA": {
+		Tag:                  "hr-BA",
+		Language:             "hr",
+		Territory:            "BA",
+		WeekdayNames:         names111,
+		WeekdayAbbreviations: names112,
+		WeekdayNarrowNames:   names113,
+		MonthNames:           names114,
+		MonthGenitiveNames:   names115,
+		MonthAbbreviations:   names116,
+		MonthNarrowNames:     names117,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"hr-HR": {
+		Tag:                  "hr-HR",
+		Language:             "hr",
+		Territory:            "HR",
+		WeekdayNames:         names111,
+		WeekdayAbbreviations: names112,
+		WeekdayNarrowNames:   names113,
+		MonthNames:           names114,
+		MonthGenitiveNames:   names115,
+		MonthAbbreviations:   names116,
+		MonthNarrowNames:     names117,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"hu": {
+		Tag:                  "hu",
+		Language:             "hu",
+		Territory:            "HU",
+		WeekdayNames:         names118,
+		WeekdayAbbreviations: names119,
+		WeekdayNarrowNames:   names120,
+		MonthNames:           names121,
+		MonthAbbreviations:   names122,
+		MonthNarrowNames:     names123,
+		DayPeriods:           names124,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"hu-HU": {
+		Tag:                  "hu-HU",
+		Language:             "hu",
+		Territory:            "HU",
+		WeekdayNames:         names118,
+		WeekdayAbbreviations: names119,
+		WeekdayNarrowNames:   names120,
+		MonthNames:           names121,
+		MonthAbbreviations:   names122,
+		MonthNarrowNames:     names123,
+		DayPeriods:           names124,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"id": {
+		Tag:                  "id",
+		Language:             "id",
+		Territory:            "ID",
+		WeekdayNames:         names125,
+		WeekdayAbbreviations: names126,
+		WeekdayNarrowNames:   names127,
+		MonthNames:           names128,
+		MonthAbbreviations:   names129,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"id-ID": {
+		Tag:                  "id-ID",
+		Language:             "id",
+		Territory:            "ID",
+		WeekdayNames:         names125,
+		WeekdayAbbreviations: names126,
+		WeekdayNarrowNames:   names127,
+		MonthNames:           names128,
+		MonthAbbreviations:   names129,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"it": {
+		Tag:                  "it",
+		Language:             "it",
+		Territory:            "IT",
+		WeekdayNames:         names130,
+		WeekdayAbbreviations: names131,
+		WeekdayNarrowNames:   names132,
+		MonthNames:           names133,
+		MonthAbbreviations:   names134,
+		MonthNarrowNames:     names135,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "º"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"it-CH": {
+		Tag:                  "it-CH",
+		Language:             "it",
+		Territory:            "CH",
+		WeekdayNames:         names130,
+		WeekdayAbbreviations: names131,
+		WeekdayNarrowNames:   names132,
+		MonthNames:           names133,
+		MonthAbbreviations:   names134,
+		MonthNarrowNames:     names135,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "º"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"it-IT": {
+		Tag:                  "it-IT",
+		Language:             "it",
+		Territory:            "IT",
+		WeekdayNames:         names130,
+		WeekdayAbbreviations: names131,
+		WeekdayNarrowNames:   names132,
+		MonthNames:           names133,
+		MonthAbbreviations:   names134,
+		MonthNarrowNames:     names135,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "º"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"it-SM": {
+		Tag:                  "it-SM",
+		Language:             "it",
+		Territory:            "SM",
+		WeekdayNames:         names130,
+		WeekdayAbbreviations: names131,
+		WeekdayNarrowNames:   names132,
+		MonthNames:           names133,
+		MonthAbbreviations:   names134,
+		MonthNarrowNames:     names135,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "º"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"ja": {
+		Tag:                  "ja",
+		Language:             "ja",
+		Territory:            "JP",
+		WeekdayNames:         names136,
+		WeekdayAbbreviations: names137,
+		WeekdayNarrowNames:   names137,
+		MonthNames:           names138,
+		MonthAbbreviations:   names138,
+		MonthNarrowNames:     names29,
+		DayPeriods:           names139,
+		Ordinal:              Ordinal{SuffixOrdinal, "日"},
+		NativeDigits:         names140,
+		HanNumerals:          true,
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"ja-JP": {
+		Tag:                  "ja-JP",
+		Language:             "ja",
+		Territory:            "JP",
+		WeekdayNames:         names136,
+		WeekdayAbbreviations: names137,
+		WeekdayNarrowNames:   names137,
+		MonthNames:           names138,
+		MonthAbbreviations:   names138,
+		MonthNarrowNames:     names29,
+		DayPeriods:           names139,
+		Ordinal:              Ordinal{SuffixOrdinal, "日"},
+		NativeDigits:         names140,
+		HanNumerals:          true,
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"ko": {
+		Tag:                  "ko",
+		Language:             "ko",
+		Territory:            "KR",
+		WeekdayNames:         names141,
+		WeekdayAbbreviations: names142,
+		WeekdayNarrowNames:   names142,
+		MonthNames:           names143,
+		MonthAbbreviations:   names143,
+		MonthNarrowNames:     names143,
+		DayPeriods:           names144,
+		Ordinal:              Ordinal{SuffixOrdinal, "일"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"ko-KP": {
+		Tag:                  "ko-KP",
+		Language:             "ko",
+		Territory:            "KP",
+		WeekdayNames:         names141,
+		WeekdayAbbreviations: names142,
+		WeekdayNarrowNames:   names142,
+		MonthNames:           names143,
+		MonthAbbreviations:   names143,
+		MonthNarrowNames:     names143,
+		DayPeriods:           names144,
+		Ordinal:              Ordinal{SuffixOrdinal, "일"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"ko-KR": {
+		Tag:                  "ko-KR",
+		Language:             "ko",
+		Territory:            "KR",
+		WeekdayNames:         names141,
+		WeekdayAbbreviations: names142,
+		WeekdayNarrowNames:   names142,
+		MonthNames:           names143,
+		MonthAbbreviations:   names143,
+		MonthNarrowNames:     names143,
+		DayPeriods:           names144,
+		Ordinal:              Ordinal{SuffixOrdinal, "일"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"lt": {
+		Tag:                  "lt",
+		Language:             "lt",
+		Territory:            "LT",
+		WeekdayNames:         names145,
+		WeekdayAbbreviations: names146,
+		WeekdayNarrowNames:   names147,
+		MonthNames:           names148,
+		MonthGenitiveNames:   names149,
+		MonthAbbreviations:   names150,
+		MonthNarrowNames:     names151,
+		DayPeriods:           names152,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"lt-LT": {
+		Tag:                  "lt-LT",
+		Language:             "lt",
+		Territory:            "LT",
+		WeekdayNames:         names145,
+		WeekdayAbbreviations: names146,
+		WeekdayNarrowNames:   names147,
+		MonthNames:           names148,
+		MonthGenitiveNames:   names149,
+		MonthAbbreviations:   names150,
+		MonthNarrowNames:     names151,
+		DayPeriods:           names152,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"lv": {
+		Tag:                  "lv",
+		Language:             "lv",
+		Territory:            "LV",
+		WeekdayNames:         names153,
+		WeekdayAbbreviations: names154,
+		WeekdayNarrowNames:   names155,
+		MonthNames:           names156,
+		MonthAbbreviations:   names157,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names158,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"lv-LV": {
+		Tag:                  "lv-LV",
+		Language:             "lv",
+		Territory:            "LV",
+		WeekdayNames:         names153,
+		WeekdayAbbreviations: names154,
+		WeekdayNarrowNames:   names155,
+		MonthNames:           names156,
+		MonthAbbreviations:   names157,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names158,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"nb": {
+		Tag:                  "nb",
+		Language:             "nb",
+		Territory:            "NO",
+		WeekdayNames:         names31,
+		WeekdayAbbreviations: names159,
+		WeekdayNarrowNames:   names33,
+		MonthNames:           names160,
+		MonthAbbreviations:   names161,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names59,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"nb-NO": {
+		Tag:                  "nb-NO",
+		Language:             "nb",
+		Territory:            "NO",
+		WeekdayNames:         names31,
+		WeekdayAbbreviations: names159,
+		WeekdayNarrowNames:   names33,
+		MonthNames:           names160,
+		MonthAbbreviations:   names161,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names59,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"nl": {
+		Tag:                  "nl",
+		Language:             "nl",
+		Territory:            "NL",
+		WeekdayNames:         names162,
+		WeekdayAbbreviations: names163,
+		WeekdayNarrowNames:   names164,
+		MonthNames:           names165,
+		MonthAbbreviations:   names166,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names59,
+		Ordinal:              Ordinal{SuffixOrdinal, "e"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"nl-BE": {
+		Tag:                  "nl-BE",
+		Language:             "nl",
+		Territory:            "BE",
+		WeekdayNames:         names162,
+		WeekdayAbbreviations: names163,
+		WeekdayNarrowNames:   names164,
+		MonthNames:           names165,
+		MonthAbbreviations:   names166,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names59,
+		Ordinal:              Ordinal{SuffixOrdinal, "e"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"nl-NL": {
+		Tag:                  "nl-NL",
+		Language:             "nl",
+		Territory:            "NL",
+		WeekdayNames:         names162,
+		WeekdayAbbreviations: names163,
+		WeekdayNarrowNames:   names164,
+		MonthNames:           names165,
+		MonthAbbreviations:   names166,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names59,
+		Ordinal:              Ordinal{SuffixOrdinal, "e"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"nl-SR": {
+		Tag:                  "nl-SR",
+		Language:             "nl",
+		Territory:            "SR",
+		WeekdayNames:         names162,
+		WeekdayAbbreviations: names163,
+		WeekdayNarrowNames:   names164,
+		MonthNames:           names165,
+		MonthAbbreviations:   names166,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names59,
+		Ordinal:              Ordinal{SuffixOrdinal, "e"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"pl": {
+		Tag:                  "pl",
+		Language:             "pl",
+		Territory:            "PL",
+		WeekdayNames:         names167,
+		WeekdayAbbreviations: names168,
+		WeekdayNarrowNames:   names169,
+		MonthNames:           names170,
+		MonthGenitiveNames:   names171,
+		MonthAbbreviations:   names172,
+		MonthNarrowNames:     names173,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"pl-PL": {
+		Tag:                  "pl-PL",
+		Language:             "pl",
+		Territory:            "PL",
+		WeekdayNames:         names167,
+		WeekdayAbbreviations: names168,
+		WeekdayNarrowNames:   names169,
+		MonthNames:           names170,
+		MonthGenitiveNames:   names171,
+		MonthAbbreviations:   names172,
+		MonthNarrowNames:     names173,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"pt": {
+		Tag:                  "pt",
+		Language:             "pt",
+		Territory:            "BR",
+		WeekdayNames:         names174,
+		WeekdayAbbreviations: names175,
+		WeekdayNarrowNames:   names176,
+		MonthNames:           names177,
+		MonthAbbreviations:   names178,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "º"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"pt-AO": {
+		Tag:                  "pt-AO",
+		Language:             "pt",
+		Territory:            "AO",
+		WeekdayNames:         names174,
+		WeekdayAbbreviations: names175,
+		WeekdayNarrowNames:   names176,
+		MonthNames:           names177,
+		MonthAbbreviations:   names178,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names179,
+		Ordinal:              Ordinal{FirstDayOrdinal, "º"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"pt-BR": {
+		Tag:                  "pt-BR",
+		Language:             "pt",
+		Territory:            "BR",
+		WeekdayNames:         names174,
+		WeekdayAbbreviations: names175,
+		WeekdayNarrowNames:   names176,
+		MonthNames:           names177,
+		MonthAbbreviations:   names178,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{FirstDayOrdinal, "º"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"pt-MZ": {
+		Tag:                  "pt-MZ",
+		Language:             "pt",
+		Territory:            "MZ",
+		WeekdayNames:         names174,
+		WeekdayAbbreviations: names175,
+		WeekdayNarrowNames:   names176,
+		MonthNames:           names177,
+		MonthAbbreviations:   names178,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names179,
+		Ordinal:              Ordinal{FirstDayOrdinal, "º"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"pt-PT": {
+		Tag:                  "pt-PT",
+		Language:             "pt",
+		Territory:            "PT",
+		WeekdayNames:         names174,
+		WeekdayAbbreviations: names180,
+		WeekdayNarrowNames:   names176,
+		MonthNames:           names177,
+		MonthAbbreviations:   names178,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names179,
+		Ordinal:              Ordinal{FirstDayOrdinal, "º"},
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"ro": {
+		Tag:                  "ro",
+		Language:             "ro",
+		Territory:            "RO",
+		WeekdayNames:         names181,
+		WeekdayAbbreviations: names182,
+		WeekdayNarrowNames:   names87,
+		MonthNames:           names183,
+		MonthAbbreviations:   names184,
+		MonthNarrowNames:     names185,
+		DayPeriods:           names59,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"ro-MD": {
+		Tag:                  "ro-MD",
+		Language:             "ro",
+		Territory:            "MD",
+		WeekdayNames:         names181,
+		WeekdayAbbreviations: names182,
+		WeekdayNarrowNames:   names87,
+		MonthNames:           names183,
+		MonthAbbreviations:   names184,
+		MonthNarrowNames:     names185,
+		DayPeriods:           names59,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"ro-RO": {
+		Tag:                  "ro-RO",
+		Language:             "ro",
+		Territory:            "RO",
+		WeekdayNames:         names181,
+		WeekdayAbbreviations: names182,
+		WeekdayNarrowNames:   names87,
+		MonthNames:           names183,
+		MonthAbbreviations:   names184,
+		MonthNarrowNames:     names185,
+		DayPeriods:           names59,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"ru": {
+		Tag:                  "ru",
+		Language:             "ru",
+		Territory:            "RU",
+		WeekdayNames:         names186,
+		WeekdayAbbreviations: names187,
+		WeekdayNarrowNames:   names188,
+		MonthNames:           names189,
+		MonthGenitiveNames:   names190,
+		MonthAbbreviations:   names191,
+		MonthNarrowNames:     names192,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "-е"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"ru-BY": {
+		Tag:                  "ru-BY",
+		Language:             "ru",
+		Territory:            "BY",
+		WeekdayNames:         names186,
+		WeekdayAbbreviations: names187,
+		WeekdayNarrowNames:   names188,
+		MonthNames:           names189,
+		MonthGenitiveNames:   names190,
+		MonthAbbreviations:   names191,
+		MonthNarrowNames:     names192,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "-е"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"ru-KG": {
+		Tag:                  "ru-KG",
+		Language:             "ru",
+		Territory:            "KG",
+		WeekdayNames:         names186,
+		WeekdayAbbreviations: names187,
+		WeekdayNarrowNames:   names188,
+		MonthNames:           names189,
+		MonthGenitiveNames:   names190,
+		MonthAbbreviations:   names191,
+		MonthNarrowNames:     names192,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "-е"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"ru-KZ": {
+		Tag:                  "ru-KZ",
+		Language:             "ru",
+		Territory:            "KZ",
+		WeekdayNames:         names186,
+		WeekdayAbbreviations: names187,
+		WeekdayNarrowNames:   names188,
+		MonthNames:           names189,
+		MonthGenitiveNames:   names190,
+		MonthAbbreviations:   names191,
+		MonthNarrowNames:     names192,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "-е"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"ru-MD": {
+		Tag:                  "ru-MD",
+		Language:             "ru",
+		Territory:            "MD",
+		WeekdayNames:         names186,
+		WeekdayAbbreviations: names187,
+		WeekdayNarrowNames:   names188,
+		MonthNames:           names189,
+		MonthGenitiveNames:   names190,
+		MonthAbbreviations:   names191,
+		MonthNarrowNames:     names192,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "-е"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"ru-RU": {
+		Tag:                  "ru-RU",
+		Language:             "ru",
+		Territory:            "RU",
+		WeekdayNames:         names186,
+		WeekdayAbbreviations: names187,
+		WeekdayNarrowNames:   names188,
+		MonthNames:           names189,
+		MonthGenitiveNames:   names190,
+		MonthAbbreviations:   names191,
+		MonthNarrowNames:     names192,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "-е"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"ru-UA": {
+		Tag:                  "ru-UA",
+		Language:             "ru",
+		Territory:            "UA",
+		WeekdayNames:         names186,
+		WeekdayAbbreviations: names187,
+		WeekdayNarrowNames:   names188,
+		MonthNames:           names189,
+		MonthGenitiveNames:   names190,
+		MonthAbbreviations:   names191,
+		MonthNarrowNames:     names192,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "-е"},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"sk": {
+		Tag:                  "sk",
+		Language:             "sk",
+		Territory:            "SK",
+		WeekdayNames:         names193,
+		WeekdayAbbreviations: names194,
+		WeekdayNarrowNames:   names195,
+		MonthNames:           names196,
+		MonthGenitiveNames:   names197,
+		MonthAbbreviations:   names198,
+		MonthNarrowNames:     names96,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"sk-SK": {
+		Tag:                  "sk-SK",
+		Language:             "sk",
+		Territory:            "SK",
+		WeekdayNames:         names193,
+		WeekdayAbbreviations: names194,
+		WeekdayNarrowNames:   names195,
+		MonthNames:           names196,
+		MonthGenitiveNames:   names197,
+		MonthAbbreviations:   names198,
+		MonthNarrowNames:     names96,
+		DayPeriods:           names37,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"sl": {
+		Tag:                  "sl",
+		Language:             "sl",
+		Territory:            "SI",
+		WeekdayNames:         names199,
+		WeekdayAbbreviations: names200,
+		WeekdayNarrowNames:   names201,
+		MonthNames:           names202,
+		MonthAbbreviations:   names203,
+		MonthNarrowNames:     names96,
+		DayPeriods:           names204,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"sl-SI": {
+		Tag:                  "sl-SI",
+		Language:             "sl",
+		Territory:            "SI",
+		WeekdayNames:         names199,
+		WeekdayAbbreviations: names200,
+		WeekdayNarrowNames:   names201,
+		MonthNames:           names202,
+		MonthAbbreviations:   names203,
+		MonthNarrowNames:     names96,
+		DayPeriods:           names204,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"sv": {
+		Tag:                  "sv",
+		Language:             "sv",
+		Territory:            "SE",
+		WeekdayNames:         names205,
+		WeekdayAbbreviations: names206,
+		WeekdayNarrowNames:   names33,
+		MonthNames:           names207,
+		MonthAbbreviations:   names208,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names209,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"sv-FI": {
+		Tag:                  "sv-FI",
+		Language:             "sv",
+		Territory:            "FI",
+		WeekdayNames:         names205,
+		WeekdayAbbreviations: names206,
+		WeekdayNarrowNames:   names33,
+		MonthNames:           names207,
+		MonthAbbreviations:   names208,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names209,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"sv-SE": {
+		Tag:                  "sv-SE",
+		Language:             "sv",
+		Territory:            "SE",
+		WeekdayNames:         names205,
+		WeekdayAbbreviations: names206,
+		WeekdayNarrowNames:   names33,
+		MonthNames:           names207,
+		MonthAbbreviations:   names208,
+		MonthNarrowNames:     names36,
+		DayPeriods:           names209,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          4,
+	},
+	"th": {
+		Tag:                  "th",
+		Language:             "th",
+		Territory:            "TH",
+		WeekdayNames:         names210,
+		WeekdayAbbreviations: names211,
+		WeekdayNarrowNames:   names212,
+		MonthNames:           names213,
+		MonthAbbreviations:   names214,
+		MonthNarrowNames:     names214,
+		DayPeriods:           names215,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names216,
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"th-TH": {
+		Tag:                  "th-TH",
+		Language:             "th",
+		Territory:            "TH",
+		WeekdayNames:         names210,
+		WeekdayAbbreviations: names211,
+		WeekdayNarrowNames:   names212,
+		MonthNames:           names213,
+		MonthAbbreviations:   names214,
+		MonthNarrowNames:     names214,
+		DayPeriods:           names215,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		NativeDigits:         names216,
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"tr": {
+		Tag:                  "tr",
+		Language:             "tr",
+		Territory:            "TR",
+		WeekdayNames:         names217,
+		WeekdayAbbreviations: names218,
+		WeekdayNarrowNames:   names219,
+		MonthNames:           names220,
+		MonthAbbreviations:   names221,
+		MonthNarrowNames:     names222,
+		DayPeriods:           names223,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"tr-CY": {
+		Tag:                  "tr-CY",
+		Language:             "tr",
+		Territory:            "CY",
+		WeekdayNames:         names217,
+		WeekdayAbbreviations: names218,
+		WeekdayNarrowNames:   names219,
+		MonthNames:           names220,
+		MonthAbbreviations:   names221,
+		MonthNarrowNames:     names222,
+		DayPeriods:           names223,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"tr-TR": {
+		Tag:                  "tr-TR",
+		Language:             "tr",
+		Territory:            "TR",
+		WeekdayNames:         names217,
+		WeekdayAbbreviations: names218,
+		WeekdayNarrowNames:   names219,
+		MonthNames:           names220,
+		MonthAbbreviations:   names221,
+		MonthNarrowNames:     names222,
+		DayPeriods:           names223,
+		Ordinal:              Ordinal{SuffixOrdinal, "."},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"uk": {
+		Tag:                  "uk",
+		Language:             "uk",
+		Territory:            "UA",
+		WeekdayNames:         names224,
+		WeekdayAbbreviations: names10,
+		WeekdayNarrowNames:   names225,
+		MonthNames:           names226,
+		MonthGenitiveNames:   names227,
+		MonthAbbreviations:   names228,
+		MonthNarrowNames:     names229,
+		DayPeriods:           names230,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"uk-UA": {
+		Tag:                  "uk-UA",
+		Language:             "uk",
+		Territory:            "UA",
+		WeekdayNames:         names224,
+		WeekdayAbbreviations: names10,
+		WeekdayNarrowNames:   names225,
+		MonthNames:           names226,
+		MonthGenitiveNames:   names227,
+		MonthAbbreviations:   names228,
+		MonthNarrowNames:     names229,
+		DayPeriods:           names230,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"vi": {
+		Tag:                  "vi",
+		Language:             "vi",
+		Territory:            "VN",
+		WeekdayNames:         names231,
+		WeekdayAbbreviations: names232,
+		WeekdayNarrowNames:   names233,
+		MonthNames:           names234,
+		MonthGenitiveNames:   names235,
+		MonthAbbreviations:   names236,
+		MonthNarrowNames:     names29,
+		DayPeriods:           names237,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"vi-VN": {
+		Tag:                  "vi-VN",
+		Language:             "vi",
+		Territory:            "VN",
+		WeekdayNames:         names231,
+		WeekdayAbbreviations: names232,
+		WeekdayNarrowNames:   names233,
+		MonthNames:           names234,
+		MonthGenitiveNames:   names235,
+		MonthAbbreviations:   names236,
+		MonthNarrowNames:     names29,
+		DayPeriods:           names237,
+		Ordinal:              Ordinal{SuffixOrdinal, ""},
+		FirstDay:             time.Monday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"zh": {
+		Tag:                  "zh",
+		Language:             "zh",
+		Territory:            "CN",
+		WeekdayNames:         names238,
+		WeekdayAbbreviations: names239,
+		WeekdayNarrowNames:   names240,
+		MonthNames:           names241,
+		MonthAbbreviations:   names138,
+		MonthNarrowNames:     names29,
+		DayPeriods:           names242,
+		Ordinal:              Ordinal{SuffixOrdinal, "日"},
+		NativeDigits:         names140,
+		HanNumerals:          true,
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"zh-CN": {
+		Tag:                  "zh-CN",
+		Language:             "zh",
+		Territory:            "CN",
+		WeekdayNames:         names238,
+		WeekdayAbbreviations: names239,
+		WeekdayNarrowNames:   names240,
+		MonthNames:           names241,
+		MonthAbbreviations:   names138,
+		MonthNarrowNames:     names29,
+		DayPeriods:           names242,
+		Ordinal:              Ordinal{SuffixOrdinal, "日"},
+		NativeDigits:         names140,
+		HanNumerals:          true,
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"zh-HK": {
+		Tag:                  "zh-HK",
+		Language:             "zh",
+		Territory:            "HK",
+		WeekdayNames:         names238,
+		WeekdayAbbreviations: names243,
+		WeekdayNarrowNames:   names240,
+		MonthNames:           names138,
+		MonthAbbreviations:   names138,
+		MonthNarrowNames:     names29,
+		DayPeriods:           names242,
+		Ordinal:              Ordinal{SuffixOrdinal, "日"},
+		NativeDigits:         names140,
+		HanNumerals:          true,
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"zh-Hant": {
+		Tag:                  "zh-Hant",
+		Language:             "zh",
+		Territory:            "TW",
+		WeekdayNames:         names238,
+		WeekdayAbbreviations: names243,
+		WeekdayNarrowNames:   names240,
+		MonthNames:           names138,
+		MonthAbbreviations:   names138,
+		MonthNarrowNames:     names29,
+		DayPeriods:           names242,
+		Ordinal:              Ordinal{SuffixOrdinal, "日"},
+		NativeDigits:         names140,
+		HanNumerals:          true,
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"zh-MO": {
+		Tag:                  "zh-MO",
+		Language:             "zh",
+		Territory:            "MO",
+		WeekdayNames:         names238,
+		WeekdayAbbreviations: names243,
+		WeekdayNarrowNames:   names240,
+		MonthNames:           names138,
+		MonthAbbreviations:   names138,
+		MonthNarrowNames:     names29,
+		DayPeriods:           names242,
+		Ordinal:              Ordinal{SuffixOrdinal, "日"},
+		NativeDigits:         names140,
+		HanNumerals:          true,
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"zh-SG": {
+		Tag:                  "zh-SG",
+		Language:             "zh",
+		Territory:            "SG",
+		WeekdayNames:         names238,
+		WeekdayAbbreviations: names239,
+		WeekdayNarrowNames:   names240,
+		MonthNames:           names241,
+		MonthAbbreviations:   names138,
+		MonthNarrowNames:     names29,
+		DayPeriods:           names242,
+		Ordinal:              Ordinal{SuffixOrdinal, "日"},
+		NativeDigits:         names140,
+		HanNumerals:          true,
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+	"zh-TW": {
+		Tag:                  "zh-TW",
+		Language:             "zh",
+		Territory:            "TW",
+		WeekdayNames:         names238,
+		WeekdayAbbreviations: names243,
+		WeekdayNarrowNames:   names240,
+		MonthNames:           names138,
+		MonthAbbreviations:   names138,
+		MonthNarrowNames:     names29,
+		DayPeriods:           names242,
+		Ordinal:              Ordinal{SuffixOrdinal, "日"},
+		NativeDigits:         names140,
+		HanNumerals:          true,
+		FirstDay:             time.Sunday,
+		WeekendStart:         time.Saturday,
+		WeekendEnd:           time.Sunday,
+		MinimalDays:          1,
+	},
+}
+
+var aliases = map[string]string{
+	"ch":      "gsw",
+	"in":      "id",
+	"iw":      "he",
+	"iw-IL":   "he",
+	"no":      "nb",
+	"no-NO":   "nb",
+	"zh-Hans": "zh",
+}
+
+var (
+	names0   = []string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"}
+	names1   = []string{"ح", "ن", "ث", "ر", "خ", "ج", "س"}
+	names2   = []string{"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"}
+	names3   = []string{"ي", "ف", "م", "أ", "و", "ن", "ل", "غ", "س", "ك", "ب", "د"}
+	names4   = []string{"ص", "م"}
+	names5   = []string{"٠", "١", "٢", "٣", "٤", "٥", "٦", "٧", "٨", "٩"}
+	names6   = []string{"جانفي", "فيفري", "مارس", "أفريل", "ماي", "جوان", "جويلية", "أوت", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"}
+	names7   = []string{"كانون الثاني", "شباط", "آذار", "نيسان", "أيار", "حزيران", "تموز", "آب", "أيلول", "تشرين الأول", "تشرين الثاني", "كانون الأول"}
+	names8   = []string{"يناير", "فبراير", "مارس", "أبريل", "ماي", "يونيو", "يوليوز", "غشت", "شتنبر", "أكتوبر", "نونبر", "دجنبر"}
+	names9   = []string{"неделя", "понеделник", "вторник", "сряда", "четвъртък", "петък", "събота"}
+	names10  = []string{"нд", "пн", "вт", "ср", "чт", "пт", "сб"}
+	names11  = []string{"н", "п", "в", "с", "ч", "п", "с"}
+	names12  = []string{"януари", "февруари", "март", "април", "май", "юни", "юли", "август", "септември", "октомври", "ноември", "декември"}
+	names13  = []string{"яну", "фев", "март", "апр", "май", "юни", "юли", "авг", "сеп", "окт", "ное", "дек"}
+	names14  = []string{"я", "ф", "м", "а", "м", "ю", "ю", "а", "с", "о", "н", "д"}
+	names15  = []string{"пр.об.", "сл.об."}
+	names16  = []string{"diumenge", "dilluns", "dimarts", "dimecres", "dijous", "divendres", "dissabte"}
+	names17  = []string{"dg.", "dl.", "dt.", "dc.", "dj.", "dv.", "ds."}
+	names18  = []string{"dg", "dl", "dt", "dc", "dj", "dv", "ds"}
+	names19  = []string{"gener", "febrer", "març", "abril", "maig", "juny", "juliol", "agost", "setembre", "octubre", "novembre", "desembre"}
+	names20  = []string{"gen.", "febr.", "març", "abr.", "maig", "juny", "jul.", "ag.", "set.", "oct.", "nov.", "des."}
+	names21  = []string{"GN", "FB", "MÇ", "AB", "MG", "JN", "JL", "AG", "ST", "OC", "NV", "DS"}
+	names22  = []string{"a. m.", "p. m."}
+	names23  = []string{"neděle", "pondělí", "úterý", "středa", "čtvrtek", "pátek", "sobota"}
+	names24  = []string{"ne", "po", "út", "st", "čt", "pá", "so"}
+	names25  = []string{"N", "P", "Ú", "S", "Č", "P", "S"}
+	names26  = []string{"leden", "únor", "březen", "duben", "květen", "červen", "červenec", "srpen", "září", "říjen", "listopad", "prosinec"}
+	names27  = []string{"ledna", "února", "března", "dubna", "května", "června", "července", "srpna", "září", "října", "listopadu", "prosince"}
+	names28  = []string{"led", "úno", "bře", "dub", "kvě", "čvn", "čvc", "srp", "zář", "říj", "lis", "pro"}
+	names29  = []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}
+	names30  = []string{"dop.", "odp."}
+	names31  = []string{"søndag", "mandag", "tirsdag", "onsdag", "torsdag", "fredag", "lørdag"}
+	names32  = []string{"søn.", "man.", "tirs.", "ons.", "tors.", "fre.", "lør."}
+	names33  = []string{"S", "M", "T", "O", "T", "F", "L"}
+	names34  = []string{"januar", "februar", "marts", "april", "maj", "juni", "juli", "august", "september", "oktober", "november", "december"}
+	names35  = []string{"jan.", "feb.", "mar.", "apr.", "maj", "jun.", "jul.", "aug.", "sep.", "okt.", "nov.", "dec."}
+	names36  = []string{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"}
+	names37  = []string{"AM", "PM"}
+	names38  = []string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"}
+	names39  = []string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."}
+	names40  = []string{"S", "M", "D", "M", "D", "F", "S"}
+	names41  = []string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"}
+	names42  = []string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."}
+	names43  = []string{"Jänner", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"}
+	names44  = []string{"Jän.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sep.", "Okt.", "Nov.", "Dez."}
+	names45  = []string{"Κυριακή", "Δευτέρα", "Τρίτη", "Τετάρτη", "Πέμπτη", "Παρασκευή", "Σάββατο"}
+	names46  = []string{"Κυρ", "Δευ", "Τρί", "Τετ", "Πέμ", "Παρ", "Σάβ"}
+	names47  = []string{"Κ", "Δ", "Τ", "Τ", "Π", "Π", "Σ"}
+	names48  = []string{"Ιανουάριος", "Φεβρουάριος", "Μάρτιος", "Απρίλιος", "Μάιος", "Ιούνιος", "Ιούλιος", "Αύγουστος", "Σεπτέμβριος", "Οκτώβριος", "Νοέμβριος", "Δεκέμβριος"}
+	names49  = []string{"Ιανουαρίου", "Φεβρουαρίου", "Μαρτίου", "Απριλίου", "Μαΐου", "Ιουνίου", "Ιουλίου", "Αυγούστου", "Σεπτεμβρίου", "Οκτωβρίου", "Νοεμβρίου", "Δεκεμβρίου"}
+	names50  = []string{"Ιαν", "Φεβ", "Μαρ", "Απρ", "Μαΐ", "Ιουν", "Ιουλ", "Αυγ", "Σεπ", "Οκτ", "Νοε", "Δεκ"}
+	names51  = []string{"Ι", "Φ", "Μ", "Α", "Μ", "Ι", "Ι", "Α", "Σ", "Ο", "Ν", "Δ"}
+	names52  = []string{"π.μ.", "μ.μ."}
+	names53  = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+	names54  = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+	names55  = []string{"S", "M", "T", "W", "T", "F", "S"}
+	names56  = []string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}
+	names57  = []string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
+	names58  = []string{"am", "pm"}
+	names59  = []string{"a.m.", "p.m."}
+	names60  = []string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"}
+	names61  = []string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"}
+	names62  = []string{"D", "L", "M", "X", "J", "V", "S"}
+	names63  = []string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"}
+	names64  = []string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"}
+	names65  = []string{"E", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"}
+	names66  = []string{"pühapäev", "esmaspäev", "teisipäev", "kolmapäev", "neljapäev", "reede", "laupäev"}
+	names67  = []string{"P", "E", "T", "K", "N", "R", "L"}
+	names68  = []string{"jaanuar", "veebruar", "märts", "aprill", "mai", "juuni", "juuli", "august", "september", "oktoober", "november", "detsember"}
+	names69  = []string{"jaan", "veebr", "märts", "apr", "mai", "juuni", "juuli", "aug", "sept", "okt", "nov", "dets"}
+	names70  = []string{"J", "V", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"}
+	names71  = []string{"یکشنبه", "دوشنبه", "سه\u200cشنبه", "چهارشنبه", "پنجشنبه", "جمعه", "شنبه"}
+	names72  = []string{"ی", "د", "س", "چ", "پ", "ج", "ش"}
+	names73  = []string{"ژانویه", "فوریه", "مارس", "آوریل", "مه", "ژوئن", "ژوئیه", "اوت", "سپتامبر", "اکتبر", "نوامبر", "دسامبر"}
+	names74  = []string{"ژ", "ف", "م", "آ", "م", "ژ", "ژ", "ا", "س", "ا", "ن", "د"}
+	names75  = []string{"ق.ظ.", "ب.ظ."}
+	names76  = []string{"۰", "۱", "۲", "۳", "۴", "۵", "۶", "۷", "۸", "۹"}
+	names77  = []string{"sunnuntai", "maanantai", "tiistai", "keskiviikko", "torstai", "perjantai", "lauantai"}
+	names78  = []string{"su", "ma", "ti", "ke", "to", "pe", "la"}
+	names79  = []string{"S", "M", "T", "K", "T", "P", "L"}
+	names80  = []string{"tammikuu", "helmikuu", "maaliskuu", "huhtikuu", "toukokuu", "kesäkuu", "heinäkuu", "elokuu", "syyskuu", "lokakuu", "marraskuu", "joulukuu"}
+	names81  = []string{"tammikuuta", "helmikuuta", "maaliskuuta", "huhtikuuta", "toukokuuta", "kesäkuuta", "heinäkuuta", "elokuuta", "syyskuuta", "lokakuuta", "marraskuuta", "joulukuuta"}
+	names82  = []string{"tammi", "helmi", "maalis", "huhti", "touko", "kesä", "heinä", "elo", "syys", "loka", "marras", "joulu"}
+	names83  = []string{"T", "H", "M", "H", "T", "K", "H", "E", "S", "L", "M", "J"}
+	names84  = []string{"ap.", "ip."}
+	names85  = []string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"}
+	names86  = []string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."}
+	names87  = []string{"D", "L", "M", "M", "J", "V", "S"}
+	names88  = []string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"}
+	names89  = []string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."}
+	names90  = []string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juill.", "août", "sept.", "oct.", "nov.", "déc."}
+	names91  = []string{"suntig", "mäntig", "zistig", "mittwuch", "donstig", "fritig", "samstig"}
+	names92  = []string{"su.", "mä.", "zi.", "mi.", "do.", "fr.", "sa."}
+	names93  = []string{"s", "m", "z", "m", "d", "f", "s"}
+	names94  = []string{"januar", "februar", "märz", "apriu", "mai", "juni", "july", "august", "september", "oktober", "november", "dezember"}
+	names95  = []string{"jan", "feb", "mär", "apr", "mai", "jun", "jul", "aug", "sep", "okt", "nov", "dez"}
+	names96  = []string{"j", "f", "m", "a", "m", "j", "j", "a", "s", "o", "n", "d"}
+	names97  = []string{"vorm.", "nam."}
+	names98  = []string{"יום ראשון", "יום שני", "יום שלישי", "יום רביעי", "יום חמישי", "יום שישי", "יום שבת"}
+	names99  = []string{"יום א׳", "יום ב׳", "יום ג׳", "יום ד׳", "יום ה׳", "יום ו׳", "שבת"}
+	names100 = []string{"א׳", "ב׳", "ג׳", "ד׳", "ה׳", "ו׳", "ש׳"}
+	names101 = []string{"ינואר", "פברואר", "מרץ", "אפריל", "מאי", "יוני", "יולי", "אוגוסט", "ספטמבר", "אוקטובר", "נובמבר", "דצמבר"}
+	names102 = []string{"ינו׳", "פבר׳", "מרץ", "אפר׳", "מאי", "יוני", "יולי", "אוג׳", "ספט׳", "אוק׳", "נוב׳", "דצמ׳"}
+	names103 = []string{"לפנה״צ", "אחה״צ"}
+	names104 = []string{"रविवार", "सोमवार", "मंगलवार", "बुधवार", "गुरुवार", "शुक्रवार", "शनिवार"}
+	names105 = []string{"रवि", "सोम", "मंगल", "बुध", "गुरु", "शुक्र", "शनि"}
+	names106 = []string{"र", "सो", "मं", "बु", "गु", "शु", "श"}
+	names107 = []string{"जनवरी", "फ़रवरी", "मार्च", "अप्रैल", "मई", "जून", "जुलाई", "अगस्त", "सितंबर", "अक्टूबर", "नवंबर", "दिसंबर"}
+	names108 = []string{"जन॰", "फ़र॰", "मार्च", "अप्रैल", "मई", "जून", "जुल॰", "अग॰", "सित॰", "अक्तू॰", "नव॰", "दिस॰"}
+	names109 = []string{"ज", "फ़", "मा", "अ", "म", "जू", "जु", "अ", "सि", "अ", "न", "दि"}
+	names110 = []string{"०", "१", "२", "३", "४", "५", "६", "७", "८", "९"}
+	names111 = []string{"nedjelja", "ponedjeljak", "utorak", "srijeda", "četvrtak", "petak", "subota"}
+	names112 = []string{"ned", "pon", "uto", "sri", "čet", "pet", "sub"}
+	names113 = []string{"N", "P", "U", "S", "Č", "P", "S"}
+	names114 = []string{"siječanj", "veljača", "ožujak", "travanj", "svibanj", "lipanj", "srpanj", "kolovoz", "rujan", "listopad", "studeni", "prosinac"}
+	names115 = []string{"siječnja", "veljače", "ožujka", "travnja", "svibnja", "lipnja", "srpnja", "kolovoza", "rujna", "listopada", "studenoga", "prosinca"}
+	names116 = []string{"sij", "velj", "ožu", "tra", "svi", "lip", "srp", "kol", "ruj", "lis", "stu", "pro"}
+	names117 = []string{"1.", "2.", "3.", "4.", "5.", "6.", "7.", "8.", "9.", "10.", "11.", "12."}
+	names118 = []string{"vasárnap", "hétfő", "kedd", "szerda", "csütörtök", "péntek", "szombat"}
+	names119 = []string{"V", "H", "K", "Sze", "Cs", "P", "Szo"}
+	names120 = []string{"V", "H", "K", "Sz", "Cs", "P", "Sz"}
+	names121 = []string{"január", "február", "március", "április", "május", "június", "július", "augusztus", "szeptember", "október", "november", "december"}
+	names122 = []string{"jan.", "febr.", "márc.", "ápr.", "máj.", "jún.", "júl.", "aug.", "szept.", "okt.", "nov.", "dec."}
+	names123 = []string{"J", "F", "M", "Á", "M", "J", "J", "A", "Sz", "O", "N", "D"}
+	names124 = []string{"de.", "du."}
+	names125 = []string{"Minggu", "Senin", "Selasa", "Rabu", "Kamis", "Jumat", "Sabtu"}
+	names126 = []string{"Min", "Sen", "Sel", "Rab", "Kam", "Jum", "Sab"}
+	names127 = []string{"M", "S", "S", "R", "K", "J", "S"}
+	names128 = []string{"Januari", "Februari", "Maret", "April", "Mei", "Juni", "Juli", "Agustus", "September", "Oktober", "November", "Desember"}
+	names129 = []string{"Jan", "Feb", "Mar", "Apr", "Mei", "Jun", "Jul", "Agu", "Sep", "Okt", "Nov", "Des"}
+	names130 = []string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"}
+	names131 = []string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"}
+	names132 = []string{"D", "L", "M", "M", "G", "V", "S"}
+	names133 = []string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"}
+	names134 = []string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"}
+	names135 = []string{"G", "F", "M", "A", "M", "G", "L", "A", "S", "O", "N", "D"}
+	names136 = []string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"}
+	names137 = []string{"日", "月", "火", "水", "木", "金", "土"}
+	names138 = []string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"}
+	names139 = []string{"午前", "午後"}
+	names140 = []string{"〇", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+	names141 = []string{"일요일", "월요일", "화요일", "수요일", "목요일", "금요일", "토요일"}
+	names142 = []string{"일", "월", "화", "수", "목", "금", "토"}
+	names143 = []string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"}
+	names144 = []string{"오전", "오후"}
+	names145 = []string{"sekmadienis", "pirmadienis", "antradienis", "trečiadienis", "ketvirtadienis", "penktadienis", "šeštadienis"}
+	names146 = []string{"sk", "pr", "an", "tr", "kt", "pn", "št"}
+	names147 = []string{"S", "P", "A", "T", "K", "P", "Š"}
+	names148 = []string{"sausis", "vasaris", "kovas", "balandis", "gegužė", "birželis", "liepa", "rugpjūtis", "rugsėjis", "spalis", "lapkritis", "gruodis"}
+	names149 = []string{"sausio", "vasario", "kovo", "balandžio", "gegužės", "birželio", "liepos", "rugpjūčio", "rugsėjo", "spalio", "lapkričio", "gruodžio"}
+	names150 = []string{"saus.", "vas.", "kov.", "bal.", "geg.", "birž.", "liep.", "rugp.", "rugs.", "spal.", "lapkr.", "gruod."}
+	names151 = []string{"S", "V", "K", "B", "G", "B", "L", "R", "R", "S", "L", "G"}
+	names152 = []string{"priešpiet", "popiet"}
+	names153 = []string{"svētdiena", "pirmdiena", "otrdiena", "trešdiena", "ceturtdiena", "piektdiena", "sestdiena"}
+	names154 = []string{"svētd.", "pirmd.", "otrd.", "trešd.", "ceturtd.", "piektd.", "sestd."}
+	names155 = []string{"S", "P", "O", "T", "C", "P", "S"}
+	names156 = []string{"janvāris", "februāris", "marts", "aprīlis", "maijs", "jūnijs", "jūlijs", "augusts", "septembris", "oktobris", "novembris", "decembris"}
+	names157 = []string{"janv.", "febr.", "marts", "apr.", "maijs", "jūn.", "jūl.", "aug.", "sept.", "okt.", "nov.", "dec."}
+	names158 = []string{"priekšp.", "pēcp."}
+	names159 = []string{"søn.", "man.", "tir.", "ons.", "tor.", "fre.", "lør."}
+	names160 = []string{"januar", "februar", "mars", "april", "mai", "juni", "juli", "august", "september", "oktober", "november", "desember"}
+	names161 = []string{"jan.", "feb.", "mar.", "apr.", "mai", "jun.", "jul.", "aug.", "sep.", "okt.", "nov.", "des."}
+	names162 = []string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"}
+	names163 = []string{"zo", "ma", "di", "wo", "do", "vr", "za"}
+	names164 = []string{"Z", "M", "D", "W", "D", "V", "Z"}
+	names165 = []string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"}
+	names166 = []string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"}
+	names167 = []string{"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"}
+	names168 = []string{"niedz.", "pon.", "wt.", "śr.", "czw.", "pt.", "sob."}
+	names169 = []string{"n", "p", "w", "ś", "c", "p", "s"}
+	names170 = []string{"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec", "lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień"}
+	names171 = []string{"stycznia", "lutego", "marca", "kwietnia", "maja", "czerwca", "lipca", "sierpnia", "września", "października", "listopada", "grudnia"}
+	names172 = []string{"sty", "lut", "mar", "kwi", "maj", "cze", "lip", "sie", "wrz", "paź", "lis", "gru"}
+	names173 = []string{"s", "l", "m", "k", "m", "c", "l", "s", "w", "p", "l", "g"}
+	names174 = []string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"}
+	names175 = []string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."}
+	names176 = []string{"D", "S", "T", "Q", "Q", "S", "S"}
+	names177 = []string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"}
+	names178 = []string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."}
+	names179 = []string{"da manhã", "da tarde"}
+	names180 = []string{"domingo", "segunda", "terça", "quarta", "quinta", "sexta", "sábado"}
+	names181 = []string{"duminică", "luni", "marți", "miercuri", "joi", "vineri", "sâmbătă"}
+	names182 = []string{"dum.", "lun.", "mar.", "mie.", "joi", "vin.", "sâm."}
+	names183 = []string{"ianuarie", "februarie", "martie", "aprilie", "mai", "iunie", "iulie", "august", "septembrie", "octombrie", "noiembrie", "decembrie"}
+	names184 = []string{"ian.", "feb.", "mar.", "apr.", "mai", "iun.", "iul.", "aug.", "sept.", "oct.", "nov.", "dec."}
+	names185 = []string{"I", "F", "M", "A", "M", "I", "I", "A", "S", "O", "N", "D"}
+	names186 = []string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"}
+	names187 = []string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"}
+	names188 = []string{"В", "П", "В", "С", "Ч", "П", "С"}
+	names189 = []string{"январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"}
+	names190 = []string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"}
+	names191 = []string{"янв.", "февр.", "март", "апр.", "май", "июнь", "июль", "авг.", "сент.", "окт.", "нояб.", "дек."}
+	names192 = []string{"Я", "Ф", "М", "А", "М", "И", "И", "А", "С", "О", "Н", "Д"}
+	names193 = []string{"nedeľa", "pondelok", "utorok", "streda", "štvrtok", "piatok", "sobota"}
+	names194 = []string{"ne", "po", "ut", "st", "št", "pi", "so"}
+	names195 = []string{"n", "p", "u", "s", "š", "p", "s"}
+	names196 = []string{"január", "február", "marec", "apríl", "máj", "jún", "júl", "august", "september", "október", "november", "december"}
+	names197 = []string{"januára", "februára", "marca", "apríla", "mája", "júna", "júla", "augusta", "septembra", "októbra", "novembra", "decembra"}
+	names198 = []string{"jan", "feb", "mar", "apr", "máj", "jún", "júl", "aug", "sep", "okt", "nov", "dec"}
+	names199 = []string{"nedelja", "ponedeljek", "torek", "sreda", "četrtek", "petek", "sobota"}
+	names200 = []string{"ned.", "pon.", "tor.", "sre.", "čet.", "pet.", "sob."}
+	names201 = []string{"n", "p", "t", "s", "č", "p", "s"}
+	names202 = []string{"januar", "februar", "marec", "april", "maj", "junij", "julij", "avgust", "september", "oktober", "november", "december"}
+	names203 = []string{"jan.", "feb.", "mar.", "apr.", "maj", "jun.", "jul.", "avg.", "sep.", "okt.", "nov.", "dec."}
+	names204 = []string{"dop.", "pop."}
+	names205 = []string{"söndag", "måndag", "tisdag", "onsdag", "torsdag", "fredag", "lördag"}
+	names206 = []string{"sön", "mån", "tis", "ons", "tors", "fre", "lör"}
+	names207 = []string{"januari", "februari", "mars", "april", "maj", "juni", "juli", "augusti", "september", "oktober", "november", "december"}
+	names208 = []string{"jan.", "feb.", "mars", "apr.", "maj", "juni", "juli", "aug.", "sep.", "okt.", "nov.", "dec."}
+	names209 = []string{"fm", "em"}
+	names210 = []string{"วันอาทิตย์", "วันจันทร์", "วันอังคาร", "วันพุธ", "วันพฤหัสบดี", "วันศุกร์", "วันเสาร์"}
+	names211 = []string{"อา.", "จ.", "อ.", "พ.", "พฤ.", "ศ.", "ส."}
+	names212 = []string{"อา", "จ", "อ", "พ", "พฤ", "ศ", "ส"}
+	names213 = []string{"มกราคม", "กุมภาพันธ์", "มีนาคม", "เมษายน", "พฤษภาคม", "มิถุนายน", "กรกฎาคม", "สิงหาคม", "กันยายน", "ตุลาคม", "พฤศจิกายน", "ธันวาคม"}
+	names214 = []string{"ม.ค.", "ก.พ.", "มี.ค.", "เม.ย.", "พ.ค.", "มิ.ย.", "ก.ค.", "ส.ค.", "ก.ย.", "ต.ค.", "พ.ย.", "ธ.ค."}
+	names215 = []string{"ก่อนเที่ยง", "หลังเที่ยง"}
+	names216 = []string{"๐", "๑", "๒", "๓", "๔", "๕", "๖", "๗", "๘", "๙"}
+	names217 = []string{"Pazar", "Pazartesi", "Salı", "Çarşamba", "Perşembe", "Cuma", "Cumartesi"}
+	names218 = []string{"Paz", "Pzt", "Sal", "Çar", "Per", "Cum", "Cmt"}
+	names219 = []string{"P", "P", "S", "Ç", "P", "C", "C"}
+	names220 = []string{"Ocak", "Şubat", "Mart", "Nisan", "Mayıs", "Haziran", "Temmuz", "Ağustos", "Eylül", "Ekim", "Kasım", "Aralık"}
+	names221 = []string{"Oca", "Şub", "Mar", "Nis", "May", "Haz", "Tem", "Ağu", "Eyl", "Eki", "Kas", "Ara"}
+	names222 = []string{"O", "Ş", "M", "N", "M", "H", "T", "A", "E", "E", "K", "A"}
+	names223 = []string{"ÖÖ", "ÖS"}
+	names224 = []string{"неділя", "понеділок", "вівторок", "середа", "четвер", "пʼятниця", "субота"}
+	names225 = []string{"Н", "П", "В", "С", "Ч", "П", "С"}
+	names226 = []string{"січень", "лютий", "березень", "квітень", "травень", "червень", "липень", "серпень", "вересень", "жовтень", "листопад", "грудень"}
+	names227 = []string{"січня", "лютого", "березня", "квітня", "травня", "червня", "липня", "серпня", "вересня", "жовтня", "листопада", "грудня"}
+	names228 = []string{"січ.", "лют.", "бер.", "квіт.", "трав.", "черв.", "лип.", "серп.", "вер.", "жовт.", "лист.", "груд."}
+	names229 = []string{"С", "Л", "Б", "К", "Т", "Ч", "Л", "С", "В", "Ж", "Л", "Г"}
+	names230 = []string{"дп", "пп"}
+	names231 = []string{"Chủ Nhật", "Thứ Hai", "Thứ Ba", "Thứ Tư", "Thứ Năm", "Thứ Sáu", "Thứ Bảy"}
+	names232 = []string{"CN", "Th 2", "Th 3", "Th 4", "Th 5", "Th 6", "Th 7"}
+	names233 = []string{"CN", "T2", "T3", "T4", "T5", "T6", "T7"}
+	names234 = []string{"Tháng 1", "Tháng 2", "Tháng 3", "Tháng 4", "Tháng 5", "Tháng 6", "Tháng 7", "Tháng 8", "Tháng 9", "Tháng 10", "Tháng 11", "Tháng 12"}
+	names235 = []string{"tháng 1", "tháng 2", "tháng 3", "tháng 4", "tháng 5", "tháng 6", "tháng 7", "tháng 8", "tháng 9", "tháng 10", "tháng 11", "tháng 12"}
+	names236 = []string{"Thg 1", "Thg 2", "Thg 3", "Thg 4", "Thg 5", "Thg 6", "Thg 7", "Thg 8", "Thg 9", "Thg 10", "Thg 11", "Thg 12"}
+	names237 = []string{"SA", "CH"}
+	names238 = []string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"}
+	names239 = []string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"}
+	names240 = []string{"日", "一", "二", "三", "四", "五", "六"}
+	names241 = []string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"}
+	names242 = []string{"上午", "下午"}
+	names243 = []string{"週日", "週一", "週二", "週三", "週四", "週五", "週六"}
+)
@@ -0,0 +1,31 @@
+package locale
+
+import "strings"
+
+// posixScripts maps the modifiers of POSIX locale names which choose a
+// script to the script subtag, e.g. sr_RS@latin.
+var posixScripts = map[string]string{
+	"latin":      "Latn",
+	"cyrillic":   "Cyrl",
+	"devanagari": "Deva",
+}
+
+// POSIXTag converts a POSIX locale name of the form
+// language[_territory][.codeset][@modifier], e.g. de_CH.UTF-8, to a BCP 47
+// tag. The C and POSIX locales have no tag.
+func POSIXTag(name string) string {
+	name, modifier, _ := strings.Cut(name, "@")
+	name, _, _ = strings.Cut(name, ".")
+	if name == "" || name == "C" || name == "POSIX" {
+		return ""
+	}
+	language, territory, _ := strings.Cut(name, "_")
+	tag := language
+	if script, found := posixScripts[strings.ToLower(modifier)]; found {
+		tag += "-" + script
+	}
+	if territory != "" {
+		tag += "-" + territory
+	}
+	return Canonicalize(tag)
+}
@@ -0,0 +1,224 @@
+package locale
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The locale files are written in a subset of YAML: nested mappings, block
+// sequences ("- name"), flow sequences ("[a, b]"), plain, single and double
+// quoted scalars and comments. Anchors, multi-line scalars and documents are
+// not supported.
+
+type nodeKind int
+
+const (
+	scalarNode nodeKind = iota
+	sequenceNode
+	mappingNode
+)
+
+type node struct {
+	kind   nodeKind
+	line   int
+	value  string
+	items  []string
+	fields map[string]*node
+	// keys holds the keys of a mapping in the order of the file.
+	keys []string
+}
+
+type yamlLine struct {
+	number int
+	indent int
+	text   string
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+func parseYAML(data string) (*node, error) {
+	p := yamlParser{}
+	for i, text := range strings.Split(data, "\n") {
+		text = strings.TrimRight(text, " \t\r")
+		trimmed := strings.TrimLeft(text, " ")
+		if trimmed == "" || trimmed[0] == '#' || trimmed == "---" {
+			continue
+		}
+		if trimmed[0] == '\t' {
+			return nil, fmt.Errorf("line %d: tabs are not allowed for indentation", i+1)
+		}
+		p.lines = append(p.lines, yamlLine{i + 1, len(text) - len(trimmed), trimmed})
+	}
+	if len(p.lines) == 0 {
+		return &node{kind: mappingNode, fields: map[string]*node{}}, nil
+	}
+	if p.lines[0].indent != 0 {
+		return nil, fmt.Errorf("line %d: unexpected indentation", p.lines[0].number)
+	}
+	return p.parseMapping(0)
+}
+
+func isSequenceItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+func (p *yamlParser) parseBlock(indent int) (*node, error) {
+	if isSequenceItem(p.lines[p.pos].text) {
+		return p.parseSequence(indent)
+	}
+	return p.parseMapping(indent)
+}
+
+func (p *yamlParser) parseMapping(indent int) (*node, error) {
+	n := &node{kind: mappingNode, line: p.lines[p.pos].number, fields: map[string]*node{}}
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			break
+		}
+		if l.indent > indent {
+			return nil, fmt.Errorf("line %d: unexpected indentation", l.number)
+		}
+		if isSequenceItem(l.text) {
+			return nil, fmt.Errorf("line %d: expected a key, got a list item", l.number)
+		}
+		key, rest, found := strings.Cut(l.text, ":")
+		key = strings.TrimSpace(key)
+		if !found || key == "" || (rest != "" && rest[0] != ' ') {
+			return nil, fmt.Errorf("line %d: expected key: value", l.number)
+		}
+		if _, duplicate := n.fields[key]; duplicate {
+			return nil, fmt.Errorf("line %d: duplicate key %q", l.number, key)
+		}
+		rest = strings.TrimSpace(rest)
+		p.pos++
+
+		var child *node
+		var err error
+		switch {
+		case rest != "" && rest[0] != '#':
+			child, err = parseInline(rest, l.number)
+		case p.pos < len(p.lines) && p.lines[p.pos].indent > indent:
+			child, err = p.parseBlock(p.lines[p.pos].indent)
+		case p.pos < len(p.lines) && p.lines[p.pos].indent == indent && isSequenceItem(p.lines[p.pos].text):
+			// A list may start at the indentation of its key.
+			child, err = p.parseSequence(indent)
+		default:
+			child = &node{kind: scalarNode, line: l.number}
+		}
+		if err != nil {
+			return nil, err
+		}
+		n.fields[key] = child
+		n.keys = append(n.keys, key)
+	}
+	return n, nil
+}
+
+func (p *yamlParser) parseSequence(indent int) (*node, error) {
+	n := &node{kind: sequenceNode, line: p.lines[p.pos].number}
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent || (l.indent == indent && !isSequenceItem(l.text)) {
+			break
+		}
+		if l.indent > indent || !isSequenceItem(l.text) {
+			return nil, fmt.Errorf("line %d: nested lists and mappings are not supported in a list", l.number)
+		}
+		item, err := parseScalar(strings.TrimPrefix(l.text, "-"), l.number)
+		if err != nil {
+			return nil, err
+		}
+		n.items = append(n.items, item)
+		p.pos++
+	}
+	return n, nil
+}
+
+// parseInline parses the value after a key, a flow sequence or a scalar.
+func parseInline(text string, line int) (*node, error) {
+	if text[0] != '[' {
+		value, err := parseScalar(text, line)
+		return &node{kind: scalarNode, line: line, value: value}, err
+	}
+	n := &node{kind: sequenceNode, line: line}
+	rest := strings.TrimSpace(text[1:])
+	for {
+		if rest == "" {
+			return nil, fmt.Errorf("line %d: unterminated list", line)
+		}
+		if rest[0] == ']' {
+			if after := strings.TrimSpace(rest[1:]); after != "" && after[0] != '#' {
+				return nil, fmt.Errorf("line %d: unexpected text after list", line)
+			}
+			return n, nil
+		}
+		end := scalarEnd(rest)
+		item, err := parseScalar(rest[:end], line)
+		if err != nil {
+			return nil, err
+		}
+		n.items = append(n.items, item)
+		rest = strings.TrimSpace(rest[end:])
+		if strings.HasPrefix(rest, ",") {
+			rest = strings.TrimSpace(rest[1:])
+		} else if rest != "" && rest[0] != ']' {
+			return nil, fmt.Errorf("line %d: expected , or ] in list", line)
+		}
+	}
+}
+
+// scalarEnd returns the length of the scalar at the start of a flow
+// sequence entry.
+func scalarEnd(text string) int {
+	if text[0] == '"' || text[0] == '\'' {
+		for i := 1; i < len(text); i++ {
+			switch {
+			case text[0] == '"' && text[i] == '\\':
+				i++
+			case text[i] == text[0] && text[0] == '\'' && i+1 < len(text) && text[i+1] == '\'':
+				i++
+			case text[i] == text[0]:
+				return i + 1
+			}
+		}
+		return len(text)
+	}
+	if end := strings.IndexAny(text, ",]"); end != -1 {
+		return end
+	}
+	return len(text)
+}
+
+func parseScalar(text string, line int) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", nil
+	}
+	switch text[0] {
+	case '"', '\'':
+		end := scalarEnd(text)
+		if end < 2 || text[end-1] != text[0] {
+			return "", fmt.Errorf("line %d: unterminated string", line)
+		}
+		if after := strings.TrimSpace(text[end:]); after != "" && after[0] != '#' {
+			return "", fmt.Errorf("line %d: unexpected text after string", line)
+		}
+		if text[0] == '\'' {
+			return strings.ReplaceAll(text[1:end-1], "''", "'"), nil
+		}
+		value, err := strconv.Unquote(text[:end])
+		if err != nil {
+			return "", fmt.Errorf("line %d: invalid string %s", line, text[:end])
+		}
+		return value, nil
+	}
+	if comment := strings.Index(text, " #"); comment != -1 {
+		text = strings.TrimSpace(text[:comment])
+	}
+	return text, nil
+}
@@ -0,0 +1,1241 @@
+package messages
+
+// catalog holds the translations of the messages by language.
+var catalog = map[string]map[Message]string{
+	"de": {
+		InvalidOption:               "ungültige Option ohne Flag angegeben",
+		NoIgnoredWeekdays:           "keine Wochentage zum Auslassen angegeben",
+		InvalidWeekday:              "Wochentag konnte nicht gelesen werden",
+		WrongFormatArgs:             "falsche Argumente für das Format angegeben",
+		WrongFormatStyleArgs:        "falsche Anzahl Argumente für den Formatstil angegeben",
+		UnknownFormatStyle:          "unbekannter Formatstil erkannt",
+		ReverseArgs:                 "das Flag -r hat keine Argumente",
+		WrongLanguageArgs:           "falsche Anzahl Argumente für die Sprache angegeben",
+		UnknownLanguage:             "unbekannte Sprache erkannt",
+		WrongLocaleFileArgs:         "falsche Anzahl Argumente für die Locale-Datei angegeben",
+		WrongCountArgs:              "falsche Anzahl Argumente für die Anzahl angegeben",
+		InvalidCount:                "die Anzahl muss eine positive Zahl sein",
+		WrongTimezoneArgs:           "falsche Anzahl Argumente für die Zeitzone angegeben",
+		UnknownTimezone:             "unbekannte Zeitzone erkannt",
+		WrongStepArgs:               "falsche Anzahl Argumente für den Schritt angegeben",
+		InvalidStep:                 "ungültiger Schritt erkannt",
+		WrongCalendarArgs:           "falsche Anzahl Argumente für den Kalender angegeben",
+		UnknownCalendar:             "unbekannter Kalender erkannt",
+		WrongDigitsArgs:             "falsche Anzahl Argumente für die Ziffern angegeben",
+		UnknownDigits:               "unbekannte Ziffern erkannt",
+		WrongWeekStartArgs:          "falsche Anzahl Argumente für den Wochenbeginn angegeben",
+		UnknownWeekStart:            "unbekannter Wochenbeginn erkannt",
+		WrongWeekendArgs:            "falsche Anzahl Argumente für das Wochenende angegeben",
+		UnknownWeekend:              "unbekanntes Wochenende erkannt",
+		WorkdaysOnlyArgs:            "das Flag --workdays-only hat keine Argumente",
+		NoFilterValues:              "keine Werte für den Filter angegeben",
+		InvalidDay:                  "ungültiger Tag des Monats erkannt",
+		InvalidMonth:                "ungültiger Monat erkannt",
+		InvalidWeek:                 "ungültige Woche erkannt",
+		InvalidDayOfYear:            "ungültiger Tag des Jahres erkannt",
+		NoWhereExpression:           "kein Ausdruck für --where angegeben",
+		InvalidExpression:           "ungültiger Ausdruck für --where",
+		UnknownName:                 "unbekannter Name im Ausdruck für --where",
+		MismatchedTypes:             "Zahlen, Codes und Bedingungen im Ausdruck für --where verwechselt",
+		WrongHolidaysArgs:           "falsche Anzahl Argumente für die Feiertage angegeben",
+		InvalidHoliday:              "ungültiges Datum eines Feiertags erkannt",
+		UnreadableFile:              "die Datei kann nicht gelesen werden",
+		WrongOffsetArgs:             "falsche Anzahl Argumente für den Versatz angegeben",
+		InvalidOffset:               "der Versatz darf nicht negativ sein",
+		WrongEveryArgs:              "falsche Anzahl Argumente für --every angegeben",
+		InvalidEvery:                "--every muss eine positive Zahl sein",
+		WrongFirstArgs:              "falsche Anzahl Argumente für --first angegeben",
+		InvalidFirst:                "--first muss eine positive Zahl sein",
+		WrongLastArgs:               "falsche Anzahl Argumente für --last angegeben",
+		InvalidLast:                 "--last muss eine positive Zahl sein",
+		WrongSampleArgs:             "falsche Anzahl Argumente für die Stichprobe angegeben",
+		InvalidSample:               "die Stichprobe muss eine positive Zahl sein",
+		WrongSeedArgs:               "falsche Anzahl Argumente für den Seed angegeben",
+		InvalidSeed:                 "der Seed muss eine Zahl sein",
+		SeedWithoutSample:           "der Seed kann nicht ohne Stichprobe verwendet werden",
+		InvalidRange:                "ungültiger Zeitraum erkannt",
+		NoRanges:                    "keine Zeiträume für die Mengenoperation angegeben",
+		DatesWithRanges:             "Daten können nicht mit Zeiträumen kombiniert werden",
+		StdinArgs:                   "das Flag --stdin hat keine Argumente",
+		InvalidInput:                "ungültiges Datum oder ungültiger Zeitraum in der Eingabe",
+		StdinWithDates:              "--stdin kann nicht mit Daten, Zeiträumen oder einer Anzahl kombiniert werden",
+		WrongInArgs:                 "falsche Anzahl Argumente für --in angegeben",
+		NoInputFormat:               "reformat braucht das Format der Daten mit --in",
+		InputFormatWithoutReformat:  "--in kann nur mit reformat verwendet werden",
+		ReformatWithDates:           "reformat kann nicht mit Daten, Zeiträumen, einer Anzahl oder --stdin kombiniert werden",
+		UnsupportedInputPlaceholder: "Platzhalter kann nicht aus dem Text gelesen werden",
+		IncompleteInputFormat:       "das Format von --in braucht ein Jahr, einen Monat und einen Tag",
+		InfoWithDates:               "info nimmt ein einzelnes Datum",
+		JSONArgs:                    "das Flag --json hat keine Argumente",
+		JSONWithoutInfo:             "--json kann nur mit info verwendet werden",
+		InfoWithCount:               "info kann nicht mit einer Anzahl kombiniert werden",
+		InfoWithFilters:             "info kann nicht mit Filtern, Auswahlen, -r oder --step kombiniert werden",
+		GridArgs:                    "das Flag --grid hat keine Argumente",
+		GridWithCommand:             "--grid kann nur beim Ausgeben von Daten verwendet werden",
+		GridWithFormat:              "--grid kann nicht mit -f, --calendar oder -r kombiniert werden",
+		InfoDate:                    "Datum",
+		InfoWeekday:                 "Wochentag",
+		InfoISOWeek:                 "ISO-Woche",
+		InfoDayOfYear:               "Tag des Jahres",
+		InfoQuarter:                 "Quartal",
+		InfoFromToday:               "Ab heute",
+		InfoLeapYear:                "Schaltjahr",
+		InfoHolidays:                "Feiertage",
+		InfoToday:                   "heute",
+		InfoTomorrow:                "morgen",
+		InfoYesterday:               "gestern",
+		InfoInDays:                  "in %d Tagen",
+		InfoDaysAgo:                 "vor %d Tagen",
+		InfoYes:                     "ja",
+		InfoNo:                      "nein",
+		UnknownFlag:                 "unbekanntes Flag gefunden",
+		DuplicateFlag:               "doppeltes Flag gefunden",
+		WrongNumberOfDates:          "falsche Anzahl Daten angegeben",
+		DatesNotNextToEachOther:     "die beiden Daten stehen nicht nebeneinander",
+		DatesBetweenOptions:         "Daten stehen zwischen Optionen",
+		DoubleWeekday:               "doppelte Wochentage für -i erkannt",
+		CountWithEndDate:            "die Anzahl kann nicht mit einem Enddatum kombiniert werden",
+		CountWithoutWeekdays:        "die Anzahl kann nicht erreicht werden, wenn alle Wochentage ausgelassen werden",
+		CountWithoutMatches:         "die Anzahl kann nicht erreicht werden, wenn kein Datum zu den Filtern passt",
+		UnsupportedStrftime:         "nicht unterstützte strftime-Anweisung gefunden",
+		UnsupportedGoLayout:         "nicht unterstütztes Element des Go-Layouts gefunden",
+		UnterminatedPlaceholder:     "nicht abgeschlossener Platzhalter gefunden",
+		UnknownPlaceholder:          "unbekannter Platzhalter gefunden",
+		InvalidPadModifier:          "ungültiger pad-Modifikator gefunden",
+		InvalidLenModifier:          "ungültiger len-Modifikator gefunden",
+		UnknownModifier:             "unbekannter Modifikator für Platzhalter gefunden",
+	},
+	"fr": {
+		InvalidOption:               "option invalide donnée sans drapeau",
+		NoIgnoredWeekdays:           "aucun jour de la semaine à ignorer indiqué",
+		InvalidWeekday:              "erreur lors de la lecture d'un jour de la semaine",
+		WrongFormatArgs:             "mauvais arguments pour le format",
+		WrongFormatStyleArgs:        "mauvais nombre d'arguments pour le style de format",
+		UnknownFormatStyle:          "style de format inconnu détecté",
+		ReverseArgs:                 "le drapeau -r n'a pas d'arguments",
+		WrongLanguageArgs:           "mauvais nombre d'arguments pour la langue",
+		UnknownLanguage:             "langue inconnue détectée",
+		WrongLocaleFileArgs:         "mauvais nombre d'arguments pour le fichier de locale",
+		WrongCountArgs:              "mauvais nombre d'arguments pour le nombre de dates",
+		InvalidCount:                "le nombre de dates doit être un nombre positif",
+		WrongTimezoneArgs:           "mauvais nombre d'arguments pour le fuseau horaire",
+		UnknownTimezone:             "fuseau horaire inconnu détecté",
+		WrongStepArgs:               "mauvais nombre d'arguments pour le pas",
+		InvalidStep:                 "pas invalide détecté",
+		WrongCalendarArgs:           "mauvais nombre d'arguments pour le calendrier",
+		UnknownCalendar:             "calendrier inconnu détecté",
+		WrongDigitsArgs:             "mauvais nombre d'arguments pour les chiffres",
+		UnknownDigits:               "chiffres inconnus détectés",
+		WrongWeekStartArgs:          "mauvais nombre d'arguments pour le début de semaine",
+		UnknownWeekStart:            "début de semaine inconnu détecté",
+		WrongWeekendArgs:            "mauvais nombre d'arguments pour le week-end",
+		UnknownWeekend:              "week-end inconnu détecté",
+		WorkdaysOnlyArgs:            "le drapeau --workdays-only n'a pas d'arguments",
+		NoFilterValues:              "aucune valeur indiquée pour le filtre",
+		InvalidDay:                  "jour du mois invalide détecté",
+		InvalidMonth:                "mois invalide détecté",
+		InvalidWeek:                 "semaine invalide détectée",
+		InvalidDayOfYear:            "jour de l'année invalide détecté",
+		NoWhereExpression:           "aucune expression --where indiquée",
+		InvalidExpression:           "expression --where invalide",
+		UnknownName:                 "nom inconnu dans l'expression --where",
+		MismatchedTypes:             "nombres, codes et conditions confondus dans l'expression --where",
+		WrongHolidaysArgs:           "mauvais nombre d'arguments pour les jours fériés",
+		InvalidHoliday:              "date de jour férié invalide détectée",
+		UnreadableFile:              "le fichier ne peut pas être lu",
+		WrongOffsetArgs:             "mauvais nombre d'arguments pour le décalage",
+		InvalidOffset:               "le décalage ne peut pas être négatif",
+		WrongEveryArgs:              "mauvais nombre d'arguments pour --every",
+		InvalidEvery:                "--every doit être un nombre positif",
+		WrongFirstArgs:              "mauvais nombre d'arguments pour --first",
+		InvalidFirst:                "--first doit être un nombre positif",
+		WrongLastArgs:               "mauvais nombre d'arguments pour --last",
+		InvalidLast:                 "--last doit être un nombre positif",
+		WrongSampleArgs:             "mauvais nombre d'arguments pour l'échantillon",
+		InvalidSample:               "l'échantillon doit être un nombre positif",
+		WrongSeedArgs:               "mauvais nombre d'arguments pour la graine",
+		InvalidSeed:                 "la graine doit être un nombre",
+		SeedWithoutSample:           "la graine ne peut pas être utilisée sans échantillon",
+		InvalidRange:                "période invalide détectée",
+		NoRanges:                    "aucune période indiquée pour l'opération d'ensemble",
+		DatesWithRanges:             "les dates ne peuvent pas être combinées avec des périodes",
+		StdinArgs:                   "le drapeau --stdin n'a pas d'arguments",
+		InvalidInput:                "date ou période invalide dans l'entrée",
+		StdinWithDates:              "--stdin ne peut pas être combiné avec des dates, des périodes ou un nombre",
+		WrongInArgs:                 "mauvais nombre d'arguments pour --in",
+		NoInputFormat:               "reformat a besoin du format des dates avec --in",
+		InputFormatWithoutReformat:  "--in ne peut être utilisé qu'avec reformat",
+		ReformatWithDates:           "reformat ne peut pas être combiné avec des dates, des périodes, un nombre ou --stdin",
+		UnsupportedInputPlaceholder: "espace réservé impossible à lire dans le texte",
+		IncompleteInputFormat:       "le format de --in a besoin d'une année, d'un mois et d'un jour",
+		InfoWithDates:               "info prend une seule date",
+		JSONArgs:                    "le drapeau --json n'a pas d'arguments",
+		JSONWithoutInfo:             "--json ne peut être utilisé qu'avec info",
+		InfoWithCount:               "info ne peut pas être combiné avec un nombre",
+		InfoWithFilters:             "info ne peut pas être combiné avec des filtres, des sélections, -r ou --step",
+		GridArgs:                    "le drapeau --grid n'a pas d'arguments",
+		GridWithCommand:             "--grid ne peut être utilisé que pour afficher des dates",
+		GridWithFormat:              "--grid ne peut pas être combiné avec -f, --calendar ou -r",
+		InfoDate:                    "Date",
+		InfoWeekday:                 "Jour",
+		InfoISOWeek:                 "Semaine ISO",
+		InfoDayOfYear:               "Jour de l'année",
+		InfoQuarter:                 "Trimestre",
+		InfoFromToday:               "Depuis aujourd'hui",
+		InfoLeapYear:                "Année bissextile",
+		InfoHolidays:                "Jours fériés",
+		InfoToday:                   "aujourd'hui",
+		InfoTomorrow:                "demain",
+		InfoYesterday:               "hier",
+		InfoInDays:                  "dans %d jours",
+		InfoDaysAgo:                 "il y a %d jours",
+		InfoYes:                     "oui",
+		InfoNo:                      "non",
+		UnknownFlag:                 "drapeau inconnu trouvé",
+		DuplicateFlag:               "drapeau en double trouvé",
+		WrongNumberOfDates:          "mauvais nombre de dates indiqué",
+		DatesNotNextToEachOther:     "les deux dates ne se suivent pas",
+		DatesBetweenOptions:         "des dates se trouvent entre les options",
+		DoubleWeekday:               "jours de la semaine en double détectés pour -i",
+		CountWithEndDate:            "le nombre de dates ne peut pas être combiné avec une date de fin",
+		CountWithoutWeekdays:        "le nombre de dates ne peut pas être atteint si tous les jours sont ignorés",
+		CountWithoutMatches:         "le nombre de dates ne peut pas être atteint si aucune date ne correspond aux filtres",
+		UnsupportedStrftime:         "directive strftime non prise en charge trouvée",
+		UnsupportedGoLayout:         "élément de layout go non pris en charge trouvé",
+		UnterminatedPlaceholder:     "espace réservé non terminé trouvé",
+		UnknownPlaceholder:          "espace réservé inconnu trouvé",
+		InvalidPadModifier:          "modificateur pad invalide trouvé",
+		InvalidLenModifier:          "modificateur len invalide trouvé",
+		UnknownModifier:             "modificateur d'espace réservé inconnu trouvé",
+	},
+	"es": {
+		InvalidOption:               "opción no válida sin indicador",
+		NoIgnoredWeekdays:           "no se indicaron días de la semana para ignorar",
+		InvalidWeekday:              "error al leer un día de la semana",
+		WrongFormatArgs:             "argumentos incorrectos para el formato",
+		WrongFormatStyleArgs:        "número incorrecto de argumentos para el estilo de formato",
+		UnknownFormatStyle:          "estilo de formato desconocido",
+		ReverseArgs:                 "el indicador -r no tiene argumentos",
+		WrongLanguageArgs:           "número incorrecto de argumentos para el idioma",
+		UnknownLanguage:             "idioma desconocido",
+		WrongLocaleFileArgs:         "número incorrecto de argumentos para el archivo de configuración regional",
+		WrongCountArgs:              "número incorrecto de argumentos para la cantidad",
+		InvalidCount:                "la cantidad tiene que ser un número positivo",
+		WrongTimezoneArgs:           "número incorrecto de argumentos para la zona horaria",
+		UnknownTimezone:             "zona horaria desconocida",
+		WrongStepArgs:               "número incorrecto de argumentos para el paso",
+		InvalidStep:                 "paso no válido",
+		WrongCalendarArgs:           "número incorrecto de argumentos para el calendario",
+		UnknownCalendar:             "calendario desconocido",
+		WrongDigitsArgs:             "número incorrecto de argumentos para los dígitos",
+		UnknownDigits:               "dígitos desconocidos",
+		WrongWeekStartArgs:          "número incorrecto de argumentos para el inicio de la semana",
+		UnknownWeekStart:            "inicio de la semana desconocido",
+		WrongWeekendArgs:            "número incorrecto de argumentos para el fin de semana",
+		UnknownWeekend:              "fin de semana desconocido",
+		WorkdaysOnlyArgs:            "el indicador --workdays-only no tiene argumentos",
+		NoFilterValues:              "no se indicaron valores para el filtro",
+		InvalidDay:                  "día del mes no válido",
+		InvalidMonth:                "mes no válido",
+		InvalidWeek:                 "semana no válida",
+		InvalidDayOfYear:            "día del año no válido",
+		NoWhereExpression:           "no se indicó ninguna expresión --where",
+		InvalidExpression:           "expresión --where no válida",
+		UnknownName:                 "nombre desconocido en la expresión --where",
+		MismatchedTypes:             "números, códigos y condiciones mezclados en la expresión --where",
+		WrongHolidaysArgs:           "número incorrecto de argumentos para los festivos",
+		InvalidHoliday:              "fecha de festivo no válida",
+		UnreadableFile:              "no se puede leer el archivo",
+		WrongOffsetArgs:             "número incorrecto de argumentos para el desplazamiento",
+		InvalidOffset:               "el desplazamiento no puede ser negativo",
+		WrongEveryArgs:              "número incorrecto de argumentos para --every",
+		InvalidEvery:                "--every debe ser un número positivo",
+		WrongFirstArgs:              "número incorrecto de argumentos para --first",
+		InvalidFirst:                "--first debe ser un número positivo",
+		WrongLastArgs:               "número incorrecto de argumentos para --last",
+		InvalidLast:                 "--last debe ser un número positivo",
+		WrongSampleArgs:             "número incorrecto de argumentos para la muestra",
+		InvalidSample:               "la muestra debe ser un número positivo",
+		WrongSeedArgs:               "número incorrecto de argumentos para la semilla",
+		InvalidSeed:                 "la semilla debe ser un número",
+		SeedWithoutSample:           "la semilla no se puede usar sin muestra",
+		InvalidRange:                "rango no válido",
+		NoRanges:                    "no se indicaron rangos para la operación de conjuntos",
+		DatesWithRanges:             "las fechas no se pueden combinar con rangos",
+		StdinArgs:                   "la opción --stdin no tiene argumentos",
+		InvalidInput:                "fecha o rango no válido en la entrada",
+		StdinWithDates:              "--stdin no se puede combinar con fechas, rangos o una cantidad",
+		WrongInArgs:                 "número incorrecto de argumentos para --in",
+		NoInputFormat:               "reformat necesita el formato de las fechas con --in",
+		InputFormatWithoutReformat:  "--in solo se puede usar con reformat",
+		ReformatWithDates:           "reformat no se puede combinar con fechas, rangos, una cantidad o --stdin",
+		UnsupportedInputPlaceholder: "el marcador no se puede leer del texto",
+		IncompleteInputFormat:       "el formato de --in necesita un año, un mes y un día",
+		InfoWithDates:               "info admite una sola fecha",
+		JSONArgs:                    "la opción --json no tiene argumentos",
+		JSONWithoutInfo:             "--json solo se puede usar con info",
+		InfoWithCount:               "info no se puede combinar con una cantidad",
+		InfoWithFilters:             "info no se puede combinar con filtros, selecciones, -r o --step",
+		GridArgs:                    "la opción --grid no tiene argumentos",
+		GridWithCommand:             "--grid solo se puede usar para mostrar fechas",
+		GridWithFormat:              "--grid no se puede combinar con -f, --calendar o -r",
+		InfoDate:                    "Fecha",
+		InfoWeekday:                 "Día de la semana",
+		InfoISOWeek:                 "Semana ISO",
+		InfoDayOfYear:               "Día del año",
+		InfoQuarter:                 "Trimestre",
+		InfoFromToday:               "Desde hoy",
+		InfoLeapYear:                "Año bisiesto",
+		InfoHolidays:                "Festivos",
+		InfoToday:                   "hoy",
+		InfoTomorrow:                "mañana",
+		InfoYesterday:               "ayer",
+		InfoInDays:                  "dentro de %d días",
+		InfoDaysAgo:                 "hace %d días",
+		InfoYes:                     "sí",
+		InfoNo:                      "no",
+		UnknownFlag:                 "indicador desconocido",
+		DuplicateFlag:               "indicador duplicado",
+		WrongNumberOfDates:          "número incorrecto de fechas",
+		DatesNotNextToEachOther:     "las dos fechas no están una al lado de la otra",
+		DatesBetweenOptions:         "hay fechas entre las opciones",
+		DoubleWeekday:               "días de la semana duplicados para -i",
+		CountWithEndDate:            "la cantidad no se puede combinar con una fecha final",
+		CountWithoutWeekdays:        "la cantidad no se puede alcanzar si se ignoran todos los días",
+		CountWithoutMatches:         "la cantidad no se puede alcanzar si ninguna fecha cumple los filtros",
+		UnsupportedStrftime:         "directiva strftime no compatible",
+		UnsupportedGoLayout:         "elemento de layout go no compatible",
+		UnterminatedPlaceholder:     "marcador sin cerrar",
+		UnknownPlaceholder:          "marcador desconocido",
+		InvalidPadModifier:          "modificador pad no válido",
+		InvalidLenModifier:          "modificador len no válido",
+		UnknownModifier:             "modificador de marcador desconocido",
+	},
+	"it": {
+		InvalidOption:               "opzione non valida senza flag",
+		NoIgnoredWeekdays:           "nessun giorno della settimana da ignorare indicato",
+		InvalidWeekday:              "errore durante la lettura di un giorno della settimana",
+		WrongFormatArgs:             "argomenti errati per il formato",
+		WrongFormatStyleArgs:        "numero errato di argomenti per lo stile del formato",
+		UnknownFormatStyle:          "stile del formato sconosciuto",
+		ReverseArgs:                 "il flag -r non ha argomenti",
+		WrongLanguageArgs:           "numero errato di argomenti per la lingua",
+		UnknownLanguage:             "lingua sconosciuta",
+		WrongLocaleFileArgs:         "numero errato di argomenti per il file di locale",
+		WrongCountArgs:              "numero errato di argomenti per il conteggio",
+		InvalidCount:                "il conteggio deve essere un numero positivo",
+		WrongTimezoneArgs:           "numero errato di argomenti per il fuso orario",
+		UnknownTimezone:             "fuso orario sconosciuto",
+		WrongStepArgs:               "numero errato di argomenti per il passo",
+		InvalidStep:                 "passo non valido",
+		WrongCalendarArgs:           "numero errato di argomenti per il calendario",
+		UnknownCalendar:             "calendario sconosciuto",
+		WrongDigitsArgs:             "numero errato di argomenti per le cifre",
+		UnknownDigits:               "cifre sconosciute",
+		WrongWeekStartArgs:          "numero errato di argomenti per l'inizio della settimana",
+		UnknownWeekStart:            "inizio della settimana sconosciuto",
+		WrongWeekendArgs:            "numero errato di argomenti per il fine settimana",
+		UnknownWeekend:              "fine settimana sconosciuto",
+		WorkdaysOnlyArgs:            "il flag --workdays-only non ha argomenti",
+		NoFilterValues:              "nessun valore indicato per il filtro",
+		InvalidDay:                  "giorno del mese non valido",
+		InvalidMonth:                "mese non valido",
+		InvalidWeek:                 "settimana non valida",
+		InvalidDayOfYear:            "giorno dell'anno non valido",
+		NoWhereExpression:           "nessuna espressione --where indicata",
+		InvalidExpression:           "espressione --where non valida",
+		UnknownName:                 "nome sconosciuto nell'espressione --where",
+		MismatchedTypes:             "numeri, codici e condizioni confusi nell'espressione --where",
+		WrongHolidaysArgs:           "numero errato di argomenti per i giorni festivi",
+		InvalidHoliday:              "data di un giorno festivo non valida",
+		UnreadableFile:              "il file non può essere letto",
+		WrongOffsetArgs:             "numero errato di argomenti per lo scostamento",
+		InvalidOffset:               "lo scostamento non può essere negativo",
+		WrongEveryArgs:              "numero errato di argomenti per --every",
+		InvalidEvery:                "--every deve essere un numero positivo",
+		WrongFirstArgs:              "numero errato di argomenti per --first",
+		InvalidFirst:                "--first deve essere un numero positivo",
+		WrongLastArgs:               "numero errato di argomenti per --last",
+		InvalidLast:                 "--last deve essere un numero positivo",
+		WrongSampleArgs:             "numero errato di argomenti per il campione",
+		InvalidSample:               "il campione deve essere un numero positivo",
+		WrongSeedArgs:               "numero errato di argomenti per il seme",
+		InvalidSeed:                 "il seme deve essere un numero",
+		SeedWithoutSample:           "il seme non può essere usato senza campione",
+		InvalidRange:                "intervallo non valido",
+		NoRanges:                    "nessun intervallo indicato per l'operazione sugli insiemi",
+		DatesWithRanges:             "le date non possono essere combinate con intervalli",
+		StdinArgs:                   "l'opzione --stdin non ha argomenti",
+		InvalidInput:                "data o intervallo non valido nell'input",
+		StdinWithDates:              "--stdin non può essere combinato con date, intervalli o un conteggio",
+		WrongInArgs:                 "numero errato di argomenti per --in",
+		NoInputFormat:               "reformat richiede il formato delle date con --in",
+		InputFormatWithoutReformat:  "--in può essere usato solo con reformat",
+		ReformatWithDates:           "reformat non può essere combinato con date, intervalli, un numero o --stdin",
+		UnsupportedInputPlaceholder: "il segnaposto non può essere letto dal testo",
+		IncompleteInputFormat:       "il formato di --in richiede un anno, un mese e un giorno",
+		InfoWithDates:               "info accetta una sola data",
+		JSONArgs:                    "l'opzione --json non ha argomenti",
+		JSONWithoutInfo:             "--json può essere usato solo con info",
+		InfoWithCount:               "info non può essere combinato con un numero",
+		InfoWithFilters:             "info non può essere combinato con filtri, selezioni, -r o --step",
+		GridArgs:                    "l'opzione --grid non ha argomenti",
+		GridWithCommand:             "--grid può essere usato solo per stampare date",
+		GridWithFormat:              "--grid non può essere combinato con -f, --calendar o -r",
+		InfoDate:                    "Data",
+		InfoWeekday:                 "Giorno della settimana",
+		InfoISOWeek:                 "Settimana ISO",
+		InfoDayOfYear:               "Giorno dell'anno",
+		InfoQuarter:                 "Trimestre",
+		InfoFromToday:               "Da oggi",
+		InfoLeapYear:                "Anno bisestile",
+		InfoHolidays:                "Festività",
+		InfoToday:                   "oggi",
+		InfoTomorrow:                "domani",
+		InfoYesterday:               "ieri",
+		InfoInDays:                  "tra %d giorni",
+		InfoDaysAgo:                 "%d giorni fa",
+		InfoYes:                     "sì",
+		InfoNo:                      "no",
+		UnknownFlag:                 "flag sconosciuto",
+		DuplicateFlag:               "flag duplicato",
+		WrongNumberOfDates:          "numero errato di date",
+		DatesNotNextToEachOther:     "le due date non sono una accanto all'altra",
+		DatesBetweenOptions:         "ci sono date tra le opzioni",
+		DoubleWeekday:               "giorni della settimana duplicati per -i",
+		CountWithEndDate:            "il conteggio non può essere combinato con una data di fine",
+		CountWithoutWeekdays:        "il conteggio non può essere raggiunto se tutti i giorni sono ignorati",
+		CountWithoutMatches:         "il conteggio non può essere raggiunto se nessuna data soddisfa i filtri",
+		UnsupportedStrftime:         "direttiva strftime non supportata",
+		UnsupportedGoLayout:         "elemento del layout go non supportato",
+		UnterminatedPlaceholder:     "segnaposto non terminato",
+		UnknownPlaceholder:          "segnaposto sconosciuto",
+		InvalidPadModifier:          "modificatore pad non valido",
+		InvalidLenModifier:          "modificatore len non valido",
+		UnknownModifier:             "modificatore di segnaposto sconosciuto",
+	},
+	"pt": {
+		InvalidOption:               "opção inválida sem flag",
+		NoIgnoredWeekdays:           "nenhum dia da semana para ignorar informado",
+		InvalidWeekday:              "erro ao ler um dia da semana",
+		WrongFormatArgs:             "argumentos errados para o formato",
+		WrongFormatStyleArgs:        "número errado de argumentos para o estilo de formato",
+		UnknownFormatStyle:          "estilo de formato desconhecido",
+		ReverseArgs:                 "a flag -r não tem argumentos",
+		WrongLanguageArgs:           "número errado de argumentos para o idioma",
+		UnknownLanguage:             "idioma desconhecido",
+		WrongLocaleFileArgs:         "número errado de argumentos para o arquivo de localidade",
+		WrongCountArgs:              "número errado de argumentos para a quantidade",
+		InvalidCount:                "a quantidade tem que ser um número positivo",
+		WrongTimezoneArgs:           "número errado de argumentos para o fuso horário",
+		UnknownTimezone:             "fuso horário desconhecido",
+		WrongStepArgs:               "número errado de argumentos para o passo",
+		InvalidStep:                 "passo inválido",
+		WrongCalendarArgs:           "número errado de argumentos para o calendário",
+		UnknownCalendar:             "calendário desconhecido",
+		WrongDigitsArgs:             "número errado de argumentos para os dígitos",
+		UnknownDigits:               "dígitos desconhecidos",
+		WrongWeekStartArgs:          "número errado de argumentos para o início da semana",
+		UnknownWeekStart:            "início da semana desconhecido",
+		WrongWeekendArgs:            "número errado de argumentos para o fim de semana",
+		UnknownWeekend:              "fim de semana desconhecido",
+		WorkdaysOnlyArgs:            "a flag --workdays-only não tem argumentos",
+		NoFilterValues:              "nenhum valor informado para o filtro",
+		InvalidDay:                  "dia do mês inválido",
+		InvalidMonth:                "mês inválido",
+		InvalidWeek:                 "semana inválida",
+		InvalidDayOfYear:            "dia do ano inválido",
+		NoWhereExpression:           "nenhuma expressão --where indicada",
+		InvalidExpression:           "expressão --where inválida",
+		UnknownName:                 "nome desconhecido na expressão --where",
+		MismatchedTypes:             "números, códigos e condições misturados na expressão --where",
+		WrongHolidaysArgs:           "número errado de argumentos para os feriados",
+		InvalidHoliday:              "data de feriado inválida",
+		UnreadableFile:              "o arquivo não pode ser lido",
+		WrongOffsetArgs:             "número errado de argumentos para o deslocamento",
+		InvalidOffset:               "o deslocamento não pode ser negativo",
+		WrongEveryArgs:              "número errado de argumentos para --every",
+		InvalidEvery:                "--every tem de ser um número positivo",
+		WrongFirstArgs:              "número errado de argumentos para --first",
+		InvalidFirst:                "--first tem de ser um número positivo",
+		WrongLastArgs:               "número errado de argumentos para --last",
+		InvalidLast:                 "--last tem de ser um número positivo",
+		WrongSampleArgs:             "número errado de argumentos para a amostra",
+		InvalidSample:               "a amostra tem de ser um número positivo",
+		WrongSeedArgs:               "número errado de argumentos para a semente",
+		InvalidSeed:                 "a semente tem de ser um número",
+		SeedWithoutSample:           "a semente não pode ser usada sem amostra",
+		InvalidRange:                "intervalo inválido",
+		NoRanges:                    "nenhum intervalo indicado para a operação de conjuntos",
+		DatesWithRanges:             "as datas não podem ser combinadas com intervalos",
+		StdinArgs:                   "a opção --stdin não tem argumentos",
+		InvalidInput:                "data ou intervalo inválido na entrada",
+		StdinWithDates:              "--stdin não pode ser combinado com datas, intervalos ou uma quantidade",
+		WrongInArgs:                 "número incorreto de argumentos para --in",
+		NoInputFormat:               "reformat precisa do formato das datas com --in",
+		InputFormatWithoutReformat:  "--in só pode ser usado com reformat",
+		ReformatWithDates:           "reformat não pode ser combinado com datas, intervalos, uma quantidade ou --stdin",
+		UnsupportedInputPlaceholder: "o marcador não pode ser lido do texto",
+		IncompleteInputFormat:       "o formato de --in precisa de um ano, um mês e um dia",
+		InfoWithDates:               "info aceita uma única data",
+		JSONArgs:                    "a opção --json não tem argumentos",
+		JSONWithoutInfo:             "--json só pode ser usado com info",
+		InfoWithCount:               "info não pode ser combinado com uma quantidade",
+		InfoWithFilters:             "info não pode ser combinado com filtros, seleções, -r ou --step",
+		GridArgs:                    "a opção --grid não tem argumentos",
+		GridWithCommand:             "--grid só pode ser usado para mostrar datas",
+		GridWithFormat:              "--grid não pode ser combinado com -f, --calendar ou -r",
+		InfoDate:                    "Data",
+		InfoWeekday:                 "Dia da semana",
+		InfoISOWeek:                 "Semana ISO",
+		InfoDayOfYear:               "Dia do ano",
+		InfoQuarter:                 "Trimestre",
+		InfoFromToday:               "A partir de hoje",
+		InfoLeapYear:                "Ano bissexto",
+		InfoHolidays:                "Feriados",
+		InfoToday:                   "hoje",
+		InfoTomorrow:                "amanhã",
+		InfoYesterday:               "ontem",
+		InfoInDays:                  "em %d dias",
+		InfoDaysAgo:                 "há %d dias",
+		InfoYes:                     "sim",
+		InfoNo:                      "não",
+		UnknownFlag:                 "flag desconhecida",
+		DuplicateFlag:               "flag duplicada",
+		WrongNumberOfDates:          "número errado de datas",
+		DatesNotNextToEachOther:     "as duas datas não estão lado a lado",
+		DatesBetweenOptions:         "há datas entre as opções",
+		DoubleWeekday:               "dias da semana duplicados para -i",
+		CountWithEndDate:            "a quantidade não pode ser combinada com uma data final",
+		CountWithoutWeekdays:        "a quantidade não pode ser alcançada se todos os dias forem ignorados",
+		CountWithoutMatches:         "a quantidade não pode ser alcançada se nenhuma data corresponder aos filtros",
+		UnsupportedStrftime:         "diretiva strftime não suportada",
+		UnsupportedGoLayout:         "elemento de layout go não suportado",
+		UnterminatedPlaceholder:     "marcador não terminado",
+		UnknownPlaceholder:          "marcador desconhecido",
+		InvalidPadModifier:          "modificador pad inválido",
+		InvalidLenModifier:          "modificador len inválido",
+		UnknownModifier:             "modificador de marcador desconhecido",
+	},
+	"nl": {
+		InvalidOption:               "ongeldige optie zonder vlag opgegeven",
+		NoIgnoredWeekdays:           "geen weekdagen om over te slaan opgegeven",
+		InvalidWeekday:              "fout bij het lezen van een weekdag",
+		WrongFormatArgs:             "verkeerde argumenten voor het formaat",
+		WrongFormatStyleArgs:        "verkeerd aantal argumenten voor de formaatstijl",
+		UnknownFormatStyle:          "onbekende formaatstijl",
+		ReverseArgs:                 "de vlag -r heeft geen argumenten",
+		WrongLanguageArgs:           "verkeerd aantal argumenten voor de taal",
+		UnknownLanguage:             "onbekende taal",
+		WrongLocaleFileArgs:         "verkeerd aantal argumenten voor het localebestand",
+		WrongCountArgs:              "verkeerd aantal argumenten voor het aantal",
+		InvalidCount:                "het aantal moet een positief getal zijn",
+		WrongTimezoneArgs:           "verkeerd aantal argumenten voor de tijdzone",
+		UnknownTimezone:             "onbekende tijdzone",
+		WrongStepArgs:               "verkeerd aantal argumenten voor de stap",
+		InvalidStep:                 "ongeldige stap",
+		WrongCalendarArgs:           "verkeerd aantal argumenten voor de kalender",
+		UnknownCalendar:             "onbekende kalender",
+		WrongDigitsArgs:             "verkeerd aantal argumenten voor de cijfers",
+		UnknownDigits:               "onbekende cijfers",
+		WrongWeekStartArgs:          "verkeerd aantal argumenten voor het begin van de week",
+		UnknownWeekStart:            "onbekend begin van de week",
+		WrongWeekendArgs:            "verkeerd aantal argumenten voor het weekend",
+		UnknownWeekend:              "onbekend weekend",
+		WorkdaysOnlyArgs:            "de vlag --workdays-only heeft geen argumenten",
+		NoFilterValues:              "geen waarden voor het filter opgegeven",
+		InvalidDay:                  "ongeldige dag van de maand",
+		InvalidMonth:                "ongeldige maand",
+		InvalidWeek:                 "ongeldige week",
+		InvalidDayOfYear:            "ongeldige dag van het jaar",
+		NoWhereExpression:           "geen --where-expressie opgegeven",
+		InvalidExpression:           "ongeldige --where-expressie",
+		UnknownName:                 "onbekende naam in de --where-expressie",
+		MismatchedTypes:             "getallen, codes en voorwaarden door elkaar in de --where-expressie",
+		WrongHolidaysArgs:           "verkeerd aantal argumenten voor de feestdagen",
+		InvalidHoliday:              "ongeldige datum van een feestdag",
+		UnreadableFile:              "het bestand kan niet worden gelezen",
+		WrongOffsetArgs:             "verkeerd aantal argumenten voor de verschuiving",
+		InvalidOffset:               "de verschuiving mag niet negatief zijn",
+		WrongEveryArgs:              "verkeerd aantal argumenten voor --every",
+		InvalidEvery:                "--every moet een positief getal zijn",
+		WrongFirstArgs:              "verkeerd aantal argumenten voor --first",
+		InvalidFirst:                "--first moet een positief getal zijn",
+		WrongLastArgs:               "verkeerd aantal argumenten voor --last",
+		InvalidLast:                 "--last moet een positief getal zijn",
+		WrongSampleArgs:             "verkeerd aantal argumenten voor de steekproef",
+		InvalidSample:               "de steekproef moet een positief getal zijn",
+		WrongSeedArgs:               "verkeerd aantal argumenten voor de seed",
+		InvalidSeed:                 "de seed moet een getal zijn",
+		SeedWithoutSample:           "de seed kan niet zonder steekproef worden gebruikt",
+		InvalidRange:                "ongeldig bereik",
+		NoRanges:                    "geen bereiken opgegeven voor de verzamelingsbewerking",
+		DatesWithRanges:             "datums kunnen niet met bereiken worden gecombineerd",
+		StdinArgs:                   "de vlag --stdin heeft geen argumenten",
+		InvalidInput:                "ongeldige datum of ongeldig bereik in de invoer",
+		StdinWithDates:              "--stdin kan niet worden gecombineerd met datums, bereiken of een aantal",
+		WrongInArgs:                 "verkeerd aantal argumenten voor --in opgegeven",
+		NoInputFormat:               "reformat heeft het formaat van de datums met --in nodig",
+		InputFormatWithoutReformat:  "--in kan alleen met reformat worden gebruikt",
+		ReformatWithDates:           "reformat kan niet worden gecombineerd met datums, bereiken, een aantal of --stdin",
+		UnsupportedInputPlaceholder: "tijdelijke aanduiding kan niet uit de tekst worden gelezen",
+		IncompleteInputFormat:       "het formaat van --in heeft een jaar, een maand en een dag nodig",
+		InfoWithDates:               "info neemt één datum",
+		JSONArgs:                    "de vlag --json heeft geen argumenten",
+		JSONWithoutInfo:             "--json kan alleen met info worden gebruikt",
+		InfoWithCount:               "info kan niet worden gecombineerd met een aantal",
+		InfoWithFilters:             "info kan niet worden gecombineerd met filters, selecties, -r of --step",
+		GridArgs:                    "de vlag --grid heeft geen argumenten",
+		GridWithCommand:             "--grid kan alleen worden gebruikt om datums af te drukken",
+		GridWithFormat:              "--grid kan niet worden gecombineerd met -f, --calendar of -r",
+		InfoDate:                    "Datum",
+		InfoWeekday:                 "Weekdag",
+		InfoISOWeek:                 "ISO-week",
+		InfoDayOfYear:               "Dag van het jaar",
+		InfoQuarter:                 "Kwartaal",
+		InfoFromToday:               "Vanaf vandaag",
+		InfoLeapYear:                "Schrikkeljaar",
+		InfoHolidays:                "Feestdagen",
+		InfoToday:                   "vandaag",
+		InfoTomorrow:                "morgen",
+		InfoYesterday:               "gisteren",
+		InfoInDays:                  "over %d dagen",
+		InfoDaysAgo:                 "%d dagen geleden",
+		InfoYes:                     "ja",
+		InfoNo:                      "nee",
+		UnknownFlag:                 "onbekende vlag gevonden",
+		DuplicateFlag:               "dubbele vlag gevonden",
+		WrongNumberOfDates:          "verkeerd aantal datums opgegeven",
+		DatesNotNextToEachOther:     "de twee datums staan niet naast elkaar",
+		DatesBetweenOptions:         "er staan datums tussen de opties",
+		DoubleWeekday:               "dubbele weekdagen voor -i",
+		CountWithEndDate:            "het aantal kan niet met een einddatum worden gecombineerd",
+		CountWithoutWeekdays:        "het aantal kan niet worden bereikt als alle weekdagen worden overgeslagen",
+		CountWithoutMatches:         "het aantal kan niet worden bereikt als geen datum aan de filters voldoet",
+		UnsupportedStrftime:         "niet-ondersteunde strftime-instructie gevonden",
+		UnsupportedGoLayout:         "niet-ondersteund go-layoutelement gevonden",
+		UnterminatedPlaceholder:     "niet-afgesloten placeholder gevonden",
+		UnknownPlaceholder:          "onbekende placeholder gevonden",
+		InvalidPadModifier:          "ongeldige pad-modifier gevonden",
+		InvalidLenModifier:          "ongeldige len-modifier gevonden",
+		UnknownModifier:             "onbekende placeholder-modifier gevonden",
+	},
+	"ru": {
+		InvalidOption:               "указан недопустимый параметр без флага",
+		NoIgnoredWeekdays:           "не указаны дни недели для пропуска",
+		InvalidWeekday:              "ошибка при чтении дня недели",
+		WrongFormatArgs:             "неверные аргументы формата",
+		WrongFormatStyleArgs:        "неверное число аргументов стиля формата",
+		UnknownFormatStyle:          "неизвестный стиль формата",
+		ReverseArgs:                 "флаг -r не принимает аргументов",
+		WrongLanguageArgs:           "неверное число аргументов языка",
+		UnknownLanguage:             "неизвестный язык",
+		WrongLocaleFileArgs:         "неверное число аргументов файла локали",
+		WrongCountArgs:              "неверное число аргументов количества",
+		InvalidCount:                "количество должно быть положительным числом",
+		WrongTimezoneArgs:           "неверное число аргументов часового пояса",
+		UnknownTimezone:             "неизвестный часовой пояс",
+		WrongStepArgs:               "неверное число аргументов шага",
+		InvalidStep:                 "недопустимый шаг",
+		WrongCalendarArgs:           "неверное число аргументов календаря",
+		UnknownCalendar:             "неизвестный календарь",
+		WrongDigitsArgs:             "неверное число аргументов цифр",
+		UnknownDigits:               "неизвестные цифры",
+		WrongWeekStartArgs:          "неверное число аргументов начала недели",
+		UnknownWeekStart:            "неизвестное начало недели",
+		WrongWeekendArgs:            "неверное число аргументов выходных",
+		UnknownWeekend:              "неизвестные выходные",
+		WorkdaysOnlyArgs:            "флаг --workdays-only не принимает аргументов",
+		NoFilterValues:              "не указаны значения фильтра",
+		InvalidDay:                  "недопустимый день месяца",
+		InvalidMonth:                "недопустимый месяц",
+		InvalidWeek:                 "недопустимая неделя",
+		InvalidDayOfYear:            "недопустимый день года",
+		NoWhereExpression:           "не указано выражение --where",
+		InvalidExpression:           "недопустимое выражение --where",
+		UnknownName:                 "неизвестное имя в выражении --where",
+		MismatchedTypes:             "числа, коды и условия перепутаны в выражении --where",
+		WrongHolidaysArgs:           "неверное количество аргументов для праздников",
+		InvalidHoliday:              "недопустимая дата праздника",
+		UnreadableFile:              "не удаётся прочитать файл",
+		WrongOffsetArgs:             "неверное количество аргументов для смещения",
+		InvalidOffset:               "смещение не может быть отрицательным",
+		WrongEveryArgs:              "неверное количество аргументов для --every",
+		InvalidEvery:                "--every должно быть положительным числом",
+		WrongFirstArgs:              "неверное количество аргументов для --first",
+		InvalidFirst:                "--first должно быть положительным числом",
+		WrongLastArgs:               "неверное количество аргументов для --last",
+		InvalidLast:                 "--last должно быть положительным числом",
+		WrongSampleArgs:             "неверное количество аргументов для выборки",
+		InvalidSample:               "выборка должна быть положительным числом",
+		WrongSeedArgs:               "неверное количество аргументов для начального значения",
+		InvalidSeed:                 "начальное значение должно быть числом",
+		SeedWithoutSample:           "начальное значение нельзя использовать без выборки",
+		InvalidRange:                "недопустимый диапазон",
+		NoRanges:                    "не указаны диапазоны для операции над множествами",
+		DatesWithRanges:             "даты нельзя сочетать с диапазонами",
+		StdinArgs:                   "флаг --stdin не принимает аргументов",
+		InvalidInput:                "недопустимая дата или диапазон во входных данных",
+		StdinWithDates:              "--stdin нельзя сочетать с датами, диапазонами или количеством",
+		WrongInArgs:                 "неверное количество аргументов для --in",
+		NoInputFormat:               "reformat требует формат дат в --in",
+		InputFormatWithoutReformat:  "--in можно использовать только с reformat",
+		ReformatWithDates:           "reformat нельзя сочетать с датами, диапазонами, количеством или --stdin",
+		UnsupportedInputPlaceholder: "заполнитель нельзя прочитать из текста",
+		IncompleteInputFormat:       "формат --in должен содержать год, месяц и день",
+		InfoWithDates:               "info принимает одну дату",
+		JSONArgs:                    "у флага --json нет аргументов",
+		JSONWithoutInfo:             "--json можно использовать только с info",
+		InfoWithCount:               "info нельзя сочетать с количеством",
+		InfoWithFilters:             "info нельзя сочетать с фильтрами, выборками, -r или --step",
+		GridArgs:                    "у флага --grid нет аргументов",
+		GridWithCommand:             "--grid можно использовать только для вывода дат",
+		GridWithFormat:              "--grid нельзя сочетать с -f, --calendar или -r",
+		InfoDate:                    "Дата",
+		InfoWeekday:                 "День недели",
+		InfoISOWeek:                 "Неделя ISO",
+		InfoDayOfYear:               "День года",
+		InfoQuarter:                 "Квартал",
+		InfoFromToday:               "От сегодня",
+		InfoLeapYear:                "Високосный год",
+		InfoHolidays:                "Праздники",
+		InfoToday:                   "сегодня",
+		InfoTomorrow:                "завтра",
+		InfoYesterday:               "вчера",
+		InfoInDays:                  "через %d дн.",
+		InfoDaysAgo:                 "%d дн. назад",
+		InfoYes:                     "да",
+		InfoNo:                      "нет",
+		UnknownFlag:                 "найден неизвестный флаг",
+		DuplicateFlag:               "найден повторяющийся флаг",
+		WrongNumberOfDates:          "указано неверное число дат",
+		DatesNotNextToEachOther:     "две даты стоят не рядом",
+		DatesBetweenOptions:         "даты стоят между параметрами",
+		DoubleWeekday:               "повторяющиеся дни недели для -i",
+		CountWithEndDate:            "количество нельзя сочетать с конечной датой",
+		CountWithoutWeekdays:        "количество недостижимо, если пропущены все дни недели",
+		CountWithoutMatches:         "количество недостижимо, если ни одна дата не подходит под фильтры",
+		UnsupportedStrftime:         "найдена неподдерживаемая директива strftime",
+		UnsupportedGoLayout:         "найден неподдерживаемый элемент макета go",
+		UnterminatedPlaceholder:     "найден незакрытый заполнитель",
+		UnknownPlaceholder:          "найден неизвестный заполнитель",
+		InvalidPadModifier:          "найден недопустимый модификатор pad",
+		InvalidLenModifier:          "найден недопустимый модификатор len",
+		UnknownModifier:             "найден неизвестный модификатор заполнителя",
+	},
+	"pl": {
+		InvalidOption:               "podano nieprawidłową opcję bez flagi",
+		NoIgnoredWeekdays:           "nie podano dni tygodnia do pominięcia",
+		InvalidWeekday:              "błąd podczas odczytu dnia tygodnia",
+		WrongFormatArgs:             "nieprawidłowe argumenty formatu",
+		WrongFormatStyleArgs:        "nieprawidłowa liczba argumentów stylu formatu",
+		UnknownFormatStyle:          "nieznany styl formatu",
+		ReverseArgs:                 "flaga -r nie przyjmuje argumentów",
+		WrongLanguageArgs:           "nieprawidłowa liczba argumentów języka",
+		UnknownLanguage:             "nieznany język",
+		WrongLocaleFileArgs:         "nieprawidłowa liczba argumentów pliku ustawień regionalnych",
+		WrongCountArgs:              "nieprawidłowa liczba argumentów liczby dat",
+		InvalidCount:                "liczba dat musi być liczbą dodatnią",
+		WrongTimezoneArgs:           "nieprawidłowa liczba argumentów strefy czasowej",
+		UnknownTimezone:             "nieznana strefa czasowa",
+		WrongStepArgs:               "nieprawidłowa liczba argumentów kroku",
+		InvalidStep:                 "nieprawidłowy krok",
+		WrongCalendarArgs:           "nieprawidłowa liczba argumentów kalendarza",
+		UnknownCalendar:             "nieznany kalendarz",
+		WrongDigitsArgs:             "nieprawidłowa liczba argumentów cyfr",
+		UnknownDigits:               "nieznane cyfry",
+		WrongWeekStartArgs:          "nieprawidłowa liczba argumentów początku tygodnia",
+		UnknownWeekStart:            "nieznany początek tygodnia",
+		WrongWeekendArgs:            "nieprawidłowa liczba argumentów weekendu",
+		UnknownWeekend:              "nieznany weekend",
+		WorkdaysOnlyArgs:            "flaga --workdays-only nie przyjmuje argumentów",
+		NoFilterValues:              "nie podano wartości filtra",
+		InvalidDay:                  "nieprawidłowy dzień miesiąca",
+		InvalidMonth:                "nieprawidłowy miesiąc",
+		InvalidWeek:                 "nieprawidłowy tydzień",
+		InvalidDayOfYear:            "nieprawidłowy dzień roku",
+		NoWhereExpression:           "nie podano wyrażenia --where",
+		InvalidExpression:           "nieprawidłowe wyrażenie --where",
+		UnknownName:                 "nieznana nazwa w wyrażeniu --where",
+		MismatchedTypes:             "pomylone liczby, kody i warunki w wyrażeniu --where",
+		WrongHolidaysArgs:           "nieprawidłowa liczba argumentów dla świąt",
+		InvalidHoliday:              "nieprawidłowa data święta",
+		UnreadableFile:              "nie można odczytać pliku",
+		WrongOffsetArgs:             "nieprawidłowa liczba argumentów dla przesunięcia",
+		InvalidOffset:               "przesunięcie nie może być ujemne",
+		WrongEveryArgs:              "nieprawidłowa liczba argumentów dla --every",
+		InvalidEvery:                "--every musi być liczbą dodatnią",
+		WrongFirstArgs:              "nieprawidłowa liczba argumentów dla --first",
+		InvalidFirst:                "--first musi być liczbą dodatnią",
+		WrongLastArgs:               "nieprawidłowa liczba argumentów dla --last",
+		InvalidLast:                 "--last musi być liczbą dodatnią",
+		WrongSampleArgs:             "nieprawidłowa liczba argumentów dla próbki",
+		InvalidSample:               "próbka musi być liczbą dodatnią",
+		WrongSeedArgs:               "nieprawidłowa liczba argumentów dla ziarna",
+		InvalidSeed:                 "ziarno musi być liczbą",
+		SeedWithoutSample:           "ziarna nie można użyć bez próbki",
+		InvalidRange:                "nieprawidłowy zakres",
+		NoRanges:                    "nie podano zakresów dla operacji na zbiorach",
+		DatesWithRanges:             "dat nie można łączyć z zakresami",
+		StdinArgs:                   "flaga --stdin nie przyjmuje argumentów",
+		InvalidInput:                "nieprawidłowa data lub zakres na wejściu",
+		StdinWithDates:              "--stdin nie można łączyć z datami, zakresami ani liczbą",
+		WrongInArgs:                 "nieprawidłowa liczba argumentów dla --in",
+		NoInputFormat:               "reformat wymaga formatu dat w --in",
+		InputFormatWithoutReformat:  "--in można używać tylko z reformat",
+		ReformatWithDates:           "reformat nie może być łączony z datami, zakresami, liczbą ani --stdin",
+		UnsupportedInputPlaceholder: "symbolu zastępczego nie można odczytać z tekstu",
+		IncompleteInputFormat:       "format --in wymaga roku, miesiąca i dnia",
+		InfoWithDates:               "info przyjmuje jedną datę",
+		JSONArgs:                    "flaga --json nie ma argumentów",
+		JSONWithoutInfo:             "--json można używać tylko z info",
+		InfoWithCount:               "info nie może być łączony z liczbą",
+		InfoWithFilters:             "info nie może być łączony z filtrami, wyborami, -r ani --step",
+		GridArgs:                    "flaga --grid nie ma argumentów",
+		GridWithCommand:             "--grid można używać tylko do wypisywania dat",
+		GridWithFormat:              "--grid nie może być łączony z -f, --calendar ani -r",
+		InfoDate:                    "Data",
+		InfoWeekday:                 "Dzień tygodnia",
+		InfoISOWeek:                 "Tydzień ISO",
+		InfoDayOfYear:               "Dzień roku",
+		InfoQuarter:                 "Kwartał",
+		InfoFromToday:               "Od dziś",
+		InfoLeapYear:                "Rok przestępny",
+		InfoHolidays:                "Święta",
+		InfoToday:                   "dziś",
+		InfoTomorrow:                "jutro",
+		InfoYesterday:               "wczoraj",
+		InfoInDays:                  "za %d dni",
+		InfoDaysAgo:                 "%d dni temu",
+		InfoYes:                     "tak",
+		InfoNo:                      "nie",
+		UnknownFlag:                 "znaleziono nieznaną flagę",
+		DuplicateFlag:               "znaleziono powtórzoną flagę",
+		WrongNumberOfDates:          "podano nieprawidłową liczbę dat",
+		DatesNotNextToEachOther:     "obie daty nie stoją obok siebie",
+		DatesBetweenOptions:         "daty stoją między opcjami",
+		DoubleWeekday:               "powtórzone dni tygodnia dla -i",
+		CountWithEndDate:            "liczby dat nie można łączyć z datą końcową",
+		CountWithoutWeekdays:        "liczby dat nie można osiągnąć, gdy pominięto wszystkie dni tygodnia",
+		CountWithoutMatches:         "liczby dat nie można osiągnąć, gdy żadna data nie pasuje do filtrów",
+		UnsupportedStrftime:         "znaleziono nieobsługiwaną dyrektywę strftime",
+		UnsupportedGoLayout:         "znaleziono nieobsługiwany element układu go",
+		UnterminatedPlaceholder:     "znaleziono niezamknięty symbol zastępczy",
+		UnknownPlaceholder:          "znaleziono nieznany symbol zastępczy",
+		InvalidPadModifier:          "znaleziono nieprawidłowy modyfikator pad",
+		InvalidLenModifier:          "znaleziono nieprawidłowy modyfikator len",
+		UnknownModifier:             "znaleziono nieznany modyfikator symbolu zastępczego",
+	},
+	"zh": {
+		InvalidOption:               "给出了不属于任何标志的无效选项",
+		NoIgnoredWeekdays:           "未提供要忽略的星期",
+		InvalidWeekday:              "解析星期时出错",
+		WrongFormatArgs:             "格式参数错误",
+		WrongFormatStyleArgs:        "格式样式参数数量错误",
+		UnknownFormatStyle:          "未知的格式样式",
+		ReverseArgs:                 "-r 标志不接受参数",
+		WrongLanguageArgs:           "语言参数数量错误",
+		UnknownLanguage:             "未知的语言",
+		WrongLocaleFileArgs:         "区域设置文件参数数量错误",
+		WrongCountArgs:              "数量参数数量错误",
+		InvalidCount:                "数量必须是正数",
+		WrongTimezoneArgs:           "时区参数数量错误",
+		UnknownTimezone:             "未知的时区",
+		WrongStepArgs:               "步长参数数量错误",
+		InvalidStep:                 "无效的步长",
+		WrongCalendarArgs:           "日历参数数量错误",
+		UnknownCalendar:             "未知的日历",
+		WrongDigitsArgs:             "数字参数数量错误",
+		UnknownDigits:               "未知的数字系统",
+		WrongWeekStartArgs:          "一周起始日参数数量错误",
+		UnknownWeekStart:            "未知的一周起始日",
+		WrongWeekendArgs:            "周末参数数量错误",
+		UnknownWeekend:              "未知的周末",
+		WorkdaysOnlyArgs:            "--workdays-only 标志不接受参数",
+		NoFilterValues:              "未提供筛选值",
+		InvalidDay:                  "无效的日期",
+		InvalidMonth:                "无效的月份",
+		InvalidWeek:                 "无效的周",
+		InvalidDayOfYear:            "无效的年内日序",
+		NoWhereExpression:           "未提供 --where 表达式",
+		InvalidExpression:           "无效的 --where 表达式",
+		UnknownName:                 "--where 表达式中有未知名称",
+		MismatchedTypes:             "--where 表达式中混淆了数字、代码和条件",
+		WrongHolidaysArgs:           "节假日的参数数量错误",
+		InvalidHoliday:              "无效的节假日日期",
+		UnreadableFile:              "无法读取文件",
+		WrongOffsetArgs:             "偏移量的参数数量错误",
+		InvalidOffset:               "偏移量不能为负数",
+		WrongEveryArgs:              "--every 的参数数量错误",
+		InvalidEvery:                "--every 必须是正数",
+		WrongFirstArgs:              "--first 的参数数量错误",
+		InvalidFirst:                "--first 必须是正数",
+		WrongLastArgs:               "--last 的参数数量错误",
+		InvalidLast:                 "--last 必须是正数",
+		WrongSampleArgs:             "抽样的参数数量错误",
+		InvalidSample:               "抽样数量必须是正数",
+		WrongSeedArgs:               "随机种子的参数数量错误",
+		InvalidSeed:                 "随机种子必须是数字",
+		SeedWithoutSample:           "没有抽样不能使用随机种子",
+		InvalidRange:                "无效的日期范围",
+		NoRanges:                    "未提供集合运算的日期范围",
+		DatesWithRanges:             "日期不能与日期范围组合使用",
+		StdinArgs:                   "--stdin 标志没有参数",
+		InvalidInput:                "输入中有无效的日期或日期范围",
+		StdinWithDates:              "--stdin 不能与日期、日期范围或数量组合使用",
+		WrongInArgs:                 "--in 的参数数量错误",
+		NoInputFormat:               "reformat 需要用 --in 指定日期格式",
+		InputFormatWithoutReformat:  "--in 只能与 reformat 一起使用",
+		ReformatWithDates:           "reformat 不能与日期、范围、数量或 --stdin 组合使用",
+		UnsupportedInputPlaceholder: "无法从文本中读取该占位符",
+		IncompleteInputFormat:       "--in 格式需要包含年、月和日",
+		InfoWithDates:               "info 只接受一个日期",
+		JSONArgs:                    "--json 标志没有参数",
+		JSONWithoutInfo:             "--json 只能与 info 一起使用",
+		InfoWithCount:               "info 不能与数量组合使用",
+		InfoWithFilters:             "info 不能与过滤器、选择、-r 或 --step 组合使用",
+		GridArgs:                    "--grid 标志没有参数",
+		GridWithCommand:             "--grid 只能用于输出日期",
+		GridWithFormat:              "--grid 不能与 -f、--calendar 或 -r 组合使用",
+		InfoDate:                    "日期",
+		InfoWeekday:                 "星期",
+		InfoISOWeek:                 "ISO 周",
+		InfoDayOfYear:               "一年中的第几天",
+		InfoQuarter:                 "季度",
+		InfoFromToday:               "距今天",
+		InfoLeapYear:                "闰年",
+		InfoHolidays:                "节假日",
+		InfoToday:                   "今天",
+		InfoTomorrow:                "明天",
+		InfoYesterday:               "昨天",
+		InfoInDays:                  "%d 天后",
+		InfoDaysAgo:                 "%d 天前",
+		InfoYes:                     "是",
+		InfoNo:                      "否",
+		UnknownFlag:                 "发现未知标志",
+		DuplicateFlag:               "发现重复的标志",
+		WrongNumberOfDates:          "提供的日期数量错误",
+		DatesNotNextToEachOther:     "两个日期不相邻",
+		DatesBetweenOptions:         "日期位于选项之间",
+		DoubleWeekday:               "-i 中有重复的星期",
+		CountWithEndDate:            "数量不能与结束日期同时使用",
+		CountWithoutWeekdays:        "忽略所有星期时无法达到数量",
+		CountWithoutMatches:         "没有日期符合过滤器时无法达到数量",
+		UnsupportedStrftime:         "发现不支持的 strftime 指令",
+		UnsupportedGoLayout:         "发现不支持的 go 布局元素",
+		UnterminatedPlaceholder:     "发现未结束的占位符",
+		UnknownPlaceholder:          "发现未知的占位符",
+		InvalidPadModifier:          "发现无效的 pad 修饰符",
+		InvalidLenModifier:          "发现无效的 len 修饰符",
+		UnknownModifier:             "发现未知的占位符修饰符",
+	},
+	"ja": {
+		InvalidOption:               "フラグのない無効なオプションが指定されました",
+		NoIgnoredWeekdays:           "除外する曜日が指定されていません",
+		InvalidWeekday:              "曜日の解析中にエラーが発生しました",
+		WrongFormatArgs:             "フォーマットの引数が正しくありません",
+		WrongFormatStyleArgs:        "フォーマットスタイルの引数の数が正しくありません",
+		UnknownFormatStyle:          "不明なフォーマットスタイルです",
+		ReverseArgs:                 "-r フラグは引数を取りません",
+		WrongLanguageArgs:           "言語の引数の数が正しくありません",
+		UnknownLanguage:             "不明な言語です",
+		WrongLocaleFileArgs:         "ロケールファイルの引数の数が正しくありません",
+		WrongCountArgs:              "件数の引数の数が正しくありません",
+		InvalidCount:                "件数は正の数でなければなりません",
+		WrongTimezoneArgs:           "タイムゾーンの引数の数が正しくありません",
+		UnknownTimezone:             "不明なタイムゾーンです",
+		WrongStepArgs:               "ステップの引数の数が正しくありません",
+		InvalidStep:                 "無効なステップです",
+		WrongCalendarArgs:           "暦の引数の数が正しくありません",
+		UnknownCalendar:             "不明な暦です",
+		WrongDigitsArgs:             "数字の引数の数が正しくありません",
+		UnknownDigits:               "不明な数字です",
+		WrongWeekStartArgs:          "週の開始日の引数の数が正しくありません",
+		UnknownWeekStart:            "不明な週の開始日です",
+		WrongWeekendArgs:            "週末の引数の数が正しくありません",
+		UnknownWeekend:              "不明な週末です",
+		WorkdaysOnlyArgs:            "--workdays-only フラグは引数を取りません",
+		NoFilterValues:              "フィルターの値が指定されていません",
+		InvalidDay:                  "無効な日です",
+		InvalidMonth:                "無効な月です",
+		InvalidWeek:                 "無効な週です",
+		InvalidDayOfYear:            "無効な通算日です",
+		NoWhereExpression:           "--where の式が指定されていません",
+		InvalidExpression:           "無効な --where の式です",
+		UnknownName:                 "--where の式に不明な名前があります",
+		MismatchedTypes:             "--where の式で数値、コード、条件が混同されています",
+		WrongHolidaysArgs:           "祝日の引数の数が正しくありません",
+		InvalidHoliday:              "無効な祝日の日付です",
+		UnreadableFile:              "ファイルを読み込めません",
+		WrongOffsetArgs:             "オフセットの引数の数が正しくありません",
+		InvalidOffset:               "オフセットは負の数にできません",
+		WrongEveryArgs:              "--every の引数の数が正しくありません",
+		InvalidEvery:                "--every は正の数である必要があります",
+		WrongFirstArgs:              "--first の引数の数が正しくありません",
+		InvalidFirst:                "--first は正の数である必要があります",
+		WrongLastArgs:               "--last の引数の数が正しくありません",
+		InvalidLast:                 "--last は正の数である必要があります",
+		WrongSampleArgs:             "サンプルの引数の数が正しくありません",
+		InvalidSample:               "サンプルは正の数である必要があります",
+		WrongSeedArgs:               "シードの引数の数が正しくありません",
+		InvalidSeed:                 "シードは数値である必要があります",
+		SeedWithoutSample:           "シードはサンプルなしでは使用できません",
+		InvalidRange:                "無効な範囲です",
+		NoRanges:                    "集合演算の範囲が指定されていません",
+		DatesWithRanges:             "日付は範囲と組み合わせられません",
+		StdinArgs:                   "--stdin フラグには引数がありません",
+		InvalidInput:                "入力に無効な日付または範囲があります",
+		StdinWithDates:              "--stdin は日付、範囲、件数と組み合わせられません",
+		WrongInArgs:                 "--in の引数の数が正しくありません",
+		NoInputFormat:               "reformat には --in で日付の形式を指定する必要があります",
+		InputFormatWithoutReformat:  "--in は reformat と一緒にのみ使用できます",
+		ReformatWithDates:           "reformat は日付、範囲、件数、--stdin と組み合わせられません",
+		UnsupportedInputPlaceholder: "このプレースホルダーはテキストから読み取れません",
+		IncompleteInputFormat:       "--in の形式には年、月、日が必要です",
+		InfoWithDates:               "info は日付を 1 つだけ受け取ります",
+		JSONArgs:                    "--json フラグには引数がありません",
+		JSONWithoutInfo:             "--json は info と一緒にのみ使用できます",
+		InfoWithCount:               "info は件数と組み合わせられません",
+		InfoWithFilters:             "info はフィルター、選択、-r、--step と組み合わせられません",
+		GridArgs:                    "--grid フラグには引数がありません",
+		GridWithCommand:             "--grid は日付の出力にのみ使用できます",
+		GridWithFormat:              "--grid は -f、--calendar、-r と組み合わせられません",
+		InfoDate:                    "日付",
+		InfoWeekday:                 "曜日",
+		InfoISOWeek:                 "ISO 週",
+		InfoDayOfYear:               "年間通算日",
+		InfoQuarter:                 "四半期",
+		InfoFromToday:               "今日から",
+		InfoLeapYear:                "うるう年",
+		InfoHolidays:                "祝日",
+		InfoToday:                   "今日",
+		InfoTomorrow:                "明日",
+		InfoYesterday:               "昨日",
+		InfoInDays:                  "%d 日後",
+		InfoDaysAgo:                 "%d 日前",
+		InfoYes:                     "はい",
+		InfoNo:                      "いいえ",
+		UnknownFlag:                 "不明なフラグがあります",
+		DuplicateFlag:               "重複したフラグがあります",
+		WrongNumberOfDates:          "日付の数が正しくありません",
+		DatesNotNextToEachOther:     "2つの日付が隣り合っていません",
+		DatesBetweenOptions:         "オプションの間に日付があります",
+		DoubleWeekday:               "-i に重複した曜日があります",
+		CountWithEndDate:            "件数は終了日と組み合わせられません",
+		CountWithoutWeekdays:        "すべての曜日を除外すると件数に達しません",
+		CountWithoutMatches:         "フィルターに合う日付がないと件数に達しません",
+		UnsupportedStrftime:         "サポートされていない strftime 指定子があります",
+		UnsupportedGoLayout:         "サポートされていない go レイアウト要素があります",
+		UnterminatedPlaceholder:     "閉じられていないプレースホルダーがあります",
+		UnknownPlaceholder:          "不明なプレースホルダーがあります",
+		InvalidPadModifier:          "無効な pad 修飾子があります",
+		InvalidLenModifier:          "無効な len 修飾子があります",
+		UnknownModifier:             "不明なプレースホルダー修飾子があります",
+	},
+	"ar": {
+		InvalidOption:               "خيار غير صالح بدون علامة",
+		NoIgnoredWeekdays:           "لم يتم تحديد أيام الأسبوع المراد تجاهلها",
+		InvalidWeekday:              "خطأ أثناء قراءة يوم من أيام الأسبوع",
+		WrongFormatArgs:             "وسائط التنسيق غير صحيحة",
+		WrongFormatStyleArgs:        "عدد وسائط نمط التنسيق غير صحيح",
+		UnknownFormatStyle:          "نمط تنسيق غير معروف",
+		ReverseArgs:                 "العلامة -r لا تقبل وسائط",
+		WrongLanguageArgs:           "عدد وسائط اللغة غير صحيح",
+		UnknownLanguage:             "لغة غير معروفة",
+		WrongLocaleFileArgs:         "عدد وسائط ملف الإعدادات المحلية غير صحيح",
+		WrongCountArgs:              "عدد وسائط العدد غير صحيح",
+		InvalidCount:                "يجب أن يكون العدد رقمًا موجبًا",
+		WrongTimezoneArgs:           "عدد وسائط المنطقة الزمنية غير صحيح",
+		UnknownTimezone:             "منطقة زمنية غير معروفة",
+		WrongStepArgs:               "عدد وسائط الخطوة غير صحيح",
+		InvalidStep:                 "خطوة غير صالحة",
+		WrongCalendarArgs:           "عدد وسائط التقويم غير صحيح",
+		UnknownCalendar:             "تقويم غير معروف",
+		WrongDigitsArgs:             "عدد وسائط الأرقام غير صحيح",
+		UnknownDigits:               "أرقام غير معروفة",
+		WrongWeekStartArgs:          "عدد وسائط بداية الأسبوع غير صحيح",
+		UnknownWeekStart:            "بداية أسبوع غير معروفة",
+		WrongWeekendArgs:            "عدد وسائط عطلة نهاية الأسبوع غير صحيح",
+		UnknownWeekend:              "عطلة نهاية أسبوع غير معروفة",
+		WorkdaysOnlyArgs:            "العلامة --workdays-only لا تقبل وسائط",
+		NoFilterValues:              "لم يتم تحديد قيم للمرشح",
+		InvalidDay:                  "يوم من الشهر غير صالح",
+		InvalidMonth:                "شهر غير صالح",
+		InvalidWeek:                 "أسبوع غير صالح",
+		InvalidDayOfYear:            "يوم من السنة غير صالح",
+		NoWhereExpression:           "لم يتم تقديم تعبير --where",
+		InvalidExpression:           "تعبير --where غير صالح",
+		UnknownName:                 "اسم غير معروف في تعبير --where",
+		MismatchedTypes:             "خلط بين الأرقام والرموز والشروط في تعبير --where",
+		WrongHolidaysArgs:           "عدد خاطئ من وسائط العطلات",
+		InvalidHoliday:              "تاريخ عطلة غير صالح",
+		UnreadableFile:              "لا يمكن قراءة الملف",
+		WrongOffsetArgs:             "عدد خاطئ من وسائط الإزاحة",
+		InvalidOffset:               "لا يمكن أن تكون الإزاحة سالبة",
+		WrongEveryArgs:              "عدد خاطئ من وسائط --every",
+		InvalidEvery:                "يجب أن يكون --every رقمًا موجبًا",
+		WrongFirstArgs:              "عدد خاطئ من وسائط --first",
+		InvalidFirst:                "يجب أن يكون --first رقمًا موجبًا",
+		WrongLastArgs:               "عدد خاطئ من وسائط --last",
+		InvalidLast:                 "يجب أن يكون --last رقمًا موجبًا",
+		WrongSampleArgs:             "عدد خاطئ من وسائط العينة",
+		InvalidSample:               "يجب أن تكون العينة رقمًا موجبًا",
+		WrongSeedArgs:               "عدد خاطئ من وسائط البذرة",
+		InvalidSeed:                 "يجب أن تكون البذرة رقمًا",
+		SeedWithoutSample:           "لا يمكن استخدام البذرة بدون عينة",
+		InvalidRange:                "نطاق غير صالح",
+		NoRanges:                    "لم يتم تقديم نطاقات لعملية المجموعات",
+		DatesWithRanges:             "لا يمكن الجمع بين التواريخ والنطاقات",
+		StdinArgs:                   "العلم --stdin لا يقبل وسائط",
+		InvalidInput:                "تاريخ أو نطاق غير صالح في الإدخال",
+		StdinWithDates:              "لا يمكن الجمع بين --stdin والتواريخ أو النطاقات أو العدد",
+		WrongInArgs:                 "عدد خاطئ من وسائط --in",
+		NoInputFormat:               "يحتاج reformat إلى تنسيق التواريخ عبر --in",
+		InputFormatWithoutReformat:  "لا يمكن استخدام --in إلا مع reformat",
+		ReformatWithDates:           "لا يمكن دمج reformat مع تواريخ أو نطاقات أو عدد أو --stdin",
+		UnsupportedInputPlaceholder: "لا يمكن قراءة العنصر النائب من النص",
+		IncompleteInputFormat:       "يحتاج تنسيق --in إلى سنة وشهر ويوم",
+		InfoWithDates:               "يقبل info تاريخًا واحدًا فقط",
+		JSONArgs:                    "الخيار --json ليس له وسائط",
+		JSONWithoutInfo:             "لا يمكن استخدام --json إلا مع info",
+		InfoWithCount:               "لا يمكن دمج info مع عدد",
+		InfoWithFilters:             "لا يمكن دمج info مع المرشحات أو التحديدات أو -r أو --step",
+		GridArgs:                    "الخيار --grid ليس له وسائط",
+		GridWithCommand:             "لا يمكن استخدام --grid إلا لطباعة التواريخ",
+		GridWithFormat:              "لا يمكن دمج --grid مع -f أو --calendar أو -r",
+		InfoDate:                    "التاريخ",
+		InfoWeekday:                 "يوم الأسبوع",
+		InfoISOWeek:                 "أسبوع ISO",
+		InfoDayOfYear:               "يوم السنة",
+		InfoQuarter:                 "الربع",
+		InfoFromToday:               "من اليوم",
+		InfoLeapYear:                "سنة كبيسة",
+		InfoHolidays:                "العطلات",
+		InfoToday:                   "اليوم",
+		InfoTomorrow:                "غدًا",
+		InfoYesterday:               "أمس",
+		InfoInDays:                  "بعد %d يوم",
+		InfoDaysAgo:                 "قبل %d يوم",
+		InfoYes:                     "نعم",
+		InfoNo:                      "لا",
+		UnknownFlag:                 "تم العثور على علامة غير معروفة",
+		DuplicateFlag:               "تم العثور على علامة مكررة",
+		WrongNumberOfDates:          "عدد التواريخ غير صحيح",
+		DatesNotNextToEachOther:     "التاريخان غير متجاورين",
+		DatesBetweenOptions:         "توجد تواريخ بين الخيارات",
+		DoubleWeekday:               "أيام أسبوع مكررة في -i",
+		CountWithEndDate:            "لا يمكن الجمع بين العدد وتاريخ النهاية",
+		CountWithoutWeekdays:        "لا يمكن بلوغ العدد عند تجاهل جميع أيام الأسبوع",
+		CountWithoutMatches:         "لا يمكن بلوغ العدد عندما لا يطابق أي تاريخ المرشحات",
+		UnsupportedStrftime:         "تم العثور على توجيه strftime غير مدعوم",
+		UnsupportedGoLayout:         "تم العثور على عنصر تخطيط go غير مدعوم",
+		UnterminatedPlaceholder:     "تم العثور على عنصر نائب غير مغلق",
+		UnknownPlaceholder:          "تم العثور على عنصر نائب غير معروف",
+		InvalidPadModifier:          "تم العثور على معدِّل pad غير صالح",
+		InvalidLenModifier:          "تم العثور على معدِّل len غير صالح",
+		UnknownModifier:             "تم العثور على معدِّل عنصر نائب غير معروف",
+	},
+	"hi": {
+		InvalidOption:               "बिना फ़्लैग के अमान्य विकल्प दिया गया",
+		NoIgnoredWeekdays:           "छोड़ने के लिए कोई सप्ताह का दिन नहीं दिया गया",
+		InvalidWeekday:              "सप्ताह का दिन पढ़ते समय त्रुटि",
+		WrongFormatArgs:             "फ़ॉर्मेट के गलत तर्क दिए गए",
+		WrongFormatStyleArgs:        "फ़ॉर्मेट शैली के तर्कों की संख्या गलत है",
+		UnknownFormatStyle:          "अज्ञात फ़ॉर्मेट शैली",
+		ReverseArgs:                 "-r फ़्लैग कोई तर्क नहीं लेता",
+		WrongLanguageArgs:           "भाषा के तर्कों की संख्या गलत है",
+		UnknownLanguage:             "अज्ञात भाषा",
+		WrongLocaleFileArgs:         "लोकेल फ़ाइल के तर्कों की संख्या गलत है",
+		WrongCountArgs:              "गिनती के तर्कों की संख्या गलत है",
+		InvalidCount:                "गिनती एक धनात्मक संख्या होनी चाहिए",
+		WrongTimezoneArgs:           "समय क्षेत्र के तर्कों की संख्या गलत है",
+		UnknownTimezone:             "अज्ञात समय क्षेत्र",
+		WrongStepArgs:               "चरण के तर्कों की संख्या गलत है",
+		InvalidStep:                 "अमान्य चरण",
+		WrongCalendarArgs:           "कैलेंडर के तर्कों की संख्या गलत है",
+		UnknownCalendar:             "अज्ञात कैलेंडर",
+		WrongDigitsArgs:             "अंकों के तर्कों की संख्या गलत है",
+		UnknownDigits:               "अज्ञात अंक",
+		WrongWeekStartArgs:          "सप्ताह की शुरुआत के तर्कों की संख्या गलत है",
+		UnknownWeekStart:            "सप्ताह की अज्ञात शुरुआत",
+		WrongWeekendArgs:            "सप्ताहांत के तर्कों की संख्या गलत है",
+		UnknownWeekend:              "अज्ञात सप्ताहांत",
+		WorkdaysOnlyArgs:            "--workdays-only फ़्लैग कोई तर्क नहीं लेता",
+		NoFilterValues:              "फ़िल्टर के लिए कोई मान नहीं दिया गया",
+		InvalidDay:                  "महीने का अमान्य दिन",
+		InvalidMonth:                "अमान्य महीना",
+		InvalidWeek:                 "अमान्य सप्ताह",
+		InvalidDayOfYear:            "वर्ष का अमान्य दिन",
+		NoWhereExpression:           "कोई --where अभिव्यक्ति नहीं दी गई",
+		InvalidExpression:           "अमान्य --where अभिव्यक्ति",
+		UnknownName:                 "--where अभिव्यक्ति में अज्ञात नाम",
+		MismatchedTypes:             "--where अभिव्यक्ति में संख्याएँ, कोड और शर्तें मिल गईं",
+		WrongHolidaysArgs:           "छुट्टियों के लिए तर्कों की गलत संख्या",
+		InvalidHoliday:              "अमान्य छुट्टी की तिथि",
+		UnreadableFile:              "फ़ाइल पढ़ी नहीं जा सकती",
+		WrongOffsetArgs:             "ऑफ़सेट के लिए तर्कों की गलत संख्या",
+		InvalidOffset:               "ऑफ़सेट ऋणात्मक नहीं हो सकता",
+		WrongEveryArgs:              "--every के लिए तर्कों की गलत संख्या",
+		InvalidEvery:                "--every एक धनात्मक संख्या होनी चाहिए",
+		WrongFirstArgs:              "--first के लिए तर्कों की गलत संख्या",
+		InvalidFirst:                "--first एक धनात्मक संख्या होनी चाहिए",
+		WrongLastArgs:               "--last के लिए तर्कों की गलत संख्या",
+		InvalidLast:                 "--last एक धनात्मक संख्या होनी चाहिए",
+		WrongSampleArgs:             "नमूने के लिए तर्कों की गलत संख्या",
+		InvalidSample:               "नमूना एक धनात्मक संख्या होनी चाहिए",
+		WrongSeedArgs:               "सीड के लिए तर्कों की गलत संख्या",
+		InvalidSeed:                 "सीड एक संख्या होनी चाहिए",
+		SeedWithoutSample:           "सीड का उपयोग नमूने के बिना नहीं किया जा सकता",
+		InvalidRange:                "अमान्य सीमा",
+		NoRanges:                    "समुच्चय संक्रिया के लिए कोई सीमा नहीं दी गई",
+		DatesWithRanges:             "तिथियों को सीमाओं के साथ नहीं जोड़ा जा सकता",
+		StdinArgs:                   "--stdin फ़्लैग के कोई तर्क नहीं होते",
+		InvalidInput:                "इनपुट में अमान्य तिथि या सीमा",
+		StdinWithDates:              "--stdin को तिथियों, सीमाओं या गिनती के साथ नहीं जोड़ा जा सकता",
+		WrongInArgs:                 "--in के लिए तर्कों की गलत संख्या दी गई",
+		NoInputFormat:               "reformat को --in के साथ तिथियों का प्रारूप चाहिए",
+		InputFormatWithoutReformat:  "--in केवल reformat के साथ उपयोग किया जा सकता है",
+		ReformatWithDates:           "reformat को तिथियों, सीमाओं, गिनती या --stdin के साथ नहीं जोड़ा जा सकता",
+		UnsupportedInputPlaceholder: "प्लेसहोल्डर को पाठ से नहीं पढ़ा जा सकता",
+		IncompleteInputFormat:       "--in प्रारूप में वर्ष, महीना और दिन होना चाहिए",
+		InfoWithDates:               "info केवल एक तिथि लेता है",
+		JSONArgs:                    "--json फ़्लैग के कोई तर्क नहीं हैं",
+		JSONWithoutInfo:             "--json केवल info के साथ उपयोग किया जा सकता है",
+		InfoWithCount:               "info को गिनती के साथ नहीं जोड़ा जा सकता",
+		InfoWithFilters:             "info को फ़िल्टर, चयन, -r या --step के साथ नहीं जोड़ा जा सकता",
+		GridArgs:                    "--grid फ़्लैग के कोई तर्क नहीं हैं",
+		GridWithCommand:             "--grid का उपयोग केवल तारीखें छापने के लिए किया जा सकता है",
+		GridWithFormat:              "--grid को -f, --calendar या -r के साथ नहीं जोड़ा जा सकता",
+		InfoDate:                    "तिथि",
+		InfoWeekday:                 "सप्ताह का दिन",
+		InfoISOWeek:                 "ISO सप्ताह",
+		InfoDayOfYear:               "वर्ष का दिन",
+		InfoQuarter:                 "तिमाही",
+		InfoFromToday:               "आज से",
+		InfoLeapYear:                "लीप वर्ष",
+		InfoHolidays:                "अवकाश",
+		InfoToday:                   "आज",
+		InfoTomorrow:                "कल",
+		InfoYesterday:               "कल",
+		InfoInDays:                  "%d दिन में",
+		InfoDaysAgo:                 "%d दिन पहले",
+		InfoYes:                     "हाँ",
+		InfoNo:                      "नहीं",
+		UnknownFlag:                 "अज्ञात फ़्लैग मिला",
+		DuplicateFlag:               "दोहराया गया फ़्लैग मिला",
+		WrongNumberOfDates:          "तारीखों की संख्या गलत है",
+		DatesNotNextToEachOther:     "दोनों तारीखें एक साथ नहीं हैं",
+		DatesBetweenOptions:         "तारीखें विकल्पों के बीच में हैं",
+		DoubleWeekday:               "-i में दोहराए गए सप्ताह के दिन",
+		CountWithEndDate:            "गिनती को अंतिम तारीख के साथ नहीं जोड़ा जा सकता",
+		CountWithoutWeekdays:        "सभी दिन छोड़ने पर गिनती पूरी नहीं हो सकती",
+		CountWithoutMatches:         "जब कोई तारीख फ़िल्टर से मेल नहीं खाती तो गिनती पूरी नहीं हो सकती",
+		UnsupportedStrftime:         "असमर्थित strftime निर्देश मिला",
+		UnsupportedGoLayout:         "असमर्थित go लेआउट तत्व मिला",
+		UnterminatedPlaceholder:     "अधूरा प्लेसहोल्डर मिला",
+		UnknownPlaceholder:          "अज्ञात प्लेसहोल्डर मिला",
+		InvalidPadModifier:          "अमान्य pad संशोधक मिला",
+		InvalidLenModifier:          "अमान्य len संशोधक मिला",
+		UnknownModifier:             "अज्ञात प्लेसहोल्डर संशोधक मिला",
+	},
+}
@@ -0,0 +1,209 @@
+package messages
+
+const helpAR = `الاستخدام:
+  pdate [-i <الأيام-المستبعدة>] [-f <الصيغة>] [--format-style <النمط>] [-r] [--grid] [-l <اللغة>] [--locale-file <ملف>] [--count <n>] [--tz <المنطقة>] [--step <الخطوة>] [--calendar <التقويم>] [--digits <الأرقام>] [--week-start <اليوم>] [--weekend <النوع>] [--workdays-only] [--only <الأيام>] [--days <قائمة>] [--months <قائمة>] [--weeks <قائمة>] [--doy <قائمة>] [--where <تعبير>] [--holidays <ملف>] [--offset <n>] [--every <n>] [--first <n>] [--last <n>] [--sample <n> [--seed <البذرة>]] [--union <فترات>] [--intersect <فترات>] [--minus <فترات>] [- | --stdin] [تاريخ-البداية] [تاريخ-النهاية | <من>..<إلى> ...]
+  pdate reformat --in <الصيغة> [-f <الصيغة>] [--format-style <النمط>] [-l <اللغة>] [--calendar <التقويم>] [--digits <الأرقام>]
+  pdate info [--json] [-f <الصيغة>] [-l <اللغة>] [--holidays <ملف>] [تاريخ]
+
+الوصف:
+  يطبع التواريخ من <تاريخ-البداية> إلى <تاريخ-النهاية> (أو إلى اليوم إذا لم يُذكر تاريخ النهاية).
+  يمكن اختياريًا استبعاد أيام من الأسبوع أو تخصيص الصيغة أو عكس الترتيب.
+  يقرأ reformat نصًا من الإدخال القياسي ويستبدل تواريخ صيغة --in بصيغة -f.
+  يطبع info يوم الأسبوع وأسبوع ISO ورقم اليوم في السنة والربع والعطلات لتاريخ ما (أو لليوم).
+
+الخيارات:
+  [تاريخ-البداية]      بداية الفترة (الصيغة: YYYY-MM-DD أو YYYY-MM-DDThh:mm أو YYYY-MM-DDThh:mm:ss).
+  [تاريخ-النهاية]      نهاية اختيارية للفترة (الصيغ نفسها). الافتراضي اليوم، أو الآن للخطوات الأقل من يوم.
+  [<من>..<إلى> ...]    فترات بدل التواريخ (مثل 2025-01-01..2025-01-31 2025-03-01..2025-03-15)،
+                       تُطبع التواريخ مرة واحدة وبالترتيب.
+  -i <الأيام>          استبعاد أيام من الأسبوع بالرموز (مثل mo tu fr أو الاثنين الثلاثاء).
+  -f <الصيغة>          تنسيق كل تاريخ بعناصر نائبة (انظر أدناه).
+  --format-style <s>   قراءة صيغة -f على أنها placeholder (الافتراضي) أو strftime أو go.
+  --in <الصيغة>        مع reformat: صيغة التواريخ في النص، بنمط -f نفسه.
+                       تحتاج إلى سنة وشهر ويوم؛ تُقرأ الأسماء بلغة -l أو بالإنجليزية.
+  --json               مع info: طباعة السجل بصيغة JSON.
+  --grid               طباعة التواريخ تقويمًا للأشهر تسبقه أسابيع الإعدادات المحلية،
+                       لا مع -f أو --calendar أو -r.
+  -r                   طباعة التواريخ بترتيب عكسي.
+  -l <اللغة>           طباعة الصيغة بلغة تُعطى كوسم BCP 47 (مثل de وde-CH وpt-BR وzh-Hant)،
+                       الافتراضي $LC_ALL أو $LC_TIME أو $LANG (مثل ar_EG.UTF-8)، وإلا en.
+                       تنطبق اللغة أيضًا على هذه المساعدة ورسائل الخطأ ورموز -i.
+  --locale-file <f>    استخدام أسماء ملف إعدادات محلية بصيغة YAML (انظر README)، ويمكن لـ -l اختيار لغة أخرى.
+  --count <n>          طباعة n تاريخًا بدءًا من تاريخ البداية (إلى الوراء مع -r)، تُعدّ بعد -i.
+  --limit <n>          مثل --count.
+  --step <الخطوة>      المسافة بين تاريخين، رقم يليه s أو m أو h أو d أو w (مثل 15m). الافتراضي 1d.
+                       تنتقل خطوات الأسابيع من أول يوم في أسبوع إلى أول يوم في الأسبوع التالي، و7d تحافظ على يوم الأسبوع لتاريخ البداية.
+  --tz <المنطقة>       تحديد اليوم في منطقة زمنية (مثل Asia/Riyadh)، الافتراضي $TZ أو المنطقة الزمنية للنظام.
+                       تتبع الخطوات الأقل من يوم توقيتها الصيفي.
+  --calendar <cal>     طباعة التواريخ بتقويم آخر (انظر أدناه)، الافتراضي gregorian.
+  --digits <الأرقام>   طباعة الأرقام بالأرقام المحلية (native، مثل ar وfa وhi وth وzh وja) أو اللاتينية (latin)، الافتراضي native لـ ar وfa.
+  --week-start <اليوم> أول يوم في الأسبوع لـ {ww} و{gggg} وخطوات الأسابيع و--grid: mo أو su أو sa،
+                       الافتراضي أول يوم في الإعدادات المحلية (مثل sa لـ ar وar-EG، وsu لـ en-US، وmo لـ de).
+                       الأسبوع 1 مع mo هو أسبوع ISO، ومع su وsa هو الأسبوع الذي فيه 1 يناير.
+  --weekend <النوع>    عطلة نهاية الأسبوع التي يستبعدها --workdays-only: sat-sun أو fri-sat أو fri أو sun،
+                       الافتراضي عطلة الإعدادات المحلية (مثل fri-sat لـ ar وar-SA وhe، وsat-sun لـ de).
+  --workdays-only      استبعاد عطلة نهاية الأسبوع مع أيام -i.
+  --only <الأيام>      الإبقاء على أيام الأسبوع هذه فقط، برموز -i (مثل mo we).
+  --days <قائمة>       الإبقاء على أيام الشهر هذه فقط، والسالبة تُعدّ من النهاية (مثل 1,15,-1 أو 1-7).
+  --months <قائمة>     الإبقاء على الأشهر هذه فقط كرموز أو أسماء باللغة أو أرقام (مثل jan,apr,jul,oct أو nov-feb).
+  --weeks <قائمة>      الإبقاء على أسابيع ISO هذه فقط (مثل 1-10).
+  --doy <قائمة>        الإبقاء على أيام السنة هذه فقط، والسالبة تُعدّ من النهاية (مثل 1,100,-1).
+                       يمكن الجمع بين المرشحات، ويجب أن يوافقها التاريخ كلها.
+  --where <تعبير>      الإبقاء على التواريخ التي يكون التعبير صحيحًا لها فقط (انظر أدناه).
+  --holidays <ملف>     ملف فيه تاريخ (YYYY-MM-DD) في كل سطر لـ holiday في --where،
+                       يليه اسم اختياري يطبعه info.
+  --offset <n>         تخطي أول n تاريخًا تجتاز المرشحات.
+  --every <n>          الإبقاء على تاريخ من كل n تاريخًا تجتاز المرشحات، بدءًا بعد --offset.
+  --first <n>          الإبقاء على أول n تاريخًا.
+  --last <n>           الإبقاء على آخر n تاريخًا.
+  --sample <n>         الإبقاء على n تاريخًا تُسحب عشوائيًا، بترتيبها.
+  --seed <البذرة>      سحب عينة --sample نفسها في كل مرة.
+                       تُطبق هذه الخيارات بهذا الترتيب بعد المرشحات وقبل -r.
+  --union <فترات>      إضافة تواريخ هذه الفترات.
+  --intersect <فترات>  الإبقاء على التواريخ داخل هذه الفترات فقط.
+  --minus <فترات>      حذف التواريخ داخل هذه الفترات.
+                       تُطبق بهذا الترتيب قبل المرشحات وتأخذ كل الفترات التي تليها.
+  -, --stdin           قراءة التواريخ والفترات سطرًا سطرًا من الإدخال القياسي.
+  -h, --help           عرض هذه المساعدة.
+  -v, --version        عرض الإصدار
+
+رموز الأيام لـ -i (تعمل أيضًا أسماء الأيام المختصرة في اللغة، مثل الاثنين الثلاثاء):
+  mo  الاثنين
+  tu  الثلاثاء
+  we  الأربعاء
+  th  الخميس
+  fr  الجمعة
+  sa  السبت
+  su  الأحد
+
+العناصر النائبة لـ -f:
+  {YYYY}  السنة كاملة (مثل ٢٠٢٥)
+  {YY}    آخر رقمين من السنة (مثل ٢٥)
+  {MM}    الشهر بصفر بادئ (مثل ١٢)
+  {M}     الشهر بلا صفر بادئ (مثل ١٢)
+  {DD}    اليوم بصفر بادئ (مثل ٠٧)
+  {D}     اليوم بلا صفر بادئ (مثل ٧)
+  {MN}    اسم الشهر كاملًا (مثل ديسمبر)
+  {MNg}   اسم الشهر داخل تاريخ، بحالة الإضافة في الروسية (مثل декабря)
+  {mn}    اسم الشهر المختصر (مثل ديسمبر)
+  {mn1}   اسم الشهر الأقصر (مثل د)
+  {WD}    اسم اليوم كاملًا (مثل الأحد)
+  {wd}    اسم اليوم المختصر (مثل الأحد)
+  {wd1}   اسم اليوم الأقصر (مثل ح)
+  {WW}    أسبوع ISO بصفر بادئ (مثل ٤٩)
+  {GGGG}  سنة ترقيم أسابيع ISO (مثل ٢٠٢٥)
+  {ww}    أسبوع الإعدادات المحلية بصفر بادئ، يحتوي الأسبوع 1 على 1 يناير في الولايات المتحدة و4 يناير في معظم أوروبا (مثل ٥٠)
+  {gggg}  سنة ترقيم أسابيع الإعدادات المحلية (مثل ٢٠٢٥)
+  {DOY}   رقم اليوم في السنة بأصفار بادئة (مثل ٣٤١)
+  {Q}     ربع السنة (مثل ٤)
+  {Do}    اليوم من الشهر بصيغة الترتيب، بلا لاحقة في العربية (مثل ٧)
+  {U}     طابع زمني Unix بالثواني، لمنتصف الليل في المنطقة الزمنية للأيام الكاملة (مثل ١٧٦٥٠٦٥٦٠٠)
+  {JDN}   رقم اليوم اليولياني (مثل ٢٤٦١٠١٧)
+  {N}     يوم الأسبوع حسب ISO، الاثنين = 1 (مثل ٧)
+  {idx}   الموضع في الناتج، بدءًا من ١
+  {hh}    الساعة (00-23)
+  {mm}    الدقيقة (00-59)
+  {ss}    الثانية (00-59)
+  {HH12}  الساعة بنظام 12 ساعة (01-12)
+  {AMPM}  قبل الظهر أو بعده (مثل ص)
+  {Z}     فرق المنطقة الزمنية عن UTC (مثل +03:00)
+  {ERA}   العصر الياباني أو السنة الستينية الصينية (مثل Reiwa)
+
+معدِّلات العناصر النائبة لـ -f (مثل {WD:len=2:upper}):
+  upper   أحرف كبيرة
+  lower   أحرف صغيرة
+  title   الحرف الأول كبير (مثل {WD:title} يطبع Dimanche مع -l fr)
+  padN    الحشو حتى N حرفًا (مثل {D:pad3} يطبع ٠٠٧)
+  len=N   قطع القيمة بعد N حرفًا (مثل {MN:len=2} يطبع دي)
+  استخدم {{ و}} لطباعة { أو } حرفيًا.
+
+التعبيرات لـ --where (مثل 'weekday in (mo,fr) and day <= 7 and not holiday'):
+  المقارنات  = != < <= > >= وin (...) أو not in (...) مع أرقام ورموز (mo..su وjan..dec) ونطاقات (1..7 وnov..feb)
+  الشروط     and وor وnot والأقواس
+  الأرقام    year month day weekday (mo = 1) week (ISO) quarter doy hour minute second daysInMonth nthWeekday
+  الاختبارات isFirstWeekdayOfMonth isLastWeekdayOfMonth isLastDayOfMonth isLeapYear weekend holiday
+
+أنماط الصيغة لـ --format-style:
+  placeholder  {YYYY}-{MM}-{DD} (الافتراضي)
+  strftime     %Y-%m-%d، يدعم %Y %y %m %-m %d %-d %a %A %b %h %B %F %D %j %V %G %u %q %s
+               %H %M %S %I %p %T %R %n %t %%
+  go           2006-01-02، يدعم 2006 06 01 1 02 2 002 Jan January Mon Monday 15 03 04 05 PM pm
+
+التقاويم لـ --calendar (تغيّر {YYYY} {YY} {MM} {M} {DD} {D} {Do} {MN} {mn}):
+  gregorian  الافتراضي، التقويم الميلادي
+  julian     التقويم اليولياني الممتد
+  islamic    التقويم الهجري الجدولي
+  hebrew     التقويم العبري، تُرقّم الأشهر من تشري
+  persian    التقويم الهجري الشمسي
+  buddhist   التقويم الشمسي التايلاندي
+  japanese   الأشهر الميلادية مع سنة العصر الياباني
+  chinese    التقويم الصيني القمري الشمسي، {YYYY} هي السنة الميلادية التي بدأت فيها السنة الصينية
+
+اللغات لـ -l (أضف منطقة للأسماء الإقليمية، مثل de-AT وes-MX وfr-CA وar-MA وzh-TW):
+  ar العربية    bg البلغارية  ca الكتالونية cs التشيكية  da الدنماركية de الألمانية
+  el اليونانية  en الإنجليزية es الإسبانية  et الإستونية  fa الفارسية   fi الفنلندية
+  fr الفرنسية   gsw السويسرية he العبرية    hi الهندية    hr الكرواتية  hu المجرية
+  id الإندونيسية it الإيطالية ja اليابانية  ko الكورية    lt الليتوانية lv اللاتفية
+  nb النرويجية  nl الهولندية  pl البولندية  pt البرتغالية ro الرومانية  ru الروسية
+  sk السلوفاكية sl السلوفينية sv السويدية   th التايلاندية tr التركية   uk الأوكرانية
+  vi الفيتنامية zh الصينية    zh-Hant الصينية التقليدية
+  ما زال ch مقبولًا للألمانية السويسرية.
+
+أمثلة:
+  pdate 2025-10-02
+    يطبع كل التواريخ من 2 أكتوبر 2025 حتى اليوم.
+
+  pdate 2025-10-02 2025-11-30
+    يطبع كل التواريخ من 2 أكتوبر إلى 30 نوفمبر 2025.
+
+  pdate -i mo tu 2025-10-02 2025-11-30
+    يطبع التواريخ من دون أيام الاثنين والثلاثاء.
+
+  pdate -i mo tu th fr sa -r 2025-10-02 2025-11-30
+    يطبع التواريخ من دون الاثنين والثلاثاء والخميس والجمعة والسبت بترتيب عكسي.
+
+  pdate -f "{WD} {D} {MN} {YYYY}" 2025-10-02 2025-10-10
+    يطبع تواريخ منسقة مثل الخميس ٢ أكتوبر ٢٠٢٥
+
+  pdate --count 20 --workdays-only 2025-10-02
+    يطبع أيام العمل العشرين التالية بدءًا من 2 أكتوبر 2025، من دون الجمعة والسبت.
+
+  pdate --count 20 --workdays-only --weekend sat-sun 2025-10-02
+    يطبع أيام العمل العشرين التالية لأسبوع عطلته السبت والأحد.
+
+  pdate --grid --workdays-only --week-start mo 2025-10-01 2025-10-31
+    يطبع أيام عمل أكتوبر 2025 تقويمًا بأسابيع من الاثنين إلى الأحد.
+
+  pdate --count 12 --days 15,-1 2025-01-01
+    يطبع اليوم الخامس عشر وآخر يوم من كل شهر في النصف الأول من 2025.
+
+  pdate --where 'weekday = th and isLastWeekdayOfMonth' 2025-01-01 2025-12-31
+    يطبع آخر خميس من كل شهر في 2025.
+
+  pdate --sample 10 --seed 42 2025-01-01 2025-12-31
+    يطبع 10 تواريخ عشوائية من 2025، هي نفسها في كل تشغيل.
+
+  pdate 2025-08-18..2025-12-19 2026-01-05..2026-03-27 --minus 2025-10-06..2025-10-17
+    يطبع تواريخ فصلين دراسيين من دون عطلة الخريف.
+
+  git log --format=%as | pdate - --workdays-only -f "{DD}/{MM}/{YYYY}"
+    يعيد تنسيق تواريخ الإيداعات التي جرت في أيام العمل.
+
+  pdate reformat --in "{YYYY}-{MM}-{DD}" -f "{D} {MN} {YYYY}" -l ar < CHANGELOG.md
+    يكتب تواريخ سجل التغييرات بالعربية ويترك بقية النص كما هو.
+
+  pdate info 2025-12-25 --holidays holidays.txt
+    يطبع يوم الأسبوع والأسبوع ورقم اليوم في السنة والعطلات لعيد الميلاد 2025 وكم بقي عليه.
+
+  pdate --step 15m 2025-10-02T08:00 2025-10-02T18:00
+    يطبع فترة زمنية كل 15 دقيقة من 08:00 إلى 18:00.
+
+  pdate --format-style strftime -f "%d/%m/%Y (%a)" 2025-10-02 2025-10-10
+    يطبع التواريخ نفسها بسلسلة صيغة strftime.
+
+  pdate --calendar islamic -f "{D} {MN} {YYYY}" 2025-10-02 2025-10-10
+    يطبع التواريخ بالتقويم الهجري، مثل ٩ ربيع الآخر ١٤٤٧
+
+  pdate -l ar --digits latin -f "{D} {MN} {YYYY}" 2025-12-01 2025-12-07
+    يطبع التواريخ بالأرقام اللاتينية، مثل 1 ديسمبر 2025
+`
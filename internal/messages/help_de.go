@@ -0,0 +1,209 @@
+package messages
+
+const helpDE = `Verwendung:
+  pdate [-i <auszulassende-tage>] [-f <format>] [--format-style <stil>] [-r] [--grid] [-l <sprache>] [--locale-file <datei>] [--count <n>] [--tz <zone>] [--step <schritt>] [--calendar <kalender>] [--digits <ziffern>] [--week-start <tag>] [--weekend <profil>] [--workdays-only] [--only <tage>] [--days <liste>] [--months <liste>] [--weeks <liste>] [--doy <liste>] [--where <ausdruck>] [--holidays <datei>] [--offset <n>] [--every <n>] [--first <n>] [--last <n>] [--sample <n> [--seed <seed>]] [--union <zeiträume>] [--intersect <zeiträume>] [--minus <zeiträume>] [- | --stdin] [startdatum] [enddatum | <von>..<bis> ...]
+  pdate reformat --in <format> [-f <format>] [--format-style <stil>] [-l <sprache>] [--calendar <kalender>] [--digits <ziffern>]
+  pdate info [--json] [-f <format>] [-l <sprache>] [--holidays <datei>] [datum]
+
+Beschreibung:
+  Gibt die Daten von <startdatum> bis <enddatum> aus (oder bis heute, wenn das Enddatum fehlt).
+  Optional lassen sich bestimmte Wochentage auslassen, das Format anpassen oder die Reihenfolge umkehren.
+  reformat liest einen Text von der Standardeingabe und ersetzt die Daten im Format von --in durch das Format von -f.
+  info gibt Wochentag, ISO-Woche, Tag des Jahres, Quartal und Feiertage eines Datums (oder von heute) aus.
+
+Optionen:
+  [startdatum]         Beginn des Zeitraums (Format: YYYY-MM-DD, YYYY-MM-DDThh:mm oder YYYY-MM-DDThh:mm:ss).
+  [enddatum]           Optionales Ende des Zeitraums (gleiche Formate). Standard ist heute, bei Schritten unter einem Tag jetzt.
+  [<von>..<bis> ...]   Zeiträume statt der Daten (z. B. 2025-01-01..2025-01-31 2025-03-01..2025-03-15),
+                       die Daten werden einmal und sortiert ausgegeben.
+  -i <tage>            Bestimmte Wochentage anhand von Kürzeln auslassen (z. B. mo di fr).
+  -f <format>          Jedes Datum mit Platzhaltern formatieren (siehe unten).
+  --format-style <s>   Das Format von -f als placeholder (Standard), strftime oder go lesen.
+  --in <format>        Mit reformat: das Format der Daten im Text, im gleichen Stil wie -f.
+                       Es braucht ein Jahr, einen Monat und einen Tag; Namen werden in der Sprache von -l oder auf Englisch gelesen.
+  --json               Mit info: den Eintrag als JSON ausgeben.
+  --grid               Die Daten als Kalender der Monate mit den Wochen der Locale davor ausgeben,
+                       nicht mit -f, --calendar oder -r.
+  -r                   Daten in umgekehrter Reihenfolge ausgeben.
+  -l <sprache>         Das Format in einer Sprache als BCP-47-Tag ausgeben (z. B. de, de-CH, pt-BR, zh-Hant),
+                       Standard ist $LC_ALL, $LC_TIME oder $LANG (z. B. de_CH.UTF-8), sonst en.
+                       Die Sprache gilt auch für die Hilfe, die Fehlermeldungen und die Kürzel von -i.
+  --locale-file <d>    Die Namen einer YAML-Locale-Datei verwenden (siehe README), -l kann trotzdem eine andere Sprache wählen.
+  --count <n>          n Daten ab dem Startdatum ausgeben (mit -r rückwärts), gezählt nach -i.
+  --limit <n>          Wie --count.
+  --step <schritt>     Abstand zwischen zwei Daten, eine Zahl gefolgt von s, m, h, d oder w (z. B. 15m). Standard ist 1d.
+                       Schritte von Wochen gehen vom ersten Tag einer Woche zum nächsten, 7d behält den Wochentag des Startdatums.
+  --tz <zone>          Heute in einer Zeitzone bestimmen (z. B. Europe/Zurich), Standard ist $TZ oder die Systemzeitzone.
+                       Schritte unter einem Tag folgen ihrer Sommerzeit.
+  --calendar <kal>     Die Daten in einem anderen Kalender ausgeben (siehe unten), Standard ist gregorian.
+  --digits <ziffern>   Zahlen mit native (z. B. ar, fa, hi, th, zh, ja) oder latin Ziffern ausgeben, Standard ist native für ar und fa.
+  --week-start <tag>   Erster Tag der Woche für {ww}, {gggg}, Schritte von Wochen und --grid: mo, so oder sa,
+                       Standard ist der erste Tag der Locale (z. B. mo für de, so für en-US, sa für ar-EG).
+                       Woche 1 ist bei mo die ISO-Woche, bei so und sa die Woche des 1. Januar.
+  --weekend <profil>   Wochenende, das --workdays-only auslässt: sat-sun, fri-sat, fri oder sun,
+                       Standard ist das Wochenende der Locale (z. B. sat-sun für de, fri-sat für he und ar-SA).
+  --workdays-only      Das Wochenende auslassen, zusammen mit den Wochentagen von -i.
+  --only <tage>        Nur diese Wochentage behalten, mit den Kürzeln von -i (z. B. mo mi).
+  --days <liste>       Nur diese Tage des Monats behalten, negative zählen vom Ende (z. B. 1,15,-1 oder 1-7).
+  --months <liste>     Nur diese Monate behalten, als Kürzel, Namen der Sprache oder Zahlen (z. B. jan,apr,jul,okt oder nov-feb).
+  --weeks <liste>      Nur diese ISO-Wochen behalten (z. B. 1-10).
+  --doy <liste>        Nur diese Tage des Jahres behalten, negative zählen vom Ende (z. B. 1,100,-1).
+                       Die Filter lassen sich kombinieren, ein Datum muss alle erfüllen.
+  --where <ausdruck>   Nur die Daten behalten, für die der Ausdruck wahr ist (siehe unten).
+  --holidays <datei>   Datei mit einem Datum (YYYY-MM-DD) pro Zeile für holiday in --where,
+                       gefolgt von einem optionalen Namen, den info ausgibt.
+  --offset <n>         Die ersten n Daten nach den Filtern überspringen.
+  --every <n>          Jedes n-te Datum nach den Filtern behalten, beginnend nach --offset.
+  --first <n>          Die ersten n Daten behalten.
+  --last <n>           Die letzten n Daten behalten.
+  --sample <n>         n zufällig gezogene Daten in ihrer Reihenfolge behalten.
+  --seed <seed>        Bei jedem Aufruf dieselbe Stichprobe von --sample ziehen.
+                       Diese Optionen gelten in dieser Reihenfolge nach den Filtern und vor -r.
+  --union <zr>         Die Daten dieser Zeiträume hinzufügen.
+  --intersect <zr>     Nur die Daten innerhalb dieser Zeiträume behalten.
+  --minus <zr>         Die Daten innerhalb dieser Zeiträume entfernen.
+                       Diese gelten in dieser Reihenfolge vor den Filtern und nehmen alle folgenden Zeiträume.
+  -, --stdin           Die Daten und Zeiträume zeilenweise von der Standardeingabe lesen.
+  -h, --help           Diese Hilfe anzeigen.
+  -v, --version        Version anzeigen
+
+Wochentagskürzel für -i (die englischen Kürzel mo tu we th fr sa su und die Abkürzungen der Sprache gelten auch):
+  mo  Montag
+  di  Dienstag
+  mi  Mittwoch
+  do  Donnerstag
+  fr  Freitag
+  sa  Samstag
+  so  Sonntag
+
+Platzhalter für -f:
+  {YYYY}  Volles Jahr (z. B. 2025)
+  {YY}    Letzte zwei Ziffern des Jahres (z. B. 25)
+  {MM}    Monat mit führender Null (z. B. 12)
+  {M}     Monat ohne führende Null (z. B. 12)
+  {DD}    Tag mit führender Null (z. B. 07)
+  {D}     Tag ohne führende Null (z. B. 7)
+  {MN}    Voller Monatsname (z. B. Dezember)
+  {MNg}   Monatsname innerhalb eines Datums, im Russischen der Genitiv (z. B. декабря)
+  {mn}    Abgekürzter Monatsname (z. B. Dez.)
+  {mn1}   Schmaler Monatsname (z. B. D)
+  {WD}    Voller Wochentagsname (z. B. Sonntag)
+  {wd}    Abgekürzter Wochentagsname (z. B. So.)
+  {wd1}   Schmaler Wochentagsname (z. B. S)
+  {WW}    ISO-Woche mit führender Null (z. B. 49)
+  {GGGG}  Jahr der ISO-Wochenzählung (z. B. 2025)
+  {ww}    Woche der Locale mit führender Null, Woche 1 enthält in den USA den 1. und in den meisten Ländern Europas den 4. Januar (z. B. 49)
+  {gggg}  Jahr der Wochenzählung der Locale (z. B. 2025)
+  {DOY}   Tag des Jahres mit führenden Nullen (z. B. 341)
+  {Q}     Quartal des Jahres (z. B. 4)
+  {Do}    Tag des Monats als Ordnungszahl (z. B. 7.)
+  {U}     Unix-Zeitstempel in Sekunden, bei ganzen Tagen von Mitternacht in der Zeitzone (z. B. 1765065600)
+  {JDN}   Julianisches Datum (z. B. 2461017)
+  {N}     ISO-Wochentag, Montag = 1 (z. B. 7)
+  {idx}   Position in der Ausgabe, beginnend bei 1
+  {hh}    Stunde (00-23)
+  {mm}    Minute (00-59)
+  {ss}    Sekunde (00-59)
+  {HH12}  Stunde im 12-Stunden-Format (01-12)
+  {AMPM}  Vor- oder Nachmittag (z. B. AM)
+  {Z}     Abstand der Zeitzone zu UTC (z. B. +01:00)
+  {ERA}   Japanische Ära oder chinesisches Jahr im Sechzigerzyklus (z. B. Reiwa)
+
+Modifikatoren für Platzhalter in -f (z. B. {WD:len=2:upper}):
+  upper   Großbuchstaben
+  lower   Kleinbuchstaben
+  title   Erster Buchstabe groß (z. B. gibt {WD:title} mit -l fr Dimanche aus)
+  padN    Auf N Zeichen auffüllen (z. B. gibt {D:pad3} 007 aus)
+  len=N   Den Wert nach N Zeichen abschneiden (z. B. gibt {mn:len=2} De aus)
+  {{ und }} geben ein { oder } aus.
+
+Ausdrücke für --where (z. B. 'weekday in (mo,fr) and day <= 7 and not holiday'):
+  Vergleiche   = != < <= > >= und in (...) oder not in (...) mit Zahlen, Kürzeln (mo..su, jan..dec) und Bereichen (1..7, nov..feb)
+  Bedingungen  and, or, not und Klammern
+  Zahlen       year month day weekday (mo = 1) week (ISO) quarter doy hour minute second daysInMonth nthWeekday
+  Tests        isFirstWeekdayOfMonth isLastWeekdayOfMonth isLastDayOfMonth isLeapYear weekend holiday
+
+Formatstile für --format-style:
+  placeholder  {YYYY}-{MM}-{DD} (Standard)
+  strftime     %Y-%m-%d, unterstützt %Y %y %m %-m %d %-d %a %A %b %h %B %F %D %j %V %G %u %q %s
+               %H %M %S %I %p %T %R %n %t %%
+  go           2006-01-02, unterstützt 2006 06 01 1 02 2 002 Jan January Mon Monday 15 03 04 05 PM pm
+
+Kalender für --calendar (ändern {YYYY} {YY} {MM} {M} {DD} {D} {Do} {MN} {mn}):
+  gregorian  Standard
+  julian     Proleptischer julianischer Kalender
+  islamic    Tabellarischer islamischer Kalender
+  hebrew     Hebräischer Kalender, Monate ab Tischri gezählt
+  persian    Iranischer Sonnenkalender
+  buddhist   Thailändischer Sonnenkalender
+  japanese   Gregorianische Monate mit dem Jahr der japanischen Ära
+  chinese    Chinesischer Lunisolarkalender, {YYYY} ist das gregorianische Jahr, in dem das chinesische Jahr begann
+
+Sprachen für -l (mit einer Region für regionale Namen, z. B. de-AT, es-MX, fr-CA, ar-MA, zh-TW):
+  ar Arabisch   bg Bulgarisch ca Katalanisch cs Tschechisch da Dänisch  de Deutsch
+  el Griechisch en Englisch   es Spanisch   et Estnisch   fa Persisch   fi Finnisch
+  fr Französisch gsw Schweizerdeutsch he Hebräisch hi Hindi hr Kroatisch hu Ungarisch
+  id Indonesisch it Italienisch ja Japanisch ko Koreanisch lt Litauisch lv Lettisch
+  nb Norwegisch nl Niederländisch pl Polnisch pt Portugiesisch ro Rumänisch ru Russisch
+  sk Slowakisch sl Slowenisch sv Schwedisch th Thailändisch tr Türkisch uk Ukrainisch
+  vi Vietnamesisch zh Chinesisch zh-Hant Traditionelles Chinesisch
+  ch wird weiterhin für Schweizerdeutsch akzeptiert.
+
+Beispiele:
+  pdate 2025-10-02
+    Gibt alle Daten vom 2. Oktober 2025 bis heute aus.
+
+  pdate 2025-10-02 2025-11-30
+    Gibt alle Daten vom 2. Oktober bis 30. November 2025 aus.
+
+  pdate -i mo di 2025-10-02 2025-11-30
+    Gibt die Daten ohne Montage und Dienstage aus.
+
+  pdate -i mo di fr sa so -r 2025-10-02 2025-11-30
+    Gibt die Daten ohne Mo, Di, Fr, Sa, So in umgekehrter Reihenfolge aus.
+
+  pdate -f "{DD}.{MM}.{YYYY} ({wd})" 2025-10-02 2025-10-10
+    Gibt formatierte Daten wie 02.10.2025 (Do.) aus
+
+  pdate --count 20 -i sa so 2025-10-02
+    Gibt die nächsten 20 Werktage ab dem 2. Oktober 2025 aus.
+
+  pdate --count 20 --workdays-only --weekend fri-sat 2025-10-02
+    Gibt die nächsten 20 Werktage einer Woche mit Wochenende am Freitag und Samstag aus.
+
+  pdate --grid --workdays-only --week-start mo 2025-10-01 2025-10-31
+    Gibt die Werktage im Oktober 2025 als Kalender mit Wochen von Montag bis Sonntag aus.
+
+  pdate --count 12 --days 15,-1 2025-01-01
+    Gibt den 15. und den letzten Tag jedes Monats im ersten Halbjahr 2025 aus.
+
+  pdate --where 'weekday = fr and isLastWeekdayOfMonth' 2025-01-01 2025-12-31
+    Gibt den letzten Freitag jedes Monats im Jahr 2025 aus.
+
+  pdate --sample 10 --seed 42 2025-01-01 2025-12-31
+    Gibt 10 zufällige Daten aus 2025 aus, bei jedem Aufruf dieselben.
+
+  pdate 2025-08-18..2025-12-19 2026-01-05..2026-03-27 --minus 2025-10-06..2025-10-17
+    Gibt die Daten zweier Schulsemester ohne die Herbstferien aus.
+
+  git log --format=%as | pdate - -i sa su -f "{DD}.{MM}.{YYYY}"
+    Formatiert die Daten der Commits an Werktagen um.
+
+  pdate reformat --in "{YYYY}-{MM}-{DD}" -f "{D}. {MN} {YYYY}" -l de < CHANGELOG.md
+    Schreibt die Daten eines Changelogs auf Deutsch und lässt den übrigen Text unverändert.
+
+  pdate info 2025-12-25 --holidays holidays.txt
+    Gibt Wochentag, Woche, Tag des Jahres und Feiertage von Weihnachten 2025 aus und wie weit es entfernt ist.
+
+  pdate --step 15m 2025-10-02T08:00 2025-10-02T18:00
+    Gibt von 08:00 bis 18:00 alle 15 Minuten einen Zeitpunkt aus.
+
+  pdate --format-style strftime -f "%d.%m.%Y (%a)" 2025-10-02 2025-10-10
+    Gibt dieselben Daten mit einem strftime-Format aus.
+
+  pdate --calendar hebrew -f "{D} {MN} {YYYY}" 2025-09-23 2025-10-02
+    Gibt die Daten im hebräischen Kalender aus, wie 1 Tischri 5786
+
+  pdate -l zh --digits native -f "{YYYY}年{MN}{D}日" 2025-12-01 2025-12-07
+    Gibt die Daten mit chinesischen Zahlzeichen aus, wie 二〇二五年十二月一日
+`
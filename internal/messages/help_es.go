@@ -0,0 +1,209 @@
+package messages
+
+const helpES = `Uso:
+  pdate [-i <días-a-omitir>] [-f <formato>] [--format-style <estilo>] [-r] [--grid] [-l <idioma>] [--locale-file <archivo>] [--count <n>] [--tz <zona>] [--step <paso>] [--calendar <calendario>] [--digits <dígitos>] [--week-start <día>] [--weekend <perfil>] [--workdays-only] [--only <días>] [--days <lista>] [--months <lista>] [--weeks <lista>] [--doy <lista>] [--where <expresión>] [--holidays <archivo>] [--offset <n>] [--every <n>] [--first <n>] [--last <n>] [--sample <n> [--seed <semilla>]] [--union <periodos>] [--intersect <periodos>] [--minus <periodos>] [- | --stdin] [fecha-inicial] [fecha-final | <desde>..<hasta> ...]
+  pdate reformat --in <formato> [-f <formato>] [--format-style <estilo>] [-l <idioma>] [--calendar <calendario>] [--digits <dígitos>]
+  pdate info [--json] [-f <formato>] [-l <idioma>] [--holidays <archivo>] [fecha]
+
+Descripción:
+  Imprime las fechas desde <fecha-inicial> hasta <fecha-final> (o hasta hoy si falta la fecha final).
+  Opcionalmente se pueden omitir días de la semana, personalizar el formato o invertir el orden.
+  reformat lee un texto de la entrada estándar y sustituye las fechas del formato de --in por el formato de -f.
+  info imprime el día de la semana, la semana ISO, el día del año, el trimestre y los festivos de una fecha (o de hoy).
+
+Opciones:
+  [fecha-inicial]      Inicio del periodo (formato: YYYY-MM-DD, YYYY-MM-DDThh:mm o YYYY-MM-DDThh:mm:ss).
+  [fecha-final]        Fin opcional del periodo (mismos formatos). Por defecto hoy, o ahora para pasos de menos de un día.
+  [<desde>..<hasta> ...] Periodos en lugar de las fechas (p. ej., 2025-01-01..2025-01-31 2025-03-01..2025-03-15),
+                       las fechas se imprimen una vez y en orden.
+  -i <días>            Omitir días de la semana mediante códigos (p. ej., lu ma vi).
+  -f <formato>         Dar formato a cada fecha con marcadores (ver abajo).
+  --format-style <e>   Leer el formato de -f como placeholder (por defecto), strftime o go.
+  --in <formato>       Con reformat: el formato de las fechas del texto, en el mismo estilo que -f.
+                       Necesita un año, un mes y un día; los nombres se leen en el idioma de -l o en inglés.
+  --json               Con info: imprimir el registro como JSON.
+  --grid               Imprimir las fechas como un calendario de meses con las semanas de la configuración regional delante,
+                       no con -f, --calendar ni -r.
+  -r                   Imprimir las fechas en orden inverso.
+  -l <idioma>          Imprimir el formato en un idioma dado como etiqueta BCP 47 (p. ej., de, de-CH, pt-BR, zh-Hant),
+                       por defecto $LC_ALL, $LC_TIME o $LANG (p. ej., es_ES.UTF-8), si no en.
+                       El idioma se aplica también a esta ayuda, a los mensajes de error y a los códigos de -i.
+  --locale-file <a>    Usar los nombres de un archivo de configuración regional YAML (ver el README), -l aún puede elegir otro idioma.
+  --count <n>          Imprimir n fechas a partir de la fecha inicial (hacia atrás con -r), contadas después de -i.
+  --limit <n>          Igual que --count.
+  --step <paso>        Distancia entre dos fechas, un número seguido de s, m, h, d o w (p. ej., 15m). Por defecto 1d.
+                       Los pasos de semanas van del primer día de una semana al siguiente, 7d conserva el día de la semana de la fecha inicial.
+  --tz <zona>          Determinar hoy en una zona horaria (p. ej., Europe/Madrid), por defecto $TZ o la zona horaria del sistema.
+                       Los pasos de menos de un día siguen su horario de verano.
+  --calendar <cal>     Imprimir las fechas en otro calendario (ver abajo), por defecto gregorian.
+  --digits <dígitos>   Imprimir los números con dígitos nativos (native, p. ej., ar, fa, hi, th, zh, ja) o latinos (latin), por defecto native para ar y fa.
+  --week-start <día>   Primer día de la semana para {ww}, {gggg}, los pasos de semanas y --grid: mo, su o sa,
+                       por defecto el primer día de la configuración regional (p. ej., mo para es, su para en-US, sa para ar-EG).
+                       La semana 1 de mo es la semana ISO, la de su y sa la semana del 1 de enero.
+  --weekend <perfil>   Fin de semana que omite --workdays-only: sat-sun, fri-sat, fri o sun,
+                       por defecto el fin de semana de la configuración regional (p. ej., sat-sun para es, fri-sat para he y ar-SA).
+  --workdays-only      Omitir el fin de semana, junto con los días de -i.
+  --only <días>        Conservar solo estos días de la semana, con los códigos de -i (p. ej., lu mi).
+  --days <lista>       Conservar solo estos días del mes, los negativos cuentan desde el final (p. ej., 1,15,-1 o 1-7).
+  --months <lista>     Conservar solo estos meses como códigos, nombres del idioma o números (p. ej., jan,apr,jul,oct o nov-feb).
+  --weeks <lista>      Conservar solo estas semanas ISO (p. ej., 1-10).
+  --doy <lista>        Conservar solo estos días del año, los negativos cuentan desde el final (p. ej., 1,100,-1).
+                       Los filtros se pueden combinar, una fecha tiene que cumplirlos todos.
+  --where <expr>       Conservar solo las fechas para las que la expresión es verdadera (ver abajo).
+  --holidays <a>       Archivo con una fecha (YYYY-MM-DD) por línea para holiday en --where,
+                       seguida de un nombre opcional que imprime info.
+  --offset <n>         Saltar las primeras n fechas que pasan los filtros.
+  --every <n>          Conservar una de cada n fechas que pasan los filtros, a partir de --offset.
+  --first <n>          Conservar las primeras n fechas.
+  --last <n>           Conservar las últimas n fechas.
+  --sample <n>         Conservar n fechas elegidas al azar, en su orden.
+  --seed <semilla>     Elegir la misma muestra de --sample cada vez.
+                       Estas opciones se aplican en este orden después de los filtros y antes de -r.
+  --union <p>          Añadir las fechas de estos periodos.
+  --intersect <p>      Conservar solo las fechas dentro de estos periodos.
+  --minus <p>          Quitar las fechas dentro de estos periodos.
+                       Se aplican en este orden antes de los filtros y toman todos los periodos que les siguen.
+  -, --stdin           Leer las fechas y los periodos línea a línea de la entrada estándar.
+  -h, --help           Mostrar esta ayuda.
+  -v, --version        Mostrar la versión
+
+Códigos de días para -i (los códigos ingleses mo tu we th fr sa su y las abreviaturas del idioma también sirven):
+  lu  lunes
+  ma  martes
+  mi  miércoles
+  ju  jueves
+  vi  viernes
+  sa  sábado
+  do  domingo
+
+Marcadores para -f:
+  {YYYY}  Año completo (p. ej., 2025)
+  {YY}    Dos últimas cifras del año (p. ej., 25)
+  {MM}    Mes con cero inicial (p. ej., 12)
+  {M}     Mes sin cero inicial (p. ej., 12)
+  {DD}    Día con cero inicial (p. ej., 07)
+  {D}     Día sin cero inicial (p. ej., 7)
+  {MN}    Nombre completo del mes (p. ej., diciembre)
+  {MNg}   Nombre del mes dentro de una fecha, en genitivo en ruso (p. ej., декабря)
+  {mn}    Nombre abreviado del mes (p. ej., dic)
+  {mn1}   Nombre estrecho del mes (p. ej., D)
+  {WD}    Nombre completo del día (p. ej., domingo)
+  {wd}    Nombre abreviado del día (p. ej., dom)
+  {wd1}   Nombre estrecho del día (p. ej., D)
+  {WW}    Semana ISO con cero inicial (p. ej., 49)
+  {GGGG}  Año de la numeración ISO de semanas (p. ej., 2025)
+  {ww}    Semana de la configuración regional con cero inicial, la semana 1 contiene el 1 de enero en EE. UU. y el 4 de enero en casi toda Europa (p. ej., 49)
+  {gggg}  Año de la numeración de semanas de la configuración regional (p. ej., 2025)
+  {DOY}   Día del año con ceros iniciales (p. ej., 341)
+  {Q}     Trimestre del año (p. ej., 4)
+  {Do}    Día del mes como ordinal (p. ej., 7.º)
+  {U}     Marca de tiempo Unix en segundos, de la medianoche en la zona horaria para días enteros (p. ej., 1765065600)
+  {JDN}   Número de día juliano (p. ej., 2461017)
+  {N}     Día de la semana ISO, lunes = 1 (p. ej., 7)
+  {idx}   Posición en la salida, empezando por 1
+  {hh}    Hora (00-23)
+  {mm}    Minuto (00-59)
+  {ss}    Segundo (00-59)
+  {HH12}  Hora en formato de 12 horas (01-12)
+  {AMPM}  Antes o después del mediodía (p. ej., a. m.)
+  {Z}     Desfase de la zona horaria respecto a UTC (p. ej., +01:00)
+  {ERA}   Era japonesa o año sexagenario chino (p. ej., Reiwa)
+
+Modificadores de marcadores para -f (p. ej., {WD:len=2:upper}):
+  upper   Mayúsculas
+  lower   Minúsculas
+  title   Primera letra en mayúscula (p. ej., {WD:title} imprime Domingo con -l es)
+  padN    Rellenar hasta N caracteres (p. ej., {D:pad3} imprime 007)
+  len=N   Cortar el valor tras N caracteres (p. ej., {mn:len=2} imprime di)
+  Use {{ y }} para imprimir una { o } literal.
+
+Expresiones para --where (p. ej., 'weekday in (mo,fr) and day <= 7 and not holiday'):
+  Comparaciones  = != < <= > >= e in (...) o not in (...) con números, códigos (mo..su, jan..dec) y rangos (1..7, nov..feb)
+  Condiciones    and, or, not y paréntesis
+  Números        year month day weekday (mo = 1) week (ISO) quarter doy hour minute second daysInMonth nthWeekday
+  Pruebas        isFirstWeekdayOfMonth isLastWeekdayOfMonth isLastDayOfMonth isLeapYear weekend holiday
+
+Estilos de formato para --format-style:
+  placeholder  {YYYY}-{MM}-{DD} (por defecto)
+  strftime     %Y-%m-%d, admite %Y %y %m %-m %d %-d %a %A %b %h %B %F %D %j %V %G %u %q %s
+               %H %M %S %I %p %T %R %n %t %%
+  go           2006-01-02, admite 2006 06 01 1 02 2 002 Jan January Mon Monday 15 03 04 05 PM pm
+
+Calendarios para --calendar (cambian {YYYY} {YY} {MM} {M} {DD} {D} {Do} {MN} {mn}):
+  gregorian  Por defecto
+  julian     Calendario juliano proléptico
+  islamic    Calendario islámico tabular
+  hebrew     Calendario hebreo, meses numerados desde Tishri
+  persian    Calendario solar hégira
+  buddhist   Calendario solar tailandés
+  japanese   Meses gregorianos con el año de la era japonesa
+  chinese    Calendario lunisolar chino, {YYYY} es el año gregoriano en que empezó el año chino
+
+Idiomas para -l (añada una región para los nombres regionales, p. ej., de-AT, es-MX, fr-CA, ar-MA, zh-TW):
+  ar árabe      bg búlgaro    ca catalán    cs checo      da danés      de alemán
+  el griego     en inglés     es español    et estonio    fa persa      fi finés
+  fr francés    gsw suizo     he hebreo     hi hindi      hr croata     hu húngaro
+  id indonesio  it italiano   ja japonés    ko coreano    lt lituano    lv letón
+  nb noruego    nl neerlandés pl polaco     pt portugués  ro rumano     ru ruso
+  sk eslovaco   sl esloveno   sv sueco      th tailandés  tr turco      uk ucraniano
+  vi vietnamita zh chino      zh-Hant chino tradicional
+  ch se sigue aceptando para el alemán suizo.
+
+Ejemplos:
+  pdate 2025-10-02
+    Imprime todas las fechas desde el 2 de octubre de 2025 hasta hoy.
+
+  pdate 2025-10-02 2025-11-30
+    Imprime todas las fechas del 2 de octubre al 30 de noviembre de 2025.
+
+  pdate -i lu ma 2025-10-02 2025-11-30
+    Imprime las fechas sin los lunes ni los martes.
+
+  pdate -i lu ma vi sa do -r 2025-10-02 2025-11-30
+    Imprime las fechas sin lun, mar, vie, sáb, dom en orden inverso.
+
+  pdate -f "{DD}/{MM}/{YYYY} ({wd})" 2025-10-02 2025-10-10
+    Imprime fechas con formato como 02/10/2025 (jue)
+
+  pdate --count 20 -i sa do 2025-10-02
+    Imprime los próximos 20 días laborables a partir del 2 de octubre de 2025.
+
+  pdate --count 20 --workdays-only --weekend fri-sat 2025-10-02
+    Imprime los próximos 20 días laborables de una semana cuyo fin de semana cae en viernes y sábado.
+
+  pdate --grid --workdays-only --week-start mo 2025-10-01 2025-10-31
+    Imprime los días laborables de octubre de 2025 como un calendario con semanas de lunes a domingo.
+
+  pdate --count 12 --days 15,-1 2025-01-01
+    Imprime el día 15 y el último día de cada mes del primer semestre de 2025.
+
+  pdate --where 'weekday = fr and isLastWeekdayOfMonth' 2025-01-01 2025-12-31
+    Imprime el último viernes de cada mes de 2025.
+
+  pdate --sample 10 --seed 42 2025-01-01 2025-12-31
+    Imprime 10 fechas al azar de 2025, las mismas en cada ejecución.
+
+  pdate 2025-08-18..2025-12-19 2026-01-05..2026-03-27 --minus 2025-10-06..2025-10-17
+    Imprime las fechas de dos trimestres escolares sin las vacaciones de otoño.
+
+  git log --format=%as | pdate - -i sa do -f "{DD}/{MM}/{YYYY}"
+    Da nuevo formato a las fechas de los commits hechos en días laborables.
+
+  pdate reformat --in "{YYYY}-{MM}-{DD}" -f "{D} de {MN} de {YYYY}" -l es < CHANGELOG.md
+    Escribe las fechas de un registro de cambios en español y deja el resto del texto como está.
+
+  pdate info 2025-12-25 --holidays festivos.txt
+    Imprime el día de la semana, la semana, el día del año y los festivos de la Navidad de 2025 y cuánto falta.
+
+  pdate --step 15m 2025-10-02T08:00 2025-10-02T18:00
+    Imprime una franja horaria cada 15 minutos de 08:00 a 18:00.
+
+  pdate --format-style strftime -f "%d/%m/%Y (%a)" 2025-10-02 2025-10-10
+    Imprime las mismas fechas con una cadena de formato strftime.
+
+  pdate --calendar hebrew -f "{D} {MN} {YYYY}" 2025-09-23 2025-10-02
+    Imprime las fechas en el calendario hebreo, como 1 tishri 5786
+
+  pdate -l zh --digits native -f "{YYYY}年{MN}{D}日" 2025-12-01 2025-12-07
+    Imprime las fechas con números chinos, como 二〇二五年十二月一日
+`
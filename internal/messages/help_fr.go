@@ -0,0 +1,209 @@
+package messages
+
+const helpFR = `Utilisation :
+  pdate [-i <jours-à-ignorer>] [-f <format>] [--format-style <style>] [-r] [--grid] [-l <langue>] [--locale-file <fichier>] [--count <n>] [--tz <zone>] [--step <pas>] [--calendar <calendrier>] [--digits <chiffres>] [--week-start <jour>] [--weekend <profil>] [--workdays-only] [--only <jours>] [--days <liste>] [--months <liste>] [--weeks <liste>] [--doy <liste>] [--where <expression>] [--holidays <fichier>] [--offset <n>] [--every <n>] [--first <n>] [--last <n>] [--sample <n> [--seed <graine>]] [--union <périodes>] [--intersect <périodes>] [--minus <périodes>] [- | --stdin] [date-début] [date-fin | <de>..<à> ...]
+  pdate reformat --in <format> [-f <format>] [--format-style <style>] [-l <langue>] [--calendar <calendrier>] [--digits <chiffres>]
+  pdate info [--json] [-f <format>] [-l <langue>] [--holidays <fichier>] [date]
+
+Description :
+  Affiche les dates de <date-début> à <date-fin> (ou jusqu'à aujourd'hui si date-fin est omise).
+  Vous pouvez ignorer certains jours de la semaine, personnaliser le format ou inverser l'ordre.
+  reformat lit un texte sur l'entrée standard et remplace les dates au format de --in par le format de -f.
+  info affiche le jour de la semaine, la semaine ISO, le jour de l'année, le trimestre et les jours fériés d'une date (ou d'aujourd'hui).
+
+Options :
+  [date-début]         Début de la période (format : YYYY-MM-DD, YYYY-MM-DDThh:mm ou YYYY-MM-DDThh:mm:ss).
+  [date-fin]           Fin facultative de la période (mêmes formats). Par défaut aujourd'hui, ou maintenant pour les pas de moins d'un jour.
+  [<de>..<à> ...]      Des périodes au lieu des dates (p. ex. 2025-01-01..2025-01-31 2025-03-01..2025-03-15),
+                       les dates sont affichées une fois et dans l'ordre.
+  -i <jours>           Ignorer certains jours de la semaine avec des codes (p. ex. lu ma ve).
+  -f <format>          Formater chaque date avec des espaces réservés (voir ci-dessous).
+  --format-style <s>   Lire le format de -f comme placeholder (par défaut), strftime ou go.
+  --in <format>        Avec reformat : le format des dates dans le texte, dans le même style que -f.
+                       Il lui faut une année, un mois et un jour ; les noms sont lus dans la langue de -l ou en anglais.
+  --json               Avec info : afficher la fiche en JSON.
+  --grid               Afficher les dates comme un calendrier des mois, précédées des semaines de la locale,
+                       pas avec -f, --calendar ou -r.
+  -r                   Afficher les dates dans l'ordre inverse.
+  -l <langue>          Afficher le format dans une langue donnée par une étiquette BCP 47 (p. ex. de, de-CH, pt-BR, zh-Hant),
+                       par défaut $LC_ALL, $LC_TIME ou $LANG (p. ex. fr_CH.UTF-8), sinon en.
+                       La langue s'applique aussi à l'aide, aux messages d'erreur et aux codes de -i.
+  --locale-file <f>    Utiliser les noms d'un fichier de locale YAML (voir le README), -l peut tout de même choisir une autre langue.
+  --count <n>          Afficher n dates à partir de date-début (à rebours avec -r), comptées après -i.
+  --limit <n>          Comme --count.
+  --step <pas>         Écart entre deux dates, un nombre suivi de s, m, h, d ou w (p. ex. 15m). Par défaut 1d.
+                       Les pas en semaines vont du premier jour d'une semaine au suivant, 7d garde le jour de date-début.
+  --tz <zone>          Déterminer aujourd'hui dans un fuseau horaire (p. ex. Europe/Zurich), par défaut $TZ ou le fuseau du système.
+                       Les pas de moins d'un jour suivent son heure d'été.
+  --calendar <cal>     Afficher les dates dans un autre calendrier (voir ci-dessous), par défaut gregorian.
+  --digits <chiffres>  Afficher les nombres en chiffres native (p. ex. ar, fa, hi, th, zh, ja) ou latin, par défaut native pour ar et fa.
+  --week-start <jour>  Premier jour de la semaine pour {ww}, {gggg}, les pas en semaines et --grid : lu, di ou sa,
+                       par défaut le premier jour de la locale (p. ex. lu pour fr, di pour en-US, sa pour ar-EG).
+                       La semaine 1 est celle de l'ISO pour lu, celle du 1er janvier pour di et sa.
+  --weekend <profil>   Week-end que --workdays-only laisse de côté : sat-sun, fri-sat, fri ou sun,
+                       par défaut le week-end de la locale (p. ex. sat-sun pour fr, fri-sat pour he et ar-SA).
+  --workdays-only      Laisser de côté le week-end, en plus des jours de -i.
+  --only <jours>       Garder seulement ces jours de la semaine, avec les codes de -i (p. ex. lu me).
+  --days <liste>       Garder seulement ces jours du mois, les négatifs comptent depuis la fin (p. ex. 1,15,-1 ou 1-7).
+  --months <liste>     Garder seulement ces mois, en codes, noms de la langue ou nombres (p. ex. janv.,avr.,juil.,oct. ou nov-feb).
+  --weeks <liste>      Garder seulement ces semaines ISO (p. ex. 1-10).
+  --doy <liste>        Garder seulement ces jours de l'année, les négatifs comptent depuis la fin (p. ex. 1,100,-1).
+                       Les filtres se combinent, une date doit les satisfaire tous.
+  --where <expr>       Garder seulement les dates pour lesquelles l'expression est vraie (voir ci-dessous).
+  --holidays <f>       Fichier avec une date (YYYY-MM-DD) par ligne pour holiday dans --where,
+                       suivie d'un nom facultatif qu'affiche info.
+  --offset <n>         Sauter les n premières dates après les filtres.
+  --every <n>          Garder une date sur n après les filtres, à partir de --offset.
+  --first <n>          Garder les n premières dates.
+  --last <n>           Garder les n dernières dates.
+  --sample <n>         Garder n dates tirées au hasard, dans leur ordre.
+  --seed <graine>      Tirer le même échantillon de --sample à chaque fois.
+                       Ces options s'appliquent dans cet ordre après les filtres et avant -r.
+  --union <p>          Ajouter les dates de ces périodes.
+  --intersect <p>      Garder seulement les dates comprises dans ces périodes.
+  --minus <p>          Retirer les dates comprises dans ces périodes.
+                       Elles s'appliquent dans cet ordre avant les filtres et prennent toutes les périodes qui suivent.
+  -, --stdin           Lire les dates et les périodes ligne par ligne sur l'entrée standard.
+  -h, --help           Afficher cette aide.
+  -v, --version        Afficher la version
+
+Codes des jours pour -i (les codes anglais mo tu we th fr sa su et les abréviations de la langue fonctionnent aussi) :
+  lu  lundi
+  ma  mardi
+  me  mercredi
+  je  jeudi
+  ve  vendredi
+  sa  samedi
+  di  dimanche
+
+Espaces réservés pour -f :
+  {YYYY}  Année complète (p. ex. 2025)
+  {YY}    Deux derniers chiffres de l'année (p. ex. 25)
+  {MM}    Mois avec zéro initial (p. ex. 12)
+  {M}     Mois sans zéro initial (p. ex. 12)
+  {DD}    Jour avec zéro initial (p. ex. 07)
+  {D}     Jour sans zéro initial (p. ex. 7)
+  {MN}    Nom complet du mois (p. ex. décembre)
+  {MNg}   Nom du mois dans une date, au génitif en russe (p. ex. декабря)
+  {mn}    Nom abrégé du mois (p. ex. déc.)
+  {mn1}   Nom étroit du mois (p. ex. D)
+  {WD}    Nom complet du jour (p. ex. dimanche)
+  {wd}    Nom abrégé du jour (p. ex. dim.)
+  {wd1}   Nom étroit du jour (p. ex. D)
+  {WW}    Semaine ISO avec zéro initial (p. ex. 49)
+  {GGGG}  Année de la numérotation ISO des semaines (p. ex. 2025)
+  {ww}    Semaine de la locale avec zéro initial, la semaine 1 contient le 1er janvier aux États-Unis et le 4 janvier dans la plupart de l'Europe (p. ex. 49)
+  {gggg}  Année de la numérotation des semaines de la locale (p. ex. 2025)
+  {DOY}   Jour de l'année avec zéros initiaux (p. ex. 341)
+  {Q}     Trimestre de l'année (p. ex. 4)
+  {Do}    Jour du mois en ordinal (p. ex. 1er)
+  {U}     Horodatage Unix en secondes, de minuit dans le fuseau pour les jours entiers (p. ex. 1765065600)
+  {JDN}   Jour julien (p. ex. 2461017)
+  {N}     Jour ISO de la semaine, lundi = 1 (p. ex. 7)
+  {idx}   Position dans la sortie, à partir de 1
+  {hh}    Heure (00-23)
+  {mm}    Minute (00-59)
+  {ss}    Seconde (00-59)
+  {HH12}  Heure sur 12 heures (01-12)
+  {AMPM}  Avant ou après midi (p. ex. AM)
+  {Z}     Décalage du fuseau horaire par rapport à UTC (p. ex. +01:00)
+  {ERA}   Ère japonaise ou année sexagésimale chinoise (p. ex. Reiwa)
+
+Modificateurs des espaces réservés pour -f (p. ex. {WD:len=2:upper}) :
+  upper   Majuscules
+  lower   Minuscules
+  title   Première lettre en majuscule (p. ex. {WD:title} affiche Dimanche avec -l fr)
+  padN    Compléter à N caractères (p. ex. {D:pad3} affiche 007)
+  len=N   Couper la valeur après N caractères (p. ex. {mn:len=2} affiche dé)
+  {{ et }} affichent un { ou un }.
+
+Expressions pour --where (p. ex. 'weekday in (mo,fr) and day <= 7 and not holiday') :
+  Comparaisons = != < <= > >= et in (...) ou not in (...) avec des nombres, des codes (mo..su, jan..dec) et des plages (1..7, nov..feb)
+  Conditions   and, or, not et parenthèses
+  Nombres      year month day weekday (mo = 1) week (ISO) quarter doy hour minute second daysInMonth nthWeekday
+  Tests        isFirstWeekdayOfMonth isLastWeekdayOfMonth isLastDayOfMonth isLeapYear weekend holiday
+
+Styles de format pour --format-style :
+  placeholder  {YYYY}-{MM}-{DD} (par défaut)
+  strftime     %Y-%m-%d, prend en charge %Y %y %m %-m %d %-d %a %A %b %h %B %F %D %j %V %G %u %q %s
+               %H %M %S %I %p %T %R %n %t %%
+  go           2006-01-02, prend en charge 2006 06 01 1 02 2 002 Jan January Mon Monday 15 03 04 05 PM pm
+
+Calendriers pour --calendar (changent {YYYY} {YY} {MM} {M} {DD} {D} {Do} {MN} {mn}) :
+  gregorian  Par défaut
+  julian     Calendrier julien proleptique
+  islamic    Calendrier musulman tabulaire
+  hebrew     Calendrier hébraïque, mois comptés à partir de Tichri
+  persian    Calendrier persan solaire
+  buddhist   Calendrier solaire thaï
+  japanese   Mois grégoriens avec l'année de l'ère japonaise
+  chinese    Calendrier luni-solaire chinois, {YYYY} est l'année grégorienne où l'année chinoise a commencé
+
+Langues pour -l (ajoutez une région pour les noms régionaux, p. ex. de-AT, es-MX, fr-CA, ar-MA, zh-TW) :
+  ar arabe      bg bulgare    ca catalan    cs tchèque    da danois     de allemand
+  el grec       en anglais    es espagnol   et estonien   fa persan     fi finnois
+  fr français   gsw suisse allemand he hébreu hi hindi    hr croate     hu hongrois
+  id indonésien it italien    ja japonais   ko coréen     lt lituanien  lv letton
+  nb norvégien  nl néerlandais pl polonais  pt portugais  ro roumain    ru russe
+  sk slovaque   sl slovène    sv suédois    th thaï       tr turc       uk ukrainien
+  vi vietnamien zh chinois    zh-Hant chinois traditionnel
+  ch est toujours accepté pour le suisse allemand.
+
+Exemples :
+  pdate 2025-10-02
+    Affiche toutes les dates du 2 octobre 2025 à aujourd'hui.
+
+  pdate 2025-10-02 2025-11-30
+    Affiche toutes les dates du 2 octobre au 30 novembre 2025.
+
+  pdate -i lu ma 2025-10-02 2025-11-30
+    Affiche les dates sans les lundis et les mardis.
+
+  pdate -i lu ma ve sa di -r 2025-10-02 2025-11-30
+    Affiche les dates sans lun., mar., ven., sam., dim. dans l'ordre inverse.
+
+  pdate -f "{DD}.{MM}.{YYYY} ({wd})" 2025-10-02 2025-10-10
+    Affiche des dates formatées comme 02.10.2025 (jeu.)
+
+  pdate --count 20 -i sa di 2025-10-02
+    Affiche les 20 prochains jours ouvrés à partir du 2 octobre 2025.
+
+  pdate --count 20 --workdays-only --weekend fri-sat 2025-10-02
+    Affiche les 20 prochains jours ouvrés d'une semaine dont le week-end tombe le vendredi et le samedi.
+
+  pdate --grid --workdays-only --week-start mo 2025-10-01 2025-10-31
+    Affiche les jours ouvrés d'octobre 2025 comme un calendrier avec des semaines du lundi au dimanche.
+
+  pdate --count 12 --days 15,-1 2025-01-01
+    Affiche le 15 et le dernier jour de chaque mois du premier semestre 2025.
+
+  pdate --where 'weekday = fr and isLastWeekdayOfMonth' 2025-01-01 2025-12-31
+    Affiche le dernier vendredi de chaque mois de 2025.
+
+  pdate --sample 10 --seed 42 2025-01-01 2025-12-31
+    Affiche 10 dates aléatoires de 2025, les mêmes à chaque exécution.
+
+  pdate 2025-08-18..2025-12-19 2026-01-05..2026-03-27 --minus 2025-10-06..2025-10-17
+    Affiche les dates de deux trimestres scolaires sans les vacances d'automne.
+
+  git log --format=%as | pdate - -i sa su -f "{DD}.{MM}.{YYYY}"
+    Reformate les dates des commits faits les jours ouvrés.
+
+  pdate reformat --in "{YYYY}-{MM}-{DD}" -f "{D}. {MN} {YYYY}" -l de < CHANGELOG.md
+    Écrit les dates d'un changelog en allemand et laisse le reste du texte tel quel.
+
+  pdate info 2025-12-25 --holidays holidays.txt
+    Affiche le jour de la semaine, la semaine, le jour de l'année et les jours fériés de Noël 2025 et dans combien de temps il tombe.
+
+  pdate --step 15m 2025-10-02T08:00 2025-10-02T18:00
+    Affiche un créneau toutes les 15 minutes de 08:00 à 18:00.
+
+  pdate --format-style strftime -f "%d.%m.%Y (%a)" 2025-10-02 2025-10-10
+    Affiche les mêmes dates avec un format strftime.
+
+  pdate --calendar hebrew -f "{D} {MN} {YYYY}" 2025-09-23 2025-10-02
+    Affiche les dates dans le calendrier hébraïque, comme 1 Tichri 5786
+
+  pdate -l zh --digits native -f "{YYYY}年{MN}{D}日" 2025-12-01 2025-12-07
+    Affiche les dates en chiffres chinois, comme 二〇二五年十二月一日
+`
@@ -0,0 +1,209 @@
+package messages
+
+const helpHI = `उपयोग:
+  pdate [-i <छोड़े-जाने-वाले-दिन>] [-f <प्रारूप>] [--format-style <शैली>] [-r] [--grid] [-l <भाषा>] [--locale-file <फ़ाइल>] [--count <n>] [--tz <समय-क्षेत्र>] [--step <अंतराल>] [--calendar <कैलेंडर>] [--digits <अंक>] [--week-start <दिन>] [--weekend <प्रकार>] [--workdays-only] [--only <दिन>] [--days <सूची>] [--months <सूची>] [--weeks <सूची>] [--doy <सूची>] [--where <व्यंजक>] [--holidays <फ़ाइल>] [--offset <n>] [--every <n>] [--first <n>] [--last <n>] [--sample <n> [--seed <बीज>]] [--union <अवधियाँ>] [--intersect <अवधियाँ>] [--minus <अवधियाँ>] [- | --stdin] [आरंभ-तिथि] [अंतिम-तिथि | <से>..<तक> ...]
+  pdate reformat --in <प्रारूप> [-f <प्रारूप>] [--format-style <शैली>] [-l <भाषा>] [--calendar <कैलेंडर>] [--digits <अंक>]
+  pdate info [--json] [-f <प्रारूप>] [-l <भाषा>] [--holidays <फ़ाइल>] [तिथि]
+
+विवरण:
+  <आरंभ-तिथि> से <अंतिम-तिथि> तक की तिथियाँ छापता है (अंतिम तिथि न दी हो तो आज तक)।
+  चाहें तो सप्ताह के कुछ दिन छोड़े जा सकते हैं, प्रारूप बदला जा सकता है या क्रम उलटा किया जा सकता है।
+  reformat मानक इनपुट से पाठ पढ़ता है और --in प्रारूप की तिथियों को -f प्रारूप से बदल देता है।
+  info किसी तिथि (या आज) का वार, ISO सप्ताह, वर्ष का दिन, तिमाही और छुट्टियाँ छापता है।
+
+विकल्प:
+  [आरंभ-तिथि]          अवधि का आरंभ (प्रारूप: YYYY-MM-DD, YYYY-MM-DDThh:mm या YYYY-MM-DDThh:mm:ss)।
+  [अंतिम-तिथि]         अवधि का वैकल्पिक अंत (वही प्रारूप)। डिफ़ॉल्ट आज, और एक दिन से छोटे अंतराल के लिए अभी।
+  [<से>..<तक> ...]     तिथियों की जगह अवधियाँ (जैसे 2025-01-01..2025-01-31 2025-03-01..2025-03-15),
+                       तिथियाँ एक-एक बार और क्रम से छापी जाती हैं।
+  -i <दिन>             कोड से सप्ताह के दिन छोड़ें (जैसे mo tu fr या सोम मंगल शुक्र)।
+  -f <प्रारूप>         हर तिथि को प्लेसहोल्डर से प्रारूपित करें (नीचे देखें)।
+  --format-style <s>   -f के प्रारूप को placeholder (डिफ़ॉल्ट), strftime या go के रूप में पढ़ें।
+  --in <प्रारूप>       reformat के साथ: पाठ में तिथियों का प्रारूप, -f वाली शैली में।
+                       इसमें वर्ष, महीना और दिन चाहिए; नाम -l की भाषा में या अंग्रेज़ी में पढ़े जाते हैं।
+  --json               info के साथ: रिकॉर्ड को JSON में छापें।
+  --grid               तिथियों को महीनों के कैलेंडर के रूप में छापें, आगे लोकेल के सप्ताह के साथ,
+                       -f, --calendar या -r के साथ नहीं।
+  -r                   तिथियों को उल्टे क्रम में छापें।
+  -l <भाषा>            प्रारूप को BCP 47 टैग से दी गई भाषा में छापें (जैसे de, de-CH, pt-BR, zh-Hant),
+                       डिफ़ॉल्ट $LC_ALL, $LC_TIME या $LANG (जैसे hi_IN.UTF-8), वरना en।
+                       भाषा इस सहायता, त्रुटि संदेशों और -i के कोड पर भी लागू होती है।
+  --locale-file <f>    YAML लोकेल फ़ाइल के नाम इस्तेमाल करें (README देखें), -l फिर भी दूसरी भाषा चुन सकता है।
+  --count <n>          आरंभ-तिथि से n तिथियाँ छापें (-r के साथ पीछे की ओर), -i के बाद गिनी हुई।
+  --limit <n>          --count के समान।
+  --step <अंतराल>      दो तिथियों के बीच की दूरी, एक संख्या जिसके बाद s, m, h, d या w हो (जैसे 15m)। डिफ़ॉल्ट 1d।
+                       सप्ताह के अंतराल एक सप्ताह के पहले दिन से अगले सप्ताह के पहले दिन तक जाते हैं, 7d आरंभ-तिथि का वार बनाए रखता है।
+  --tz <क्षेत्र>       आज को किसी समय क्षेत्र में तय करें (जैसे Asia/Kolkata), डिफ़ॉल्ट $TZ या सिस्टम का समय क्षेत्र।
+                       एक दिन से छोटे अंतराल उसके डेलाइट सेविंग टाइम का पालन करते हैं।
+  --calendar <cal>     तिथियों को दूसरे कैलेंडर में छापें (नीचे देखें), डिफ़ॉल्ट gregorian।
+  --digits <अंक>       संख्याओं को स्थानीय अंकों (native, जैसे ar, fa, hi, th, zh, ja) या लैटिन अंकों (latin) में छापें, ar और fa के लिए डिफ़ॉल्ट native।
+  --week-start <दिन>   {ww}, {gggg}, सप्ताह के अंतराल और --grid के लिए सप्ताह का पहला दिन: mo, su या sa,
+                       डिफ़ॉल्ट लोकेल का पहला दिन (जैसे hi और en-US के लिए su, de के लिए mo, ar-EG के लिए sa)।
+                       mo का सप्ताह 1 ISO सप्ताह है, su और sa का वह सप्ताह जिसमें 1 जनवरी हो।
+  --weekend <प्रकार>   --workdays-only द्वारा छोड़ा जाने वाला सप्ताहांत: sat-sun, fri-sat, fri या sun,
+                       डिफ़ॉल्ट लोकेल का सप्ताहांत (जैसे hi के लिए sun, he और ar-SA के लिए fri-sat)।
+  --workdays-only      -i के दिनों के साथ सप्ताहांत भी छोड़ें।
+  --only <दिन>         -i के कोड से केवल ये वार रखें (जैसे सोम बुध)।
+  --days <सूची>        महीने के केवल ये दिन रखें, ऋणात्मक अंत से गिने जाते हैं (जैसे 1,15,-1 या 1-7)।
+  --months <सूची>      केवल ये महीने रखें, कोड, भाषा के नाम या संख्याओं के रूप में (जैसे jan,apr,jul,oct या nov-feb)।
+  --weeks <सूची>       केवल ये ISO सप्ताह रखें (जैसे 1-10)।
+  --doy <सूची>         वर्ष के केवल ये दिन रखें, ऋणात्मक अंत से गिने जाते हैं (जैसे 1,100,-1)।
+                       फ़िल्टर मिलाए जा सकते हैं, तिथि को सभी पर खरा उतरना होगा।
+  --where <व्यंजक>     केवल वे तिथियाँ रखें जिनके लिए व्यंजक सत्य हो (नीचे देखें)।
+  --holidays <फ़ाइल>   --where में holiday के लिए हर पंक्ति में एक तिथि (YYYY-MM-DD) वाली फ़ाइल,
+                       जिसके बाद एक वैकल्पिक नाम हो सकता है जिसे info छापता है।
+  --offset <n>         फ़िल्टर से गुज़रने वाली पहली n तिथियाँ छोड़ें।
+  --every <n>          फ़िल्टर से गुज़रने वाली हर n-वीं तिथि रखें, --offset के बाद से।
+  --first <n>          पहली n तिथियाँ रखें।
+  --last <n>           आख़िरी n तिथियाँ रखें।
+  --sample <n>         यादृच्छिक रूप से चुनी गई n तिथियाँ उनके क्रम में रखें।
+  --seed <बीज>         हर बार वही --sample चुनें।
+                       ये विकल्प फ़िल्टर के बाद और -r से पहले इसी क्रम में लागू होते हैं।
+  --union <अवधियाँ>    इन अवधियों की तिथियाँ जोड़ें।
+  --intersect <अवधियाँ> केवल इन अवधियों के भीतर की तिथियाँ रखें।
+  --minus <अवधियाँ>    इन अवधियों के भीतर की तिथियाँ हटाएँ।
+                       ये फ़िल्टर से पहले इसी क्रम में लागू होते हैं और अपने बाद की सभी अवधियाँ लेते हैं।
+  -, --stdin           मानक इनपुट से तिथियाँ और अवधियाँ पंक्ति-दर-पंक्ति पढ़ें।
+  -h, --help           यह सहायता दिखाएँ।
+  -v, --version        संस्करण दिखाएँ
+
+-i के लिए दिनों के कोड (भाषा के संक्षिप्त नाम भी चलते हैं, जैसे सोम मंगल बुध):
+  mo  सोमवार
+  tu  मंगलवार
+  we  बुधवार
+  th  गुरुवार
+  fr  शुक्रवार
+  sa  शनिवार
+  su  रविवार
+
+-f के लिए प्लेसहोल्डर:
+  {YYYY}  पूरा वर्ष (जैसे 2025)
+  {YY}    वर्ष के अंतिम दो अंक (जैसे 25)
+  {MM}    आगे शून्य के साथ महीना (जैसे 12)
+  {M}     बिना शून्य के महीना (जैसे 12)
+  {DD}    आगे शून्य के साथ दिन (जैसे 07)
+  {D}     बिना शून्य के दिन (जैसे 7)
+  {MN}    महीने का पूरा नाम (जैसे दिसंबर)
+  {MNg}   तिथि के भीतर महीने का नाम, रूसी में संबंध कारक में (जैसे декабря)
+  {mn}    महीने का संक्षिप्त नाम (जैसे दिस॰)
+  {mn1}   महीने का सबसे छोटा नाम (जैसे दि)
+  {WD}    वार का पूरा नाम (जैसे रविवार)
+  {wd}    वार का संक्षिप्त नाम (जैसे रवि)
+  {wd1}   वार का सबसे छोटा नाम (जैसे र)
+  {WW}    आगे शून्य के साथ ISO सप्ताह (जैसे 49)
+  {GGGG}  ISO सप्ताह क्रमांकन का वर्ष (जैसे 2025)
+  {ww}    आगे शून्य के साथ लोकेल का सप्ताह, सप्ताह 1 में अमेरिका में 1 जनवरी और अधिकांश यूरोप में 4 जनवरी होती है (जैसे 50)
+  {gggg}  लोकेल के सप्ताह क्रमांकन का वर्ष (जैसे 2025)
+  {DOY}   आगे शून्यों के साथ वर्ष का दिन (जैसे 341)
+  {Q}     वर्ष की तिमाही (जैसे 4)
+  {Do}    क्रमसूचक रूप में महीने का दिन, हिंदी में बिना प्रत्यय (जैसे 7)
+  {U}     सेकंड में Unix टाइमस्टैम्प, पूरे दिनों के लिए समय क्षेत्र की आधी रात का (जैसे 1765065600)
+  {JDN}   जूलियन दिन संख्या (जैसे 2461017)
+  {N}     ISO वार, सोमवार = 1 (जैसे 7)
+  {idx}   आउटपुट में स्थान, 1 से शुरू
+  {hh}    घंटा (00-23)
+  {mm}    मिनट (00-59)
+  {ss}    सेकंड (00-59)
+  {HH12}  12 घंटे की घड़ी में घंटा (01-12)
+  {AMPM}  दोपहर से पहले या बाद (जैसे am)
+  {Z}     UTC से समय क्षेत्र का अंतर (जैसे +05:30)
+  {ERA}   जापानी युग या चीनी षष्टिवर्षीय वर्ष (जैसे Reiwa)
+
+-f के प्लेसहोल्डर के संशोधक (जैसे {WD:len=2:upper}):
+  upper   बड़े अक्षर
+  lower   छोटे अक्षर
+  title   पहला अक्षर बड़ा (जैसे -l fr के साथ {WD:title} Dimanche छापता है)
+  padN    N वर्णों तक भरें (जैसे {D:pad3} 007 छापता है)
+  len=N   N वर्णों के बाद मान काटें (जैसे {mn:len=2} दि छापता है)
+  { या } छापने के लिए {{ और }} का प्रयोग करें।
+
+--where के लिए व्यंजक (जैसे 'weekday in (mo,fr) and day <= 7 and not holiday'):
+  तुलना     = != < <= > >= और in (...) या not in (...), संख्याओं, कोड (mo..su, jan..dec) और परिसरों (1..7, nov..feb) के साथ
+  शर्तें    and, or, not और कोष्ठक
+  संख्याएँ  year month day weekday (mo = 1) week (ISO) quarter doy hour minute second daysInMonth nthWeekday
+  जाँचें    isFirstWeekdayOfMonth isLastWeekdayOfMonth isLastDayOfMonth isLeapYear weekend holiday
+
+--format-style के लिए प्रारूप शैलियाँ:
+  placeholder  {YYYY}-{MM}-{DD} (डिफ़ॉल्ट)
+  strftime     %Y-%m-%d, समर्थित %Y %y %m %-m %d %-d %a %A %b %h %B %F %D %j %V %G %u %q %s
+               %H %M %S %I %p %T %R %n %t %%
+  go           2006-01-02, समर्थित 2006 06 01 1 02 2 002 Jan January Mon Monday 15 03 04 05 PM pm
+
+--calendar के लिए कैलेंडर ({YYYY} {YY} {MM} {M} {DD} {D} {Do} {MN} {mn} बदलते हैं):
+  gregorian  डिफ़ॉल्ट
+  julian     प्रोलेप्टिक जूलियन कैलेंडर
+  islamic    सारणीबद्ध इस्लामी कैलेंडर
+  hebrew     हिब्रू कैलेंडर, महीने तिशरी से गिने जाते हैं
+  persian    सौर हिजरी कैलेंडर
+  buddhist   थाई सौर कैलेंडर
+  japanese   ग्रेगोरियन महीने, जापानी युग के वर्ष के साथ
+  chinese    चीनी चांद्र-सौर कैलेंडर, {YYYY} वह ग्रेगोरियन वर्ष है जिसमें चीनी वर्ष शुरू हुआ
+
+-l के लिए भाषाएँ (क्षेत्रीय नामों के लिए क्षेत्र जोड़ें, जैसे de-AT, es-MX, fr-CA, ar-MA, zh-TW):
+  ar अरबी       bg बल्गेरियाई ca कातालान   cs चेक        da डेनिश      de जर्मन
+  el यूनानी     en अंग्रेज़ी   es स्पेनिश    et एस्टोनियाई fa फ़ारसी     fi फ़िनिश
+  fr फ़्रेंच     gsw स्विस     he हिब्रू     hi हिंदी      hr क्रोएशियाई hu हंगेरियाई
+  id इंडोनेशियाई it इतालवी   ja जापानी     ko कोरियाई    lt लिथुआनियाई lv लातवियाई
+  nb नॉर्वेजियाई nl डच        pl पोलिश      pt पुर्तगाली  ro रोमानियाई  ru रूसी
+  sk स्लोवाक    sl स्लोवेनियाई sv स्वीडिश   th थाई        tr तुर्की     uk यूक्रेनियाई
+  vi वियतनामी   zh चीनी       zh-Hant पारंपरिक चीनी
+  स्विस जर्मन के लिए ch अब भी स्वीकार किया जाता है।
+
+उदाहरण:
+  pdate 2025-10-02
+    2 अक्टूबर 2025 से आज तक की सभी तिथियाँ छापता है।
+
+  pdate 2025-10-02 2025-11-30
+    2 अक्टूबर से 30 नवंबर 2025 तक की सभी तिथियाँ छापता है।
+
+  pdate -i सोम मंगल 2025-10-02 2025-11-30
+    सोमवार और मंगलवार को छोड़कर तिथियाँ छापता है।
+
+  pdate -i सोम मंगल शुक्र शनि रवि -r 2025-10-02 2025-11-30
+    सोम, मंगल, शुक्र, शनि, रवि को छोड़कर तिथियाँ उल्टे क्रम में छापता है।
+
+  pdate -f "{DD}-{MM}-{YYYY} ({wd})" 2025-10-02 2025-10-10
+    02-10-2025 (गुरु) जैसी प्रारूपित तिथियाँ छापता है।
+
+  pdate --count 20 -i शनि रवि 2025-10-02
+    2 अक्टूबर 2025 से अगले 20 कार्यदिवस छापता है।
+
+  pdate --count 20 --workdays-only --weekend fri-sat 2025-10-02
+    शुक्रवार और शनिवार के सप्ताहांत वाले सप्ताह के अगले 20 कार्यदिवस छापता है।
+
+  pdate --grid --workdays-only --week-start mo 2025-10-01 2025-10-31
+    अक्टूबर 2025 के कार्यदिवसों को सोमवार से रविवार तक के सप्ताहों वाले कैलेंडर के रूप में छापता है।
+
+  pdate --count 12 --days 15,-1 2025-01-01
+    2025 की पहली छमाही के हर महीने की 15 तारीख़ और अंतिम दिन छापता है।
+
+  pdate --where 'weekday = fr and isLastWeekdayOfMonth' 2025-01-01 2025-12-31
+    2025 के हर महीने का अंतिम शुक्रवार छापता है।
+
+  pdate --sample 10 --seed 42 2025-01-01 2025-12-31
+    2025 की 10 यादृच्छिक तिथियाँ छापता है, हर बार वही।
+
+  pdate 2025-08-18..2025-12-19 2026-01-05..2026-03-27 --minus 2025-10-06..2025-10-17
+    शरद की छुट्टियों को छोड़कर दो स्कूल सत्रों की तिथियाँ छापता है।
+
+  git log --format=%as | pdate - -i शनि रवि -f "{DD}-{MM}-{YYYY}"
+    कार्यदिवसों पर किए गए कमिट की तिथियों को फिर से प्रारूपित करता है।
+
+  pdate reformat --in "{YYYY}-{MM}-{DD}" -f "{D} {MN} {YYYY}" -l hi < CHANGELOG.md
+    चेंजलॉग की तिथियाँ हिंदी में लिखता है और बाकी पाठ जैसा है वैसा छोड़ देता है।
+
+  pdate info 2025-12-25 --holidays holidays.txt
+    क्रिसमस 2025 का वार, सप्ताह, वर्ष का दिन और छुट्टियाँ छापता है, और वह कितनी दूर है।
+
+  pdate --step 15m 2025-10-02T08:00 2025-10-02T18:00
+    08:00 से 18:00 तक हर 15 मिनट पर एक समय-खंड छापता है।
+
+  pdate --format-style strftime -f "%d-%m-%Y (%a)" 2025-10-02 2025-10-10
+    वही तिथियाँ strftime प्रारूप स्ट्रिंग से छापता है।
+
+  pdate --calendar hebrew -f "{D} {MN} {YYYY}" 2025-09-23 2025-10-02
+    तिथियों को हिब्रू कैलेंडर में छापता है, जैसे 1 तिशरी 5786
+
+  pdate --digits native -f "{D} {MN} {YYYY}" 2025-12-01 2025-12-07
+    तिथियों को देवनागरी अंकों में छापता है, जैसे १ दिसंबर २०२५
+`
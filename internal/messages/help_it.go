@@ -0,0 +1,209 @@
+package messages
+
+const helpIT = `Uso:
+  pdate [-i <giorni-da-escludere>] [-f <formato>] [--format-style <stile>] [-r] [--grid] [-l <lingua>] [--locale-file <file>] [--count <n>] [--tz <zona>] [--step <passo>] [--calendar <calendario>] [--digits <cifre>] [--week-start <giorno>] [--weekend <profilo>] [--workdays-only] [--only <giorni>] [--days <elenco>] [--months <elenco>] [--weeks <elenco>] [--doy <elenco>] [--where <espressione>] [--holidays <file>] [--offset <n>] [--every <n>] [--first <n>] [--last <n>] [--sample <n> [--seed <seme>]] [--union <periodi>] [--intersect <periodi>] [--minus <periodi>] [- | --stdin] [data-iniziale] [data-finale | <da>..<a> ...]
+  pdate reformat --in <formato> [-f <formato>] [--format-style <stile>] [-l <lingua>] [--calendar <calendario>] [--digits <cifre>]
+  pdate info [--json] [-f <formato>] [-l <lingua>] [--holidays <file>] [data]
+
+Descrizione:
+  Stampa le date da <data-iniziale> a <data-finale> (o fino a oggi se manca la data finale).
+  Si possono escludere giorni della settimana, personalizzare il formato o invertire l'ordine.
+  reformat legge un testo dall'input standard e sostituisce le date nel formato di --in con il formato di -f.
+  info stampa il giorno della settimana, la settimana ISO, il giorno dell'anno, il trimestre e le festività di una data (o di oggi).
+
+Opzioni:
+  [data-iniziale]      Inizio del periodo (formato: YYYY-MM-DD, YYYY-MM-DDThh:mm o YYYY-MM-DDThh:mm:ss).
+  [data-finale]        Fine facoltativa del periodo (stessi formati). Predefinita oggi, o adesso per passi inferiori a un giorno.
+  [<da>..<a> ...]      Periodi al posto delle date (ad es. 2025-01-01..2025-01-31 2025-03-01..2025-03-15),
+                       le date vengono stampate una volta e in ordine.
+  -i <giorni>          Escludere giorni della settimana tramite codici (ad es. lu ma ve).
+  -f <formato>         Formattare ogni data con segnaposto (vedi sotto).
+  --format-style <s>   Leggere il formato di -f come placeholder (predefinito), strftime o go.
+  --in <formato>       Con reformat: il formato delle date nel testo, nello stesso stile di -f.
+                       Servono un anno, un mese e un giorno; i nomi vengono letti nella lingua di -l o in inglese.
+  --json               Con info: stampare il record come JSON.
+  --grid               Stampare le date come calendario dei mesi con le settimane della localizzazione davanti,
+                       non con -f, --calendar o -r.
+  -r                   Stampare le date in ordine inverso.
+  -l <lingua>          Stampare il formato in una lingua indicata come tag BCP 47 (ad es. de, de-CH, pt-BR, zh-Hant),
+                       predefinita $LC_ALL, $LC_TIME o $LANG (ad es. it_IT.UTF-8), altrimenti en.
+                       La lingua vale anche per questo aiuto, per i messaggi di errore e per i codici di -i.
+  --locale-file <f>    Usare i nomi di un file di localizzazione YAML (vedi il README), -l può comunque scegliere un'altra lingua.
+  --count <n>          Stampare n date a partire dalla data iniziale (all'indietro con -r), contate dopo -i.
+  --limit <n>          Come --count.
+  --step <passo>       Distanza tra due date, un numero seguito da s, m, h, d o w (ad es. 15m). Predefinito 1d.
+                       I passi di settimane vanno dal primo giorno di una settimana al successivo, 7d mantiene il giorno della settimana della data iniziale.
+  --tz <zona>          Determinare oggi in un fuso orario (ad es. Europe/Rome), predefinito $TZ o il fuso orario del sistema.
+                       I passi inferiori a un giorno seguono la sua ora legale.
+  --calendar <cal>     Stampare le date in un altro calendario (vedi sotto), predefinito gregorian.
+  --digits <cifre>     Stampare i numeri con cifre native (native, ad es. ar, fa, hi, th, zh, ja) o latine (latin), predefinito native per ar e fa.
+  --week-start <g>     Primo giorno della settimana per {ww}, {gggg}, i passi di settimane e --grid: mo, su o sa,
+                       predefinito il primo giorno della localizzazione (ad es. mo per it, su per en-US, sa per ar-EG).
+                       La settimana 1 di mo è la settimana ISO, quella di su e sa la settimana del 1° gennaio.
+  --weekend <profilo>  Fine settimana escluso da --workdays-only: sat-sun, fri-sat, fri o sun,
+                       predefinito il fine settimana della localizzazione (ad es. sat-sun per it, fri-sat per he e ar-SA).
+  --workdays-only      Escludere il fine settimana, insieme ai giorni di -i.
+  --only <giorni>      Tenere solo questi giorni della settimana, con i codici di -i (ad es. lu me).
+  --days <elenco>      Tenere solo questi giorni del mese, quelli negativi contano dalla fine (ad es. 1,15,-1 o 1-7).
+  --months <elenco>    Tenere solo questi mesi come codici, nomi della lingua o numeri (ad es. jan,apr,jul,oct o nov-feb).
+  --weeks <elenco>     Tenere solo queste settimane ISO (ad es. 1-10).
+  --doy <elenco>       Tenere solo questi giorni dell'anno, quelli negativi contano dalla fine (ad es. 1,100,-1).
+                       I filtri si possono combinare, una data deve soddisfarli tutti.
+  --where <espr>       Tenere solo le date per cui l'espressione è vera (vedi sotto).
+  --holidays <file>    File con una data (YYYY-MM-DD) per riga per holiday in --where,
+                       seguita da un nome facoltativo che info stampa.
+  --offset <n>         Saltare le prime n date che passano i filtri.
+  --every <n>          Tenere una data ogni n tra quelle che passano i filtri, a partire da --offset.
+  --first <n>          Tenere le prime n date.
+  --last <n>           Tenere le ultime n date.
+  --sample <n>         Tenere n date estratte a caso, nel loro ordine.
+  --seed <seme>        Estrarre lo stesso campione di --sample ogni volta.
+                       Queste opzioni si applicano in quest'ordine dopo i filtri e prima di -r.
+  --union <p>          Aggiungere le date di questi periodi.
+  --intersect <p>      Tenere solo le date all'interno di questi periodi.
+  --minus <p>          Togliere le date all'interno di questi periodi.
+                       Si applicano in quest'ordine prima dei filtri e prendono tutti i periodi che li seguono.
+  -, --stdin           Leggere le date e i periodi riga per riga dall'input standard.
+  -h, --help           Mostrare questo aiuto.
+  -v, --version        Mostrare la versione
+
+Codici dei giorni per -i (valgono anche i codici inglesi mo tu we th fr sa su e le abbreviazioni della lingua):
+  lu  lunedì
+  ma  martedì
+  me  mercoledì
+  gi  giovedì
+  ve  venerdì
+  sa  sabato
+  do  domenica
+
+Segnaposto per -f:
+  {YYYY}  Anno completo (ad es. 2025)
+  {YY}    Ultime due cifre dell'anno (ad es. 25)
+  {MM}    Mese con zero iniziale (ad es. 12)
+  {M}     Mese senza zero iniziale (ad es. 12)
+  {DD}    Giorno con zero iniziale (ad es. 07)
+  {D}     Giorno senza zero iniziale (ad es. 7)
+  {MN}    Nome completo del mese (ad es. dicembre)
+  {MNg}   Nome del mese all'interno di una data, al genitivo in russo (ad es. декабря)
+  {mn}    Nome abbreviato del mese (ad es. dic)
+  {mn1}   Nome stretto del mese (ad es. D)
+  {WD}    Nome completo del giorno (ad es. domenica)
+  {wd}    Nome abbreviato del giorno (ad es. dom)
+  {wd1}   Nome stretto del giorno (ad es. D)
+  {WW}    Settimana ISO con zero iniziale (ad es. 49)
+  {GGGG}  Anno della numerazione ISO delle settimane (ad es. 2025)
+  {ww}    Settimana della localizzazione con zero iniziale, la settimana 1 contiene il 1° gennaio negli Stati Uniti e il 4 gennaio in gran parte dell'Europa (ad es. 49)
+  {gggg}  Anno della numerazione delle settimane della localizzazione (ad es. 2025)
+  {DOY}   Giorno dell'anno con zeri iniziali (ad es. 341)
+  {Q}     Trimestre dell'anno (ad es. 4)
+  {Do}    Giorno del mese come ordinale, in italiano solo il giorno 1 (ad es. 1º)
+  {U}     Timestamp Unix in secondi, della mezzanotte nel fuso orario per i giorni interi (ad es. 1765065600)
+  {JDN}   Numero del giorno giuliano (ad es. 2461017)
+  {N}     Giorno della settimana ISO, lunedì = 1 (ad es. 7)
+  {idx}   Posizione nell'output, a partire da 1
+  {hh}    Ora (00-23)
+  {mm}    Minuto (00-59)
+  {ss}    Secondo (00-59)
+  {HH12}  Ora nel formato a 12 ore (01-12)
+  {AMPM}  Prima o dopo mezzogiorno (ad es. AM)
+  {Z}     Scarto del fuso orario da UTC (ad es. +01:00)
+  {ERA}   Era giapponese o anno sessagenario cinese (ad es. Reiwa)
+
+Modificatori dei segnaposto per -f (ad es. {WD:len=2:upper}):
+  upper   Maiuscolo
+  lower   Minuscolo
+  title   Prima lettera maiuscola (ad es. {WD:title} stampa Domenica con -l it)
+  padN    Riempire fino a N caratteri (ad es. {D:pad3} stampa 007)
+  len=N   Tagliare il valore dopo N caratteri (ad es. {mn:len=2} stampa di)
+  Usare {{ e }} per stampare una { o } letterale.
+
+Espressioni per --where (ad es. 'weekday in (mo,fr) and day <= 7 and not holiday'):
+  Confronti    = != < <= > >= e in (...) o not in (...) con numeri, codici (mo..su, jan..dec) e intervalli (1..7, nov..feb)
+  Condizioni   and, or, not e parentesi
+  Numeri       year month day weekday (mo = 1) week (ISO) quarter doy hour minute second daysInMonth nthWeekday
+  Test         isFirstWeekdayOfMonth isLastWeekdayOfMonth isLastDayOfMonth isLeapYear weekend holiday
+
+Stili di formato per --format-style:
+  placeholder  {YYYY}-{MM}-{DD} (predefinito)
+  strftime     %Y-%m-%d, supporta %Y %y %m %-m %d %-d %a %A %b %h %B %F %D %j %V %G %u %q %s
+               %H %M %S %I %p %T %R %n %t %%
+  go           2006-01-02, supporta 2006 06 01 1 02 2 002 Jan January Mon Monday 15 03 04 05 PM pm
+
+Calendari per --calendar (cambiano {YYYY} {YY} {MM} {M} {DD} {D} {Do} {MN} {mn}):
+  gregorian  Predefinito
+  julian     Calendario giuliano prolettico
+  islamic    Calendario islamico tabulare
+  hebrew     Calendario ebraico, mesi numerati da Tishri
+  persian    Calendario solare dell'Egira
+  buddhist   Calendario solare thailandese
+  japanese   Mesi gregoriani con l'anno dell'era giapponese
+  chinese    Calendario lunisolare cinese, {YYYY} è l'anno gregoriano in cui è iniziato l'anno cinese
+
+Lingue per -l (aggiungere una regione per i nomi regionali, ad es. de-AT, es-MX, fr-CA, ar-MA, zh-TW):
+  ar arabo      bg bulgaro    ca catalano   cs ceco       da danese     de tedesco
+  el greco      en inglese    es spagnolo   et estone     fa persiano   fi finlandese
+  fr francese   gsw svizzero  he ebraico    hi hindi      hr croato     hu ungherese
+  id indonesiano it italiano  ja giapponese ko coreano    lt lituano    lv lettone
+  nb norvegese  nl olandese   pl polacco    pt portoghese ro rumeno     ru russo
+  sk slovacco   sl sloveno    sv svedese    th thailandese tr turco     uk ucraino
+  vi vietnamita zh cinese     zh-Hant cinese tradizionale
+  ch è ancora accettato per lo svizzero tedesco.
+
+Esempi:
+  pdate 2025-10-02
+    Stampa tutte le date dal 2 ottobre 2025 a oggi.
+
+  pdate 2025-10-02 2025-11-30
+    Stampa tutte le date dal 2 ottobre al 30 novembre 2025.
+
+  pdate -i lu ma 2025-10-02 2025-11-30
+    Stampa le date esclusi i lunedì e i martedì.
+
+  pdate -i lu ma ve sa do -r 2025-10-02 2025-11-30
+    Stampa le date esclusi lun, mar, ven, sab, dom in ordine inverso.
+
+  pdate -f "{DD}/{MM}/{YYYY} ({wd})" 2025-10-02 2025-10-10
+    Stampa date formattate come 02/10/2025 (gio)
+
+  pdate --count 20 -i sa do 2025-10-02
+    Stampa i prossimi 20 giorni lavorativi a partire dal 2 ottobre 2025.
+
+  pdate --count 20 --workdays-only --weekend fri-sat 2025-10-02
+    Stampa i prossimi 20 giorni lavorativi di una settimana con il fine settimana di venerdì e sabato.
+
+  pdate --grid --workdays-only --week-start mo 2025-10-01 2025-10-31
+    Stampa i giorni lavorativi di ottobre 2025 come calendario con settimane dal lunedì alla domenica.
+
+  pdate --count 12 --days 15,-1 2025-01-01
+    Stampa il 15 e l'ultimo giorno di ogni mese per la prima metà del 2025.
+
+  pdate --where 'weekday = fr and isLastWeekdayOfMonth' 2025-01-01 2025-12-31
+    Stampa l'ultimo venerdì di ogni mese del 2025.
+
+  pdate --sample 10 --seed 42 2025-01-01 2025-12-31
+    Stampa 10 date casuali del 2025, le stesse a ogni esecuzione.
+
+  pdate 2025-08-18..2025-12-19 2026-01-05..2026-03-27 --minus 2025-10-06..2025-10-17
+    Stampa le date di due periodi scolastici senza le vacanze autunnali.
+
+  git log --format=%as | pdate - -i sa do -f "{DD}/{MM}/{YYYY}"
+    Riformatta le date dei commit fatti nei giorni lavorativi.
+
+  pdate reformat --in "{YYYY}-{MM}-{DD}" -f "{D} {MN} {YYYY}" -l it < CHANGELOG.md
+    Scrive le date di un registro delle modifiche in italiano e lascia il resto del testo com'è.
+
+  pdate info 2025-12-25 --holidays festivita.txt
+    Stampa il giorno della settimana, la settimana, il giorno dell'anno e le festività del Natale 2025 e quanto manca.
+
+  pdate --step 15m 2025-10-02T08:00 2025-10-02T18:00
+    Stampa una fascia oraria ogni 15 minuti dalle 08:00 alle 18:00.
+
+  pdate --format-style strftime -f "%d/%m/%Y (%a)" 2025-10-02 2025-10-10
+    Stampa le stesse date con una stringa di formato strftime.
+
+  pdate --calendar hebrew -f "{D} {MN} {YYYY}" 2025-09-23 2025-10-02
+    Stampa le date nel calendario ebraico, come 1 tishri 5786
+
+  pdate -l zh --digits native -f "{YYYY}年{MN}{D}日" 2025-12-01 2025-12-07
+    Stampa le date con numeri cinesi, come 二〇二五年十二月一日
+`
@@ -0,0 +1,209 @@
+package messages
+
+const helpJA = `使い方:
+  pdate [-i <除外する曜日>] [-f <書式>] [--format-style <スタイル>] [-r] [--grid] [-l <言語>] [--locale-file <ファイル>] [--count <n>] [--tz <タイムゾーン>] [--step <間隔>] [--calendar <暦>] [--digits <数字>] [--week-start <曜日>] [--weekend <種類>] [--workdays-only] [--only <曜日>] [--days <リスト>] [--months <リスト>] [--weeks <リスト>] [--doy <リスト>] [--where <式>] [--holidays <ファイル>] [--offset <n>] [--every <n>] [--first <n>] [--last <n>] [--sample <n> [--seed <シード>]] [--union <期間>] [--intersect <期間>] [--minus <期間>] [- | --stdin] [開始日] [終了日 | <開始>..<終了> ...]
+  pdate reformat --in <書式> [-f <書式>] [--format-style <スタイル>] [-l <言語>] [--calendar <暦>] [--digits <数字>]
+  pdate info [--json] [-f <書式>] [-l <言語>] [--holidays <ファイル>] [日付]
+
+説明:
+  <開始日> から <終了日> まで（終了日を省略した場合は今日まで）の日付を出力します。
+  特定の曜日を除外したり、書式を変えたり、逆順に出力したりできます。
+  reformat は標準入力からテキストを読み、--in の書式の日付を -f の書式に置き換えます。
+  info は日付（または今日）の曜日、ISO 週、年間通算日、四半期、祝日を出力します。
+
+オプション:
+  [開始日]             期間の開始（書式: YYYY-MM-DD、YYYY-MM-DDThh:mm または YYYY-MM-DDThh:mm:ss）。
+  [終了日]             期間の終了（同じ書式）、省略可。既定は今日、1 日未満の間隔では現在時刻。
+  [<開始>..<終了> ...] 日付の代わりに期間を指定（例: 2025-01-01..2025-01-31 2025-03-01..2025-03-15）、
+                       日付は重複なく順に出力されます。
+  -i <曜日>            コードで曜日を除外（例: mo tu fr または 月 火 金）。
+  -f <書式>            各日付をプレースホルダーで整形（下記参照）。
+  --format-style <s>   -f の書式を placeholder（既定）、strftime または go として解釈。
+  --in <書式>          reformat で使用: テキスト中の日付の書式、-f と同じスタイル。
+                       年・月・日が必要です。名前は -l の言語または英語で読み取ります。
+  --json               info で使用: レコードを JSON で出力。
+  --grid               日付を月ごとのカレンダーとして、ロケールの週番号を先頭に付けて出力、
+                       -f、--calendar、-r とは併用不可。
+  -r                   日付を逆順に出力。
+  -l <言語>            BCP 47 タグで指定した言語で出力（例: de、de-CH、pt-BR、zh-Hant）、
+                       既定は $LC_ALL、$LC_TIME または $LANG（例: ja_JP.UTF-8）、それ以外は en。
+                       言語はこのヘルプ、エラーメッセージ、-i のコードにも適用されます。
+  --locale-file <f>    YAML ロケールファイルの名前を使用（README 参照）、-l で別の言語を選ぶこともできます。
+  --count <n>          開始日から n 件の日付を出力（-r では過去へ）、-i の後に数えます。
+  --limit <n>          --count と同じ。
+  --step <間隔>        2 つの日付の間隔、数値の後に s、m、h、d または w（例: 15m）。既定は 1d。
+                       週単位の間隔は週の最初の日から次の週の最初の日へ進み、7d は開始日の曜日を保ちます。
+  --tz <タイムゾーン>  今日をタイムゾーンで決定（例: Asia/Tokyo）、既定は $TZ またはシステムのタイムゾーン。
+                       1 日未満の間隔はその夏時間に従います。
+  --calendar <暦>      日付を別の暦で出力（下記参照）、既定は gregorian。
+  --digits <数字>      数字を固有の数字（native、例: ar、fa、hi、th、zh、ja）またはラテン数字（latin）で出力、ar と fa の既定は native。
+  --week-start <曜日>  {ww}、{gggg}、週単位の間隔、--grid で使う週の最初の日: mo、su または sa、
+                       既定はロケールの最初の日（例: ja と en-US は su、de は mo、ar-EG は sa）。
+                       mo の第 1 週は ISO 週、su と sa の第 1 週は 1 月 1 日を含む週です。
+  --weekend <種類>     --workdays-only が除外する週末: sat-sun、fri-sat、fri または sun、
+                       既定はロケールの週末（例: ja は sat-sun、he と ar-SA は fri-sat）。
+  --workdays-only      週末を -i の曜日と合わせて除外。
+  --only <曜日>        -i と同じコードで、これらの曜日だけを残す（例: 月 水）。
+  --days <リスト>      月のこれらの日だけを残す、負の数は月末から数える（例: 1,15,-1 または 1-7）。
+  --months <リスト>    これらの月だけを残す、コード、言語の名前または数字で指定（例: jan,apr,jul,oct または nov-feb）。
+  --weeks <リスト>     これらの ISO 週だけを残す（例: 1-10）。
+  --doy <リスト>       年のこれらの日だけを残す、負の数は年末から数える（例: 1,100,-1）。
+                       フィルターは組み合わせられ、日付はそのすべてに合う必要があります。
+  --where <式>         式が真になる日付だけを残す（下記参照）。
+  --holidays <f>       --where の holiday 用に 1 行に 1 つの日付（YYYY-MM-DD）を書いたファイル、
+                       日付の後に info が出力する名前を書くこともできます。
+  --offset <n>         フィルターを通った最初の n 件を飛ばす。
+  --every <n>          フィルターを通った日付を n 件ごとに 1 件残す、--offset の後から。
+  --first <n>          最初の n 件を残す。
+  --last <n>           最後の n 件を残す。
+  --sample <n>         無作為に選んだ n 件を元の順で残す。
+  --seed <シード>      毎回同じ --sample を選ぶ。
+                       これらのオプションはフィルターの後、-r の前にこの順で適用されます。
+  --union <期間>       これらの期間の日付を加える。
+  --intersect <期間>   これらの期間内の日付だけを残す。
+  --minus <期間>       これらの期間内の日付を取り除く。
+                       これらはフィルターの前にこの順で適用され、後に続くすべての期間を受け取ります。
+  -, --stdin           標準入力から日付と期間を 1 行ずつ読む。
+  -h, --help           このヘルプを表示。
+  -v, --version        バージョンを表示
+
+-i の曜日コード（言語の略称も使えます。例: 月 火 水）:
+  mo  月曜日
+  tu  火曜日
+  we  水曜日
+  th  木曜日
+  fr  金曜日
+  sa  土曜日
+  su  日曜日
+
+-f のプレースホルダー:
+  {YYYY}  4 桁の年（例: 2025）
+  {YY}    年の下 2 桁（例: 25）
+  {MM}    ゼロ埋めした月（例: 12）
+  {M}     ゼロ埋めしない月（例: 12）
+  {DD}    ゼロ埋めした日（例: 07）
+  {D}     ゼロ埋めしない日（例: 7）
+  {MN}    月の名前（例: 12月）
+  {MNg}   日付の中の月の名前、ロシア語では属格（例: декабря）
+  {mn}    月の略称（例: 12月）
+  {mn1}   月の最短の名前（例: 12）
+  {WD}    曜日の名前（例: 日曜日）
+  {wd}    曜日の略称（例: 日）
+  {wd1}   曜日の最短の名前（例: 日）
+  {WW}    ゼロ埋めした ISO 週（例: 49）
+  {GGGG}  ISO 週番号の年（例: 2025）
+  {ww}    ゼロ埋めしたロケールの週、第 1 週は米国では 1 月 1 日、ヨーロッパの大部分では 1 月 4 日を含む（例: 50）
+  {gggg}  ロケールの週番号の年（例: 2025）
+  {DOY}   ゼロ埋めした年間通算日（例: 341）
+  {Q}     四半期（例: 4）
+  {Do}    序数の日（例: 7日）
+  {U}     Unix 時間（秒）、日単位ではタイムゾーンの午前 0 時（例: 1765065600）
+  {JDN}   ユリウス通日（例: 2461017）
+  {N}     ISO の曜日番号、月曜日 = 1（例: 7）
+  {idx}   出力中の位置、1 から
+  {hh}    時（00-23）
+  {mm}    分（00-59）
+  {ss}    秒（00-59）
+  {HH12}  12 時間制の時（01-12）
+  {AMPM}  午前または午後（例: 午前）
+  {Z}     タイムゾーンの UTC からのオフセット（例: +09:00）
+  {ERA}   日本の元号または中国の干支（例: 令和）
+
+-f のプレースホルダー修飾子（例: {WD:len=2:upper}）:
+  upper   大文字
+  lower   小文字
+  title   先頭を大文字（例: -l fr では {WD:title} が Dimanche を出力）
+  padN    N 文字まで埋める（例: {D:pad3} は 007 を出力）
+  len=N   値を N 文字で切る（例: {WD:len=1} は 日 を出力）
+  { や } そのものを出力するには {{ と }} を使います。
+
+--where の式（例: 'weekday in (mo,fr) and day <= 7 and not holiday'）:
+  比較      = != < <= > >= と in (...) または not in (...)、数値、コード（mo..su、jan..dec）、範囲（1..7、nov..feb）
+  条件      and、or、not と括弧
+  数値      year month day weekday (mo = 1) week (ISO) quarter doy hour minute second daysInMonth nthWeekday
+  判定      isFirstWeekdayOfMonth isLastWeekdayOfMonth isLastDayOfMonth isLeapYear weekend holiday
+
+--format-style の書式スタイル:
+  placeholder  {YYYY}-{MM}-{DD}（既定）
+  strftime     %Y-%m-%d、対応: %Y %y %m %-m %d %-d %a %A %b %h %B %F %D %j %V %G %u %q %s
+               %H %M %S %I %p %T %R %n %t %%
+  go           2006-01-02、対応: 2006 06 01 1 02 2 002 Jan January Mon Monday 15 03 04 05 PM pm
+
+--calendar の暦（{YYYY} {YY} {MM} {M} {DD} {D} {Do} {MN} {mn} が変わります）:
+  gregorian  既定、グレゴリオ暦
+  julian     先発ユリウス暦
+  islamic    計算上のイスラム暦
+  hebrew     ユダヤ暦、月はティシュリーから数えます
+  persian    イラン太陽暦
+  buddhist   タイ太陽暦
+  japanese   グレゴリオ暦の月と和暦の年
+  chinese    中国の太陰太陽暦、{YYYY} は旧暦の年が始まったグレゴリオ暦の年
+
+-l の言語（地域の名前には地域を付けます。例: de-AT、es-MX、fr-CA、ar-MA、zh-TW）:
+  ar アラビア語 bg ブルガリア語 ca カタルーニャ語 cs チェコ語 da デンマーク語 de ドイツ語
+  el ギリシャ語 en 英語       es スペイン語 et エストニア語 fa ペルシャ語 fi フィンランド語
+  fr フランス語 gsw スイスドイツ語 he ヘブライ語 hi ヒンディー語 hr クロアチア語 hu ハンガリー語
+  id インドネシア語 it イタリア語 ja 日本語 ko 韓国語     lt リトアニア語 lv ラトビア語
+  nb ノルウェー語 nl オランダ語 pl ポーランド語 pt ポルトガル語 ro ルーマニア語 ru ロシア語
+  sk スロバキア語 sl スロベニア語 sv スウェーデン語 th タイ語 tr トルコ語   uk ウクライナ語
+  vi ベトナム語 zh 中国語     zh-Hant 繁体字中国語
+  スイスドイツ語には ch も引き続き使えます。
+
+例:
+  pdate 2025-10-02
+    2025 年 10 月 2 日から今日までのすべての日付を出力します。
+
+  pdate 2025-10-02 2025-11-30
+    2025 年 10 月 2 日から 11 月 30 日までのすべての日付を出力します。
+
+  pdate -i 月 火 2025-10-02 2025-11-30
+    月曜日と火曜日を除いた日付を出力します。
+
+  pdate -i 月 火 金 土 日 -r 2025-10-02 2025-11-30
+    月・火・金・土・日を除いた日付を逆順に出力します。
+
+  pdate -f "{YYYY}年{M}月{D}日 ({wd})" 2025-10-02 2025-10-10
+    2025年10月2日 (木) のように整形した日付を出力します。
+
+  pdate --count 20 -i 土 日 2025-10-02
+    2025 年 10 月 2 日からの 20 営業日を出力します。
+
+  pdate --count 20 --workdays-only --weekend fri-sat 2025-10-02
+    金曜日と土曜日が週末の週で、次の 20 営業日を出力します。
+
+  pdate --grid --workdays-only --week-start mo 2025-10-01 2025-10-31
+    2025 年 10 月の営業日を月曜日から日曜日までの週のカレンダーとして出力します。
+
+  pdate --count 12 --days 15,-1 2025-01-01
+    2025 年上半期の各月の 15 日と末日を出力します。
+
+  pdate --where 'weekday = fr and isLastWeekdayOfMonth' 2025-01-01 2025-12-31
+    2025 年の各月の最終金曜日を出力します。
+
+  pdate --sample 10 --seed 42 2025-01-01 2025-12-31
+    2025 年の日付を無作為に 10 件、毎回同じものを出力します。
+
+  pdate 2025-08-18..2025-12-19 2026-01-05..2026-03-27 --minus 2025-10-06..2025-10-17
+    2 つの学期の日付を秋休みを除いて出力します。
+
+  git log --format=%as | pdate - -i 土 日 -f "{YYYY}年{M}月{D}日"
+    平日に行われたコミットの日付を整形し直します。
+
+  pdate reformat --in "{YYYY}-{MM}-{DD}" -f "{YYYY}年{M}月{D}日" -l ja < CHANGELOG.md
+    変更履歴の日付を日本語の書式で書き、残りのテキストはそのままにします。
+
+  pdate info 2025-12-25 --holidays holidays.txt
+    2025 年のクリスマスの曜日、週、年間通算日、祝日と、あと何日かを出力します。
+
+  pdate --step 15m 2025-10-02T08:00 2025-10-02T18:00
+    08:00 から 18:00 まで 15 分ごとの時間枠を出力します。
+
+  pdate --format-style strftime -f "%Y/%m/%d (%a)" 2025-10-02 2025-10-10
+    同じ日付を strftime の書式文字列で出力します。
+
+  pdate --calendar japanese -f "{ERA}{YYYY}年{M}月{D}日 ({wd})" 2025-10-02 2025-10-10
+    日付を和暦で出力します。例: 令和7年10月2日 (木)
+
+  pdate -l zh --digits native -f "{YYYY}年{MN}{D}日" 2025-12-01 2025-12-07
+    日付を漢数字で出力します。例: 二〇二五年十二月一日
+`
@@ -0,0 +1,209 @@
+package messages
+
+const helpNL = `Gebruik:
+  pdate [-i <over-te-slaan-dagen>] [-f <formaat>] [--format-style <stijl>] [-r] [--grid] [-l <taal>] [--locale-file <bestand>] [--count <n>] [--tz <zone>] [--step <stap>] [--calendar <kalender>] [--digits <cijfers>] [--week-start <dag>] [--weekend <profiel>] [--workdays-only] [--only <dagen>] [--days <lijst>] [--months <lijst>] [--weeks <lijst>] [--doy <lijst>] [--where <expressie>] [--holidays <bestand>] [--offset <n>] [--every <n>] [--first <n>] [--last <n>] [--sample <n> [--seed <seed>]] [--union <perioden>] [--intersect <perioden>] [--minus <perioden>] [- | --stdin] [begindatum] [einddatum | <van>..<tot> ...]
+  pdate reformat --in <formaat> [-f <formaat>] [--format-style <stijl>] [-l <taal>] [--calendar <kalender>] [--digits <cijfers>]
+  pdate info [--json] [-f <formaat>] [-l <taal>] [--holidays <bestand>] [datum]
+
+Beschrijving:
+  Drukt de datums af van <begindatum> tot <einddatum> (of tot vandaag als de einddatum ontbreekt).
+  Desgewenst kunnen weekdagen worden overgeslagen, kan het formaat worden aangepast of de volgorde worden omgedraaid.
+  reformat leest een tekst van de standaardinvoer en vervangt de datums in het formaat van --in door het formaat van -f.
+  info drukt de weekdag, de ISO-week, de dag van het jaar, het kwartaal en de feestdagen van een datum (of van vandaag) af.
+
+Opties:
+  [begindatum]         Begin van de periode (formaat: YYYY-MM-DD, YYYY-MM-DDThh:mm of YYYY-MM-DDThh:mm:ss).
+  [einddatum]          Optioneel einde van de periode (zelfde formaten). Standaard vandaag, of nu bij stappen korter dan een dag.
+  [<van>..<tot> ...]   Perioden in plaats van de datums (bijv. 2025-01-01..2025-01-31 2025-03-01..2025-03-15),
+                       de datums worden één keer en op volgorde afgedrukt.
+  -i <dagen>           Weekdagen overslaan met codes (bijv. ma di vr).
+  -f <formaat>         Elke datum opmaken met plaatsaanduidingen (zie hieronder).
+  --format-style <s>   Het formaat van -f lezen als placeholder (standaard), strftime of go.
+  --in <formaat>       Met reformat: het formaat van de datums in de tekst, in dezelfde stijl als -f.
+                       Het heeft een jaar, een maand en een dag nodig; namen worden gelezen in de taal van -l of in het Engels.
+  --json               Met info: het record als JSON afdrukken.
+  --grid               De datums afdrukken als een kalender van maanden met de weken van de locale ervoor,
+                       niet met -f, --calendar of -r.
+  -r                   De datums in omgekeerde volgorde afdrukken.
+  -l <taal>            Het formaat afdrukken in een taal gegeven als BCP 47-tag (bijv. de, de-CH, pt-BR, zh-Hant),
+                       standaard $LC_ALL, $LC_TIME of $LANG (bijv. nl_NL.UTF-8), anders en.
+                       De taal geldt ook voor deze hulp, de foutmeldingen en de codes van -i.
+  --locale-file <b>    De namen van een YAML-localebestand gebruiken (zie de README), -l kan nog een andere taal kiezen.
+  --count <n>          n datums vanaf de begindatum afdrukken (achteruit met -r), geteld na -i.
+  --limit <n>          Hetzelfde als --count.
+  --step <stap>        Afstand tussen twee datums, een getal gevolgd door s, m, h, d of w (bijv. 15m). Standaard 1d.
+                       Stappen van weken gaan van de eerste dag van een week naar die van de volgende, 7d behoudt de weekdag van de begindatum.
+  --tz <zone>          Vandaag bepalen in een tijdzone (bijv. Europe/Amsterdam), standaard $TZ of de tijdzone van het systeem.
+                       Stappen korter dan een dag volgen haar zomertijd.
+  --calendar <kal>     De datums in een andere kalender afdrukken (zie hieronder), standaard gregorian.
+  --digits <cijfers>   Getallen afdrukken met eigen cijfers (native, bijv. ar, fa, hi, th, zh, ja) of Latijnse cijfers (latin), standaard native voor ar en fa.
+  --week-start <dag>   Eerste dag van de week voor {ww}, {gggg}, stappen van weken en --grid: mo, su of sa,
+                       standaard de eerste dag van de locale (bijv. mo voor nl, su voor en-US, sa voor ar-EG).
+                       Week 1 van mo is de ISO-week, die van su en sa de week van 1 januari.
+  --weekend <profiel>  Weekend dat --workdays-only weglaat: sat-sun, fri-sat, fri of sun,
+                       standaard het weekend van de locale (bijv. sat-sun voor nl, fri-sat voor he en ar-SA).
+  --workdays-only      Het weekend weglaten, samen met de weekdagen van -i.
+  --only <dagen>       Alleen deze weekdagen houden, met de codes van -i (bijv. ma wo).
+  --days <lijst>       Alleen deze dagen van de maand houden, negatieve tellen vanaf het einde (bijv. 1,15,-1 of 1-7).
+  --months <lijst>     Alleen deze maanden houden als codes, namen van de taal of getallen (bijv. jan,apr,jul,oct of nov-feb).
+  --weeks <lijst>      Alleen deze ISO-weken houden (bijv. 1-10).
+  --doy <lijst>        Alleen deze dagen van het jaar houden, negatieve tellen vanaf het einde (bijv. 1,100,-1).
+                       De filters kunnen worden gecombineerd, een datum moet aan allemaal voldoen.
+  --where <expr>       Alleen de datums houden waarvoor de expressie waar is (zie hieronder).
+  --holidays <b>       Bestand met een datum (YYYY-MM-DD) per regel voor holiday in --where,
+                       gevolgd door een optionele naam die info afdrukt.
+  --offset <n>         De eerste n datums overslaan die door de filters komen.
+  --every <n>          Elke n-de datum houden die door de filters komt, beginnend na --offset.
+  --first <n>          De eerste n datums houden.
+  --last <n>           De laatste n datums houden.
+  --sample <n>         n willekeurig gekozen datums houden, in hun volgorde.
+  --seed <seed>        Elke keer dezelfde steekproef van --sample trekken.
+                       Deze opties worden in deze volgorde toegepast na de filters en vóór -r.
+  --union <p>          De datums van deze perioden toevoegen.
+  --intersect <p>      Alleen de datums binnen deze perioden houden.
+  --minus <p>          De datums binnen deze perioden verwijderen.
+                       Ze worden in deze volgorde toegepast vóór de filters en nemen alle perioden die erop volgen.
+  -, --stdin           De datums en perioden regel voor regel van de standaardinvoer lezen.
+  -h, --help           Deze hulp tonen.
+  -v, --version        De versie tonen
+
+Dagcodes voor -i (de Engelse codes mo tu we th fr sa su en de afkortingen van de taal werken ook):
+  ma  maandag
+  di  dinsdag
+  wo  woensdag
+  do  donderdag
+  vr  vrijdag
+  za  zaterdag
+  zo  zondag
+
+Plaatsaanduidingen voor -f:
+  {YYYY}  Volledig jaar (bijv. 2025)
+  {YY}    Laatste twee cijfers van het jaar (bijv. 25)
+  {MM}    Maand met voorloopnul (bijv. 12)
+  {M}     Maand zonder voorloopnul (bijv. 12)
+  {DD}    Dag met voorloopnul (bijv. 07)
+  {D}     Dag zonder voorloopnul (bijv. 7)
+  {MN}    Volledige naam van de maand (bijv. december)
+  {MNg}   Naam van de maand binnen een datum, in het Russisch in de genitief (bijv. декабря)
+  {mn}    Afgekorte naam van de maand (bijv. dec)
+  {mn1}   Smalle naam van de maand (bijv. D)
+  {WD}    Volledige naam van de weekdag (bijv. zondag)
+  {wd}    Afgekorte naam van de weekdag (bijv. zo)
+  {wd1}   Smalle naam van de weekdag (bijv. Z)
+  {WW}    ISO-week met voorloopnul (bijv. 49)
+  {GGGG}  Jaar van de ISO-weeknummering (bijv. 2025)
+  {ww}    Week van de locale met voorloopnul, week 1 bevat 1 januari in de VS en 4 januari in het grootste deel van Europa (bijv. 49)
+  {gggg}  Jaar van de weeknummering van de locale (bijv. 2025)
+  {DOY}   Dag van het jaar met voorloopnullen (bijv. 341)
+  {Q}     Kwartaal van het jaar (bijv. 4)
+  {Do}    Dag van de maand als rangtelwoord (bijv. 7e)
+  {U}     Unix-tijdstempel in seconden, van middernacht in de tijdzone voor hele dagen (bijv. 1765065600)
+  {JDN}   Juliaans dagnummer (bijv. 2461017)
+  {N}     ISO-weekdag, maandag = 1 (bijv. 7)
+  {idx}   Positie in de uitvoer, beginnend bij 1
+  {hh}    Uur (00-23)
+  {mm}    Minuut (00-59)
+  {ss}    Seconde (00-59)
+  {HH12}  Uur op een 12-uursklok (01-12)
+  {AMPM}  Voor of na de middag (bijv. a.m.)
+  {Z}     Verschil van de tijdzone met UTC (bijv. +01:00)
+  {ERA}   Japans tijdperk of Chinees sexagesimaal jaar (bijv. Reiwa)
+
+Modificatoren voor plaatsaanduidingen van -f (bijv. {WD:len=2:upper}):
+  upper   Hoofdletters
+  lower   Kleine letters
+  title   Eerste letter als hoofdletter (bijv. {WD:title} drukt Zondag af met -l nl)
+  padN    Aanvullen tot N tekens (bijv. {D:pad3} drukt 007 af)
+  len=N   De waarde na N tekens afkappen (bijv. {mn:len=2} drukt de af)
+  Gebruik {{ en }} om een letterlijke { of } af te drukken.
+
+Expressies voor --where (bijv. 'weekday in (mo,fr) and day <= 7 and not holiday'):
+  Vergelijkingen  = != < <= > >= en in (...) of not in (...) met getallen, codes (mo..su, jan..dec) en bereiken (1..7, nov..feb)
+  Voorwaarden     and, or, not en haakjes
+  Getallen        year month day weekday (mo = 1) week (ISO) quarter doy hour minute second daysInMonth nthWeekday
+  Tests           isFirstWeekdayOfMonth isLastWeekdayOfMonth isLastDayOfMonth isLeapYear weekend holiday
+
+Opmaakstijlen voor --format-style:
+  placeholder  {YYYY}-{MM}-{DD} (standaard)
+  strftime     %Y-%m-%d, ondersteunt %Y %y %m %-m %d %-d %a %A %b %h %B %F %D %j %V %G %u %q %s
+               %H %M %S %I %p %T %R %n %t %%
+  go           2006-01-02, ondersteunt 2006 06 01 1 02 2 002 Jan January Mon Monday 15 03 04 05 PM pm
+
+Kalenders voor --calendar (veranderen {YYYY} {YY} {MM} {M} {DD} {D} {Do} {MN} {mn}):
+  gregorian  Standaard
+  julian     Proleptische juliaanse kalender
+  islamic    Tabulaire islamitische kalender
+  hebrew     Hebreeuwse kalender, maanden genummerd vanaf Tisjrie
+  persian    Perzische zonnekalender
+  buddhist   Thaise zonnekalender
+  japanese   Gregoriaanse maanden met het jaar van het Japanse tijdperk
+  chinese    Chinese lunisolaire kalender, {YYYY} is het gregoriaanse jaar waarin het Chinese jaar begon
+
+Talen voor -l (voeg een regio toe voor regionale namen, bijv. de-AT, es-MX, fr-CA, ar-MA, zh-TW):
+  ar Arabisch   bg Bulgaars   ca Catalaans  cs Tsjechisch da Deens      de Duits
+  el Grieks     en Engels     es Spaans     et Estisch    fa Perzisch   fi Fins
+  fr Frans      gsw Zwitsers  he Hebreeuws  hi Hindi      hr Kroatisch  hu Hongaars
+  id Indonesisch it Italiaans ja Japans     ko Koreaans   lt Litouws    lv Lets
+  nb Noors      nl Nederlands pl Pools      pt Portugees  ro Roemeens   ru Russisch
+  sk Slowaaks   sl Sloveens   sv Zweeds     th Thai       tr Turks      uk Oekraïens
+  vi Vietnamees zh Chinees    zh-Hant traditioneel Chinees
+  ch wordt nog geaccepteerd voor Zwitserduits.
+
+Voorbeelden:
+  pdate 2025-10-02
+    Drukt alle datums af van 2 oktober 2025 tot vandaag.
+
+  pdate 2025-10-02 2025-11-30
+    Drukt alle datums af van 2 oktober tot 30 november 2025.
+
+  pdate -i ma di 2025-10-02 2025-11-30
+    Drukt de datums af zonder maandagen en dinsdagen.
+
+  pdate -i ma di vr za zo -r 2025-10-02 2025-11-30
+    Drukt de datums af zonder ma, di, vr, za, zo in omgekeerde volgorde.
+
+  pdate -f "{DD}-{MM}-{YYYY} ({wd})" 2025-10-02 2025-10-10
+    Drukt opgemaakte datums af zoals 02-10-2025 (do)
+
+  pdate --count 20 -i za zo 2025-10-02
+    Drukt de volgende 20 werkdagen af vanaf 2 oktober 2025.
+
+  pdate --count 20 --workdays-only --weekend fri-sat 2025-10-02
+    Drukt de volgende 20 werkdagen af van een week met het weekend op vrijdag en zaterdag.
+
+  pdate --grid --workdays-only --week-start mo 2025-10-01 2025-10-31
+    Drukt de werkdagen van oktober 2025 af als een kalender met weken van maandag tot zondag.
+
+  pdate --count 12 --days 15,-1 2025-01-01
+    Drukt de 15e en de laatste dag van elke maand af voor de eerste helft van 2025.
+
+  pdate --where 'weekday = fr and isLastWeekdayOfMonth' 2025-01-01 2025-12-31
+    Drukt de laatste vrijdag van elke maand in 2025 af.
+
+  pdate --sample 10 --seed 42 2025-01-01 2025-12-31
+    Drukt 10 willekeurige datums van 2025 af, bij elke uitvoering dezelfde.
+
+  pdate 2025-08-18..2025-12-19 2026-01-05..2026-03-27 --minus 2025-10-06..2025-10-17
+    Drukt de datums van twee schoolperioden af zonder de herfstvakantie.
+
+  git log --format=%as | pdate - -i za zo -f "{DD}-{MM}-{YYYY}"
+    Maakt de datums op van de commits die op werkdagen zijn gedaan.
+
+  pdate reformat --in "{YYYY}-{MM}-{DD}" -f "{D} {MN} {YYYY}" -l nl < CHANGELOG.md
+    Schrijft de datums van een changelog in het Nederlands en laat de rest van de tekst zoals hij is.
+
+  pdate info 2025-12-25 --holidays feestdagen.txt
+    Drukt de weekdag, de week, de dag van het jaar en de feestdagen van Kerstmis 2025 af en hoe ver het nog is.
+
+  pdate --step 15m 2025-10-02T08:00 2025-10-02T18:00
+    Drukt elke 15 minuten een tijdslot af van 08:00 tot 18:00.
+
+  pdate --format-style strftime -f "%d-%m-%Y (%a)" 2025-10-02 2025-10-10
+    Drukt dezelfde datums af met een strftime-opmaakstring.
+
+  pdate --calendar hebrew -f "{D} {MN} {YYYY}" 2025-09-23 2025-10-02
+    Drukt de datums af in de Hebreeuwse kalender, zoals 1 Tisjrie 5786
+
+  pdate -l zh --digits native -f "{YYYY}年{MN}{D}日" 2025-12-01 2025-12-07
+    Drukt de datums af met Chinese cijfers, zoals 二〇二五年十二月一日
+`
@@ -0,0 +1,209 @@
+package messages
+
+const helpPL = `Użycie:
+  pdate [-i <pomijane-dni>] [-f <format>] [--format-style <styl>] [-r] [--grid] [-l <język>] [--locale-file <plik>] [--count <n>] [--tz <strefa>] [--step <krok>] [--calendar <kalendarz>] [--digits <cyfry>] [--week-start <dzień>] [--weekend <profil>] [--workdays-only] [--only <dni>] [--days <lista>] [--months <lista>] [--weeks <lista>] [--doy <lista>] [--where <wyrażenie>] [--holidays <plik>] [--offset <n>] [--every <n>] [--first <n>] [--last <n>] [--sample <n> [--seed <ziarno>]] [--union <okresy>] [--intersect <okresy>] [--minus <okresy>] [- | --stdin] [data-początkowa] [data-końcowa | <od>..<do> ...]
+  pdate reformat --in <format> [-f <format>] [--format-style <styl>] [-l <język>] [--calendar <kalendarz>] [--digits <cyfry>]
+  pdate info [--json] [-f <format>] [-l <język>] [--holidays <plik>] [data]
+
+Opis:
+  Wypisuje daty od <data-początkowa> do <data-końcowa> (lub do dziś, jeśli brak daty końcowej).
+  Opcjonalnie można pominąć dni tygodnia, dostosować format lub odwrócić kolejność.
+  reformat czyta tekst ze standardowego wejścia i zamienia daty w formacie --in na format -f.
+  info wypisuje dzień tygodnia, tydzień ISO, dzień roku, kwartał i święta daty (lub dzisiejszego dnia).
+
+Opcje:
+  [data-początkowa]    Początek okresu (format: YYYY-MM-DD, YYYY-MM-DDThh:mm lub YYYY-MM-DDThh:mm:ss).
+  [data-końcowa]       Opcjonalny koniec okresu (te same formaty). Domyślnie dziś, a dla kroków krótszych niż dzień teraz.
+  [<od>..<do> ...]     Okresy zamiast dat (np. 2025-01-01..2025-01-31 2025-03-01..2025-03-15),
+                       daty są wypisywane raz i po kolei.
+  -i <dni>             Pominąć dni tygodnia za pomocą kodów (np. mo tu fr lub pon wt pt).
+  -f <format>          Sformatować każdą datę za pomocą symboli zastępczych (zob. niżej).
+  --format-style <s>   Czytać format -f jako placeholder (domyślnie), strftime lub go.
+  --in <format>        Z reformat: format dat w tekście, w tym samym stylu co -f.
+                       Potrzebuje roku, miesiąca i dnia; nazwy są czytane w języku -l lub po angielsku.
+  --json               Z info: wypisać rekord jako JSON.
+  --grid               Wypisać daty jako kalendarz miesięcy z tygodniami ustawień regionalnych na początku,
+                       nie razem z -f, --calendar ani -r.
+  -r                   Wypisać daty w odwrotnej kolejności.
+  -l <język>           Wypisać format w języku podanym jako znacznik BCP 47 (np. de, de-CH, pt-BR, zh-Hant),
+                       domyślnie $LC_ALL, $LC_TIME lub $LANG (np. pl_PL.UTF-8), w przeciwnym razie en.
+                       Język dotyczy też tej pomocy, komunikatów o błędach i kodów -i.
+  --locale-file <p>    Użyć nazw z pliku ustawień regionalnych YAML (zob. README), -l nadal może wybrać inny język.
+  --count <n>          Wypisać n dat od daty początkowej (wstecz z -r), liczonych po -i.
+  --limit <n>          To samo co --count.
+  --step <krok>        Odstęp między dwiema datami, liczba z przyrostkiem s, m, h, d lub w (np. 15m). Domyślnie 1d.
+                       Kroki tygodniowe idą od pierwszego dnia jednego tygodnia do pierwszego dnia następnego, 7d zachowuje dzień tygodnia daty początkowej.
+  --tz <strefa>        Ustalić dzisiejszy dzień w strefie czasowej (np. Europe/Warsaw), domyślnie $TZ lub strefa czasowa systemu.
+                       Kroki krótsze niż dzień uwzględniają jej czas letni.
+  --calendar <kal>     Wypisać daty w innym kalendarzu (zob. niżej), domyślnie gregorian.
+  --digits <cyfry>     Wypisać liczby cyframi rodzimymi (native, np. ar, fa, hi, th, zh, ja) lub łacińskimi (latin), domyślnie native dla ar i fa.
+  --week-start <dzień> Pierwszy dzień tygodnia dla {ww}, {gggg}, kroków tygodniowych i --grid: mo, su lub sa,
+                       domyślnie pierwszy dzień ustawień regionalnych (np. mo dla pl, su dla en-US, sa dla ar-EG).
+                       Tydzień 1 dla mo to tydzień ISO, dla su i sa tydzień, w którym wypada 1 stycznia.
+  --weekend <profil>   Weekend pomijany przez --workdays-only: sat-sun, fri-sat, fri lub sun,
+                       domyślnie weekend ustawień regionalnych (np. sat-sun dla pl, fri-sat dla he i ar-SA).
+  --workdays-only      Pominąć weekend, razem z dniami z -i.
+  --only <dni>         Zostawić tylko te dni tygodnia, z kodami -i (np. pon śr).
+  --days <lista>       Zostawić tylko te dni miesiąca, ujemne liczą się od końca (np. 1,15,-1 lub 1-7).
+  --months <lista>     Zostawić tylko te miesiące jako kody, nazwy języka lub liczby (np. jan,apr,jul,oct lub nov-feb).
+  --weeks <lista>      Zostawić tylko te tygodnie ISO (np. 1-10).
+  --doy <lista>        Zostawić tylko te dni roku, ujemne liczą się od końca (np. 1,100,-1).
+                       Filtry można łączyć, data musi spełniać wszystkie.
+  --where <wyr>        Zostawić tylko daty, dla których wyrażenie jest prawdziwe (zob. niżej).
+  --holidays <p>       Plik z jedną datą (YYYY-MM-DD) w wierszu dla holiday w --where,
+                       po której może stać nazwa wypisywana przez info.
+  --offset <n>         Pominąć pierwsze n dat, które przechodzą przez filtry.
+  --every <n>          Zostawić co n-tą datę spośród tych, które przechodzą przez filtry, zaczynając po --offset.
+  --first <n>          Zostawić pierwsze n dat.
+  --last <n>           Zostawić ostatnie n dat.
+  --sample <n>         Zostawić n losowo wybranych dat, w ich kolejności.
+  --seed <ziarno>      Za każdym razem losować tę samą próbkę --sample.
+                       Te opcje są stosowane w tej kolejności po filtrach i przed -r.
+  --union <o>          Dodać daty tych okresów.
+  --intersect <o>      Zostawić tylko daty w tych okresach.
+  --minus <o>          Usunąć daty w tych okresach.
+                       Są stosowane w tej kolejności przed filtrami i biorą wszystkie okresy, które po nich następują.
+  -, --stdin           Czytać daty i okresy wiersz po wierszu ze standardowego wejścia.
+  -h, --help           Pokazać tę pomoc.
+  -v, --version        Pokazać wersję
+
+Kody dni dla -i (skróty języka też działają, np. pon wt śr):
+  mo  poniedziałek
+  tu  wtorek
+  we  środa
+  th  czwartek
+  fr  piątek
+  sa  sobota
+  su  niedziela
+
+Symbole zastępcze dla -f:
+  {YYYY}  Pełny rok (np. 2025)
+  {YY}    Dwie ostatnie cyfry roku (np. 25)
+  {MM}    Miesiąc z zerem wiodącym (np. 12)
+  {M}     Miesiąc bez zera wiodącego (np. 12)
+  {DD}    Dzień z zerem wiodącym (np. 07)
+  {D}     Dzień bez zera wiodącego (np. 7)
+  {MN}    Pełna nazwa miesiąca (np. grudzień)
+  {MNg}   Nazwa miesiąca wewnątrz daty, w dopełniaczu (np. grudnia)
+  {mn}    Skrócona nazwa miesiąca (np. gru)
+  {mn1}   Wąska nazwa miesiąca (np. g)
+  {WD}    Pełna nazwa dnia tygodnia (np. niedziela)
+  {wd}    Skrócona nazwa dnia tygodnia (np. niedz.)
+  {wd1}   Wąska nazwa dnia tygodnia (np. n)
+  {WW}    Tydzień ISO z zerem wiodącym (np. 49)
+  {GGGG}  Rok numeracji tygodni ISO (np. 2025)
+  {ww}    Tydzień ustawień regionalnych z zerem wiodącym, tydzień 1 zawiera 1 stycznia w USA i 4 stycznia w większości Europy (np. 49)
+  {gggg}  Rok numeracji tygodni ustawień regionalnych (np. 2025)
+  {DOY}   Dzień roku z zerami wiodącymi (np. 341)
+  {Q}     Kwartał roku (np. 4)
+  {Do}    Dzień miesiąca jako liczebnik porządkowy, po polsku bez przyrostka (np. 7)
+  {U}     Znacznik czasu Unix w sekundach, dla całych dni północy w strefie czasowej (np. 1765065600)
+  {JDN}   Numer dnia juliańskiego (np. 2461017)
+  {N}     Dzień tygodnia ISO, poniedziałek = 1 (np. 7)
+  {idx}   Pozycja w wyniku, od 1
+  {hh}    Godzina (00-23)
+  {mm}    Minuta (00-59)
+  {ss}    Sekunda (00-59)
+  {HH12}  Godzina w zegarze 12-godzinnym (01-12)
+  {AMPM}  Przed południem lub po południu (np. AM)
+  {Z}     Przesunięcie strefy czasowej względem UTC (np. +01:00)
+  {ERA}   Era japońska lub rok chińskiego cyklu sześćdziesięcioletniego (np. Reiwa)
+
+Modyfikatory symboli zastępczych dla -f (np. {WD:len=2:upper}):
+  upper   Wielkie litery
+  lower   Małe litery
+  title   Pierwsza litera wielka (np. {WD:title} wypisuje Niedziela z -l pl)
+  padN    Dopełnić do N znaków (np. {D:pad3} wypisuje 007)
+  len=N   Uciąć wartość po N znakach (np. {mn:len=2} wypisuje gr)
+  Użyj {{ i }}, aby wypisać dosłowny znak { lub }.
+
+Wyrażenia dla --where (np. 'weekday in (mo,fr) and day <= 7 and not holiday'):
+  Porównania  = != < <= > >= oraz in (...) lub not in (...) z liczbami, kodami (mo..su, jan..dec) i zakresami (1..7, nov..feb)
+  Warunki     and, or, not i nawiasy
+  Liczby      year month day weekday (mo = 1) week (ISO) quarter doy hour minute second daysInMonth nthWeekday
+  Testy       isFirstWeekdayOfMonth isLastWeekdayOfMonth isLastDayOfMonth isLeapYear weekend holiday
+
+Style formatu dla --format-style:
+  placeholder  {YYYY}-{MM}-{DD} (domyślnie)
+  strftime     %Y-%m-%d, obsługuje %Y %y %m %-m %d %-d %a %A %b %h %B %F %D %j %V %G %u %q %s
+               %H %M %S %I %p %T %R %n %t %%
+  go           2006-01-02, obsługuje 2006 06 01 1 02 2 002 Jan January Mon Monday 15 03 04 05 PM pm
+
+Kalendarze dla --calendar (zmieniają {YYYY} {YY} {MM} {M} {DD} {D} {Do} {MN} {mn}):
+  gregorian  Domyślny
+  julian     Proleptyczny kalendarz juliański
+  islamic    Tabelaryczny kalendarz islamski
+  hebrew     Kalendarz żydowski, miesiące numerowane od tiszri
+  persian    Irański kalendarz słoneczny
+  buddhist   Tajski kalendarz słoneczny
+  japanese   Miesiące gregoriańskie z rokiem ery japońskiej
+  chinese    Chiński kalendarz księżycowo-słoneczny, {YYYY} to rok gregoriański, w którym zaczął się rok chiński
+
+Języki dla -l (dodaj region dla nazw regionalnych, np. de-AT, es-MX, fr-CA, ar-MA, zh-TW):
+  ar arabski    bg bułgarski  ca kataloński cs czeski     da duński     de niemiecki
+  el grecki     en angielski  es hiszpański et estoński   fa perski     fi fiński
+  fr francuski  gsw szwajcarski he hebrajski hi hindi     hr chorwacki  hu węgierski
+  id indonezyjski it włoski   ja japoński   ko koreański  lt litewski   lv łotewski
+  nb norweski   nl niderlandzki pl polski   pt portugalski ro rumuński  ru rosyjski
+  sk słowacki   sl słoweński  sv szwedzki   th tajski     tr turecki    uk ukraiński
+  vi wietnamski zh chiński    zh-Hant chiński tradycyjny
+  ch jest nadal przyjmowane dla szwajcarskiego niemieckiego.
+
+Przykłady:
+  pdate 2025-10-02
+    Wypisuje wszystkie daty od 2 października 2025 do dziś.
+
+  pdate 2025-10-02 2025-11-30
+    Wypisuje wszystkie daty od 2 października do 30 listopada 2025.
+
+  pdate -i pon wt 2025-10-02 2025-11-30
+    Wypisuje daty bez poniedziałków i wtorków.
+
+  pdate -i pon wt pt sob niedz -r 2025-10-02 2025-11-30
+    Wypisuje daty bez pon., wt., pt., sob., niedz. w odwrotnej kolejności.
+
+  pdate -f "{DD}.{MM}.{YYYY} ({wd})" 2025-10-02 2025-10-10
+    Wypisuje sformatowane daty, np. 02.10.2025 (czw.)
+
+  pdate --count 20 -i sob niedz 2025-10-02
+    Wypisuje następne 20 dni roboczych od 2 października 2025.
+
+  pdate --count 20 --workdays-only --weekend fri-sat 2025-10-02
+    Wypisuje następne 20 dni roboczych tygodnia z weekendem w piątek i sobotę.
+
+  pdate --grid --workdays-only --week-start mo 2025-10-01 2025-10-31
+    Wypisuje dni robocze października 2025 jako kalendarz z tygodniami od poniedziałku do niedzieli.
+
+  pdate --count 12 --days 15,-1 2025-01-01
+    Wypisuje 15. i ostatni dzień każdego miesiąca pierwszej połowy 2025.
+
+  pdate --where 'weekday = fr and isLastWeekdayOfMonth' 2025-01-01 2025-12-31
+    Wypisuje ostatni piątek każdego miesiąca 2025.
+
+  pdate --sample 10 --seed 42 2025-01-01 2025-12-31
+    Wypisuje 10 losowych dat z 2025, te same przy każdym uruchomieniu.
+
+  pdate 2025-08-18..2025-12-19 2026-01-05..2026-03-27 --minus 2025-10-06..2025-10-17
+    Wypisuje daty dwóch okresów szkolnych bez ferii jesiennych.
+
+  git log --format=%as | pdate - -i sob niedz -f "{DD}.{MM}.{YYYY}"
+    Przeformatowuje daty commitów zrobionych w dni robocze.
+
+  pdate reformat --in "{YYYY}-{MM}-{DD}" -f "{D} {MNg} {YYYY}" -l pl < CHANGELOG.md
+    Zapisuje daty dziennika zmian po polsku i zostawia resztę tekstu bez zmian.
+
+  pdate info 2025-12-25 --holidays swieta.txt
+    Wypisuje dzień tygodnia, tydzień, dzień roku i święta Bożego Narodzenia 2025 oraz ile do nich zostało.
+
+  pdate --step 15m 2025-10-02T08:00 2025-10-02T18:00
+    Wypisuje przedział czasu co 15 minut od 08:00 do 18:00.
+
+  pdate --format-style strftime -f "%d.%m.%Y (%a)" 2025-10-02 2025-10-10
+    Wypisuje te same daty za pomocą ciągu formatu strftime.
+
+  pdate --calendar hebrew -f "{D} {MN} {YYYY}" 2025-09-23 2025-10-02
+    Wypisuje daty w kalendarzu żydowskim, np. 1 Tishri 5786
+
+  pdate -l zh --digits native -f "{YYYY}年{MN}{D}日" 2025-12-01 2025-12-07
+    Wypisuje daty chińskimi cyframi, np. 二〇二五年十二月一日
+`
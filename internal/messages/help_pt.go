@@ -0,0 +1,209 @@
+package messages
+
+const helpPT = `Uso:
+  pdate [-i <dias-a-ignorar>] [-f <formato>] [--format-style <estilo>] [-r] [--grid] [-l <idioma>] [--locale-file <arquivo>] [--count <n>] [--tz <fuso>] [--step <passo>] [--calendar <calendário>] [--digits <algarismos>] [--week-start <dia>] [--weekend <perfil>] [--workdays-only] [--only <dias>] [--days <lista>] [--months <lista>] [--weeks <lista>] [--doy <lista>] [--where <expressão>] [--holidays <arquivo>] [--offset <n>] [--every <n>] [--first <n>] [--last <n>] [--sample <n> [--seed <semente>]] [--union <períodos>] [--intersect <períodos>] [--minus <períodos>] [- | --stdin] [data-inicial] [data-final | <de>..<até> ...]
+  pdate reformat --in <formato> [-f <formato>] [--format-style <estilo>] [-l <idioma>] [--calendar <calendário>] [--digits <algarismos>]
+  pdate info [--json] [-f <formato>] [-l <idioma>] [--holidays <arquivo>] [data]
+
+Descrição:
+  Imprime as datas de <data-inicial> até <data-final> (ou até hoje se a data final for omitida).
+  Opcionalmente é possível ignorar dias da semana, personalizar o formato ou inverter a ordem.
+  reformat lê um texto da entrada padrão e substitui as datas no formato de --in pelo formato de -f.
+  info imprime o dia da semana, a semana ISO, o dia do ano, o trimestre e os feriados de uma data (ou de hoje).
+
+Opções:
+  [data-inicial]       Início do período (formato: YYYY-MM-DD, YYYY-MM-DDThh:mm ou YYYY-MM-DDThh:mm:ss).
+  [data-final]         Fim opcional do período (mesmos formatos). O padrão é hoje, ou agora para passos menores que um dia.
+  [<de>..<até> ...]    Períodos em vez das datas (p. ex., 2025-01-01..2025-01-31 2025-03-01..2025-03-15),
+                       as datas são impressas uma vez e em ordem.
+  -i <dias>            Ignorar dias da semana por meio de códigos (p. ex., mo tu fr ou seg ter sex).
+  -f <formato>         Formatar cada data com marcadores (veja abaixo).
+  --format-style <e>   Ler o formato de -f como placeholder (padrão), strftime ou go.
+  --in <formato>       Com reformat: o formato das datas no texto, no mesmo estilo de -f.
+                       Precisa de um ano, um mês e um dia; os nomes são lidos no idioma de -l ou em inglês.
+  --json               Com info: imprimir o registro como JSON.
+  --grid               Imprimir as datas como um calendário de meses com as semanas da localidade à frente,
+                       não com -f, --calendar ou -r.
+  -r                   Imprimir as datas em ordem inversa.
+  -l <idioma>          Imprimir o formato em um idioma dado como etiqueta BCP 47 (p. ex., de, de-CH, pt-BR, zh-Hant),
+                       o padrão é $LC_ALL, $LC_TIME ou $LANG (p. ex., pt_BR.UTF-8), senão en.
+                       O idioma vale também para esta ajuda, para as mensagens de erro e para os códigos de -i.
+  --locale-file <a>    Usar os nomes de um arquivo de localidade YAML (veja o README), -l ainda pode escolher outro idioma.
+  --count <n>          Imprimir n datas a partir da data inicial (para trás com -r), contadas depois de -i.
+  --limit <n>          O mesmo que --count.
+  --step <passo>       Distância entre duas datas, um número seguido de s, m, h, d ou w (p. ex., 15m). O padrão é 1d.
+                       Passos de semanas vão do primeiro dia de uma semana ao da seguinte, 7d mantém o dia da semana da data inicial.
+  --tz <fuso>          Determinar hoje em um fuso horário (p. ex., America/Sao_Paulo), o padrão é $TZ ou o fuso horário do sistema.
+                       Passos menores que um dia seguem o seu horário de verão.
+  --calendar <cal>     Imprimir as datas em outro calendário (veja abaixo), o padrão é gregorian.
+  --digits <alg>       Imprimir os números com algarismos nativos (native, p. ex., ar, fa, hi, th, zh, ja) ou latinos (latin), o padrão é native para ar e fa.
+  --week-start <dia>   Primeiro dia da semana para {ww}, {gggg}, os passos de semanas e --grid: mo, su ou sa,
+                       o padrão é o primeiro dia da localidade (p. ex., su para pt, mo para de, sa para ar-EG).
+                       A semana 1 de mo é a semana ISO, a de su e sa é a semana de 1º de janeiro.
+  --weekend <perfil>   Fim de semana deixado de fora por --workdays-only: sat-sun, fri-sat, fri ou sun,
+                       o padrão é o fim de semana da localidade (p. ex., sat-sun para pt, fri-sat para he e ar-SA).
+  --workdays-only      Deixar de fora o fim de semana, junto com os dias de -i.
+  --only <dias>        Manter só estes dias da semana, com os códigos de -i (p. ex., mo we).
+  --days <lista>       Manter só estes dias do mês, os negativos contam a partir do fim (p. ex., 1,15,-1 ou 1-7).
+  --months <lista>     Manter só estes meses como códigos, nomes do idioma ou números (p. ex., jan,apr,jul,oct ou nov-feb).
+  --weeks <lista>      Manter só estas semanas ISO (p. ex., 1-10).
+  --doy <lista>        Manter só estes dias do ano, os negativos contam a partir do fim (p. ex., 1,100,-1).
+                       Os filtros podem ser combinados, uma data tem de satisfazer todos.
+  --where <expr>       Manter só as datas para as quais a expressão é verdadeira (veja abaixo).
+  --holidays <a>       Arquivo com uma data (YYYY-MM-DD) por linha para holiday em --where,
+                       seguida de um nome opcional que info imprime.
+  --offset <n>         Pular as primeiras n datas que passam pelos filtros.
+  --every <n>          Manter uma a cada n datas que passam pelos filtros, a partir de --offset.
+  --first <n>          Manter as primeiras n datas.
+  --last <n>           Manter as últimas n datas.
+  --sample <n>         Manter n datas sorteadas, na sua ordem.
+  --seed <semente>     Sortear a mesma amostra de --sample todas as vezes.
+                       Estas opções são aplicadas nesta ordem depois dos filtros e antes de -r.
+  --union <p>          Acrescentar as datas destes períodos.
+  --intersect <p>      Manter só as datas dentro destes períodos.
+  --minus <p>          Remover as datas dentro destes períodos.
+                       São aplicadas nesta ordem antes dos filtros e levam todos os períodos que as seguem.
+  -, --stdin           Ler as datas e os períodos linha a linha da entrada padrão.
+  -h, --help           Mostrar esta ajuda.
+  -v, --version        Mostrar a versão
+
+Códigos dos dias para -i (as abreviaturas do idioma também funcionam, p. ex., seg ter qua):
+  mo  segunda-feira
+  tu  terça-feira
+  we  quarta-feira
+  th  quinta-feira
+  fr  sexta-feira
+  sa  sábado
+  su  domingo
+
+Marcadores para -f:
+  {YYYY}  Ano completo (p. ex., 2025)
+  {YY}    Dois últimos algarismos do ano (p. ex., 25)
+  {MM}    Mês com zero à esquerda (p. ex., 12)
+  {M}     Mês sem zero à esquerda (p. ex., 12)
+  {DD}    Dia com zero à esquerda (p. ex., 07)
+  {D}     Dia sem zero à esquerda (p. ex., 7)
+  {MN}    Nome completo do mês (p. ex., dezembro)
+  {MNg}   Nome do mês dentro de uma data, no genitivo em russo (p. ex., декабря)
+  {mn}    Nome abreviado do mês (p. ex., dez.)
+  {mn1}   Nome estreito do mês (p. ex., D)
+  {WD}    Nome completo do dia (p. ex., domingo)
+  {wd}    Nome abreviado do dia (p. ex., dom.)
+  {wd1}   Nome estreito do dia (p. ex., D)
+  {WW}    Semana ISO com zero à esquerda (p. ex., 49)
+  {GGGG}  Ano da numeração ISO das semanas (p. ex., 2025)
+  {ww}    Semana da localidade com zero à esquerda, a semana 1 contém 1º de janeiro no Brasil e nos EUA e 4 de janeiro na maior parte da Europa (p. ex., 50)
+  {gggg}  Ano da numeração das semanas da localidade (p. ex., 2025)
+  {DOY}   Dia do ano com zeros à esquerda (p. ex., 341)
+  {Q}     Trimestre do ano (p. ex., 4)
+  {Do}    Dia do mês como ordinal, só o dia 1 em português (p. ex., 1º)
+  {U}     Timestamp Unix em segundos, da meia-noite no fuso horário para dias inteiros (p. ex., 1765065600)
+  {JDN}   Número do dia juliano (p. ex., 2461017)
+  {N}     Dia da semana ISO, segunda-feira = 1 (p. ex., 7)
+  {idx}   Posição na saída, a partir de 1
+  {hh}    Hora (00-23)
+  {mm}    Minuto (00-59)
+  {ss}    Segundo (00-59)
+  {HH12}  Hora no relógio de 12 horas (01-12)
+  {AMPM}  Antes ou depois do meio-dia (p. ex., AM)
+  {Z}     Diferença do fuso horário em relação a UTC (p. ex., -03:00)
+  {ERA}   Era japonesa ou ano sexagenário chinês (p. ex., Reiwa)
+
+Modificadores dos marcadores para -f (p. ex., {WD:len=2:upper}):
+  upper   Maiúsculas
+  lower   Minúsculas
+  title   Primeira letra maiúscula (p. ex., {WD:title} imprime Domingo com -l pt)
+  padN    Preencher até N caracteres (p. ex., {D:pad3} imprime 007)
+  len=N   Cortar o valor depois de N caracteres (p. ex., {mn:len=2} imprime de)
+  Use {{ e }} para imprimir um { ou } literal.
+
+Expressões para --where (p. ex., 'weekday in (mo,fr) and day <= 7 and not holiday'):
+  Comparações  = != < <= > >= e in (...) ou not in (...) com números, códigos (mo..su, jan..dec) e intervalos (1..7, nov..feb)
+  Condições    and, or, not e parênteses
+  Números      year month day weekday (mo = 1) week (ISO) quarter doy hour minute second daysInMonth nthWeekday
+  Testes       isFirstWeekdayOfMonth isLastWeekdayOfMonth isLastDayOfMonth isLeapYear weekend holiday
+
+Estilos de formato para --format-style:
+  placeholder  {YYYY}-{MM}-{DD} (padrão)
+  strftime     %Y-%m-%d, suporta %Y %y %m %-m %d %-d %a %A %b %h %B %F %D %j %V %G %u %q %s
+               %H %M %S %I %p %T %R %n %t %%
+  go           2006-01-02, suporta 2006 06 01 1 02 2 002 Jan January Mon Monday 15 03 04 05 PM pm
+
+Calendários para --calendar (mudam {YYYY} {YY} {MM} {M} {DD} {D} {Do} {MN} {mn}):
+  gregorian  Padrão
+  julian     Calendário juliano proléptico
+  islamic    Calendário islâmico tabular
+  hebrew     Calendário hebraico, meses numerados a partir de Tishri
+  persian    Calendário solar hegírico
+  buddhist   Calendário solar tailandês
+  japanese   Meses gregorianos com o ano da era japonesa
+  chinese    Calendário lunissolar chinês, {YYYY} é o ano gregoriano em que o ano chinês começou
+
+Idiomas para -l (acrescente uma região para os nomes regionais, p. ex., de-AT, es-MX, fr-CA, ar-MA, zh-TW):
+  ar árabe      bg búlgaro    ca catalão    cs tcheco     da dinamarquês de alemão
+  el grego      en inglês     es espanhol   et estoniano  fa persa      fi finlandês
+  fr francês    gsw suíço     he hebraico   hi híndi      hr croata     hu húngaro
+  id indonésio  it italiano   ja japonês    ko coreano    lt lituano    lv letão
+  nb norueguês  nl neerlandês pl polonês    pt português  ro romeno     ru russo
+  sk eslovaco   sl esloveno   sv sueco      th tailandês  tr turco      uk ucraniano
+  vi vietnamita zh chinês     zh-Hant chinês tradicional
+  ch ainda é aceito para o alemão suíço.
+
+Exemplos:
+  pdate 2025-10-02
+    Imprime todas as datas de 2 de outubro de 2025 até hoje.
+
+  pdate 2025-10-02 2025-11-30
+    Imprime todas as datas de 2 de outubro a 30 de novembro de 2025.
+
+  pdate -i mo tu 2025-10-02 2025-11-30
+    Imprime as datas sem as segundas e as terças-feiras.
+
+  pdate -i mo tu fr sa su -r 2025-10-02 2025-11-30
+    Imprime as datas sem seg, ter, sex, sáb, dom em ordem inversa.
+
+  pdate -f "{DD}/{MM}/{YYYY} ({wd})" 2025-10-02 2025-10-10
+    Imprime datas formatadas como 02/10/2025 (qui.)
+
+  pdate --count 20 -i sa su 2025-10-02
+    Imprime os próximos 20 dias úteis a partir de 2 de outubro de 2025.
+
+  pdate --count 20 --workdays-only --weekend fri-sat 2025-10-02
+    Imprime os próximos 20 dias úteis de uma semana com o fim de semana na sexta e no sábado.
+
+  pdate --grid --workdays-only --week-start mo 2025-10-01 2025-10-31
+    Imprime os dias úteis de outubro de 2025 como um calendário com semanas de segunda a domingo.
+
+  pdate --count 12 --days 15,-1 2025-01-01
+    Imprime o dia 15 e o último dia de cada mês do primeiro semestre de 2025.
+
+  pdate --where 'weekday = fr and isLastWeekdayOfMonth' 2025-01-01 2025-12-31
+    Imprime a última sexta-feira de cada mês de 2025.
+
+  pdate --sample 10 --seed 42 2025-01-01 2025-12-31
+    Imprime 10 datas sorteadas de 2025, as mesmas em cada execução.
+
+  pdate 2025-08-18..2025-12-19 2026-01-05..2026-03-27 --minus 2025-10-06..2025-10-17
+    Imprime as datas de dois períodos letivos sem as férias de outono.
+
+  git log --format=%as | pdate - -i sa su -f "{DD}/{MM}/{YYYY}"
+    Reformata as datas dos commits feitos em dias úteis.
+
+  pdate reformat --in "{YYYY}-{MM}-{DD}" -f "{D} de {MN} de {YYYY}" -l pt < CHANGELOG.md
+    Escreve as datas de um registro de alterações em português e deixa o resto do texto como está.
+
+  pdate info 2025-12-25 --holidays feriados.txt
+    Imprime o dia da semana, a semana, o dia do ano e os feriados do Natal de 2025 e quanto falta.
+
+  pdate --step 15m 2025-10-02T08:00 2025-10-02T18:00
+    Imprime um horário a cada 15 minutos das 08:00 às 18:00.
+
+  pdate --format-style strftime -f "%d/%m/%Y (%a)" 2025-10-02 2025-10-10
+    Imprime as mesmas datas com uma string de formato strftime.
+
+  pdate --calendar hebrew -f "{D} {MN} {YYYY}" 2025-09-23 2025-10-02
+    Imprime as datas no calendário hebraico, como 1 tishrei 5786
+
+  pdate -l zh --digits native -f "{YYYY}年{MN}{D}日" 2025-12-01 2025-12-07
+    Imprime as datas com numerais chineses, como 二〇二五年十二月一日
+`
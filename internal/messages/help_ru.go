@@ -0,0 +1,209 @@
+package messages
+
+const helpRU = `Использование:
+  pdate [-i <пропускаемые-дни>] [-f <формат>] [--format-style <стиль>] [-r] [--grid] [-l <язык>] [--locale-file <файл>] [--count <n>] [--tz <пояс>] [--step <шаг>] [--calendar <календарь>] [--digits <цифры>] [--week-start <день>] [--weekend <профиль>] [--workdays-only] [--only <дни>] [--days <список>] [--months <список>] [--weeks <список>] [--doy <список>] [--where <выражение>] [--holidays <файл>] [--offset <n>] [--every <n>] [--first <n>] [--last <n>] [--sample <n> [--seed <зерно>]] [--union <периоды>] [--intersect <периоды>] [--minus <периоды>] [- | --stdin] [начальная-дата] [конечная-дата | <с>..<по> ...]
+  pdate reformat --in <формат> [-f <формат>] [--format-style <стиль>] [-l <язык>] [--calendar <календарь>] [--digits <цифры>]
+  pdate info [--json] [-f <формат>] [-l <язык>] [--holidays <файл>] [дата]
+
+Описание:
+  Выводит даты с <начальная-дата> по <конечная-дата> (или по сегодняшний день, если конечная дата не указана).
+  Можно пропускать дни недели, настраивать формат или выводить даты в обратном порядке.
+  reformat читает текст со стандартного ввода и заменяет даты в формате --in на формат -f.
+  info выводит день недели, неделю ISO, день года, квартал и праздники даты (или сегодняшнего дня).
+
+Параметры:
+  [начальная-дата]     Начало периода (формат: YYYY-MM-DD, YYYY-MM-DDThh:mm или YYYY-MM-DDThh:mm:ss).
+  [конечная-дата]      Необязательный конец периода (те же форматы). По умолчанию сегодня, а для шагов меньше дня сейчас.
+  [<с>..<по> ...]      Периоды вместо дат (например, 2025-01-01..2025-01-31 2025-03-01..2025-03-15),
+                       даты выводятся по одному разу и по порядку.
+  -i <дни>             Пропускать дни недели по кодам (например, mo tu fr или пн вт пт).
+  -f <формат>          Форматировать каждую дату с помощью заполнителей (см. ниже).
+  --format-style <с>   Читать формат -f как placeholder (по умолчанию), strftime или go.
+  --in <формат>        С reformat: формат дат в тексте, в том же стиле, что и -f.
+                       Нужны год, месяц и день; названия читаются на языке -l или на английском.
+  --json               С info: вывести запись в формате JSON.
+  --grid               Вывести даты как календарь месяцев с неделями локали впереди,
+                       не вместе с -f, --calendar или -r.
+  -r                   Выводить даты в обратном порядке.
+  -l <язык>            Выводить формат на языке, заданном тегом BCP 47 (например, de, de-CH, pt-BR, zh-Hant),
+                       по умолчанию $LC_ALL, $LC_TIME или $LANG (например, ru_RU.UTF-8), иначе en.
+                       Язык действует также на эту справку, сообщения об ошибках и коды -i.
+  --locale-file <ф>    Использовать названия из YAML-файла локали (см. README), -l по-прежнему может выбрать другой язык.
+  --count <n>          Вывести n дат начиная с начальной даты (назад с -r), считая после -i.
+  --limit <n>          То же, что --count.
+  --step <шаг>         Расстояние между двумя датами, число с суффиксом s, m, h, d или w (например, 15m). По умолчанию 1d.
+                       Шаги в неделях идут от первого дня одной недели к первому дню следующей, 7d сохраняет день недели начальной даты.
+  --tz <пояс>          Определять сегодняшний день в часовом поясе (например, Europe/Moscow), по умолчанию $TZ или часовой пояс системы.
+                       Шаги меньше дня учитывают его летнее время.
+  --calendar <кал>     Выводить даты в другом календаре (см. ниже), по умолчанию gregorian.
+  --digits <цифры>     Выводить числа родными (native, например, ar, fa, hi, th, zh, ja) или латинскими (latin) цифрами, по умолчанию native для ar и fa.
+  --week-start <день>  Первый день недели для {ww}, {gggg}, шагов в неделях и --grid: mo, su или sa,
+                       по умолчанию первый день локали (например, mo для ru, su для en-US, sa для ar-EG).
+                       Неделя 1 для mo — неделя ISO, для su и sa — неделя, в которую входит 1 января.
+  --weekend <профиль>  Выходные, которые пропускает --workdays-only: sat-sun, fri-sat, fri или sun,
+                       по умолчанию выходные локали (например, sat-sun для ru, fri-sat для he и ar-SA).
+  --workdays-only      Пропускать выходные вместе с днями -i.
+  --only <дни>         Оставить только эти дни недели, с кодами -i (например, пн ср).
+  --days <список>      Оставить только эти дни месяца, отрицательные считаются с конца (например, 1,15,-1 или 1-7).
+  --months <список>    Оставить только эти месяцы в виде кодов, названий языка или чисел (например, jan,apr,jul,oct или nov-feb).
+  --weeks <список>     Оставить только эти недели ISO (например, 1-10).
+  --doy <список>       Оставить только эти дни года, отрицательные считаются с конца (например, 1,100,-1).
+                       Фильтры можно сочетать, дата должна подходить под все.
+  --where <выраж>      Оставить только даты, для которых выражение истинно (см. ниже).
+  --holidays <ф>       Файл с одной датой (YYYY-MM-DD) на строку для holiday в --where,
+                       за которой может идти название, которое выводит info.
+  --offset <n>         Пропустить первые n дат, прошедших фильтры.
+  --every <n>          Оставить каждую n-ю дату из прошедших фильтры, начиная после --offset.
+  --first <n>          Оставить первые n дат.
+  --last <n>           Оставить последние n дат.
+  --sample <n>         Оставить n случайно выбранных дат в их порядке.
+  --seed <зерно>       Каждый раз выбирать одну и ту же выборку --sample.
+                       Эти параметры применяются в этом порядке после фильтров и перед -r.
+  --union <п>          Добавить даты этих периодов.
+  --intersect <п>      Оставить только даты внутри этих периодов.
+  --minus <п>          Убрать даты внутри этих периодов.
+                       Они применяются в этом порядке перед фильтрами и берут все периоды после себя.
+  -, --stdin           Читать даты и периоды построчно со стандартного ввода.
+  -h, --help           Показать эту справку.
+  -v, --version        Показать версию
+
+Коды дней для -i (сокращения языка тоже работают, например, пн вт ср):
+  mo  понедельник
+  tu  вторник
+  we  среда
+  th  четверг
+  fr  пятница
+  sa  суббота
+  su  воскресенье
+
+Заполнители для -f:
+  {YYYY}  Полный год (например, 2025)
+  {YY}    Две последние цифры года (например, 25)
+  {MM}    Месяц с ведущим нулём (например, 12)
+  {M}     Месяц без ведущего нуля (например, 12)
+  {DD}    День с ведущим нулём (например, 07)
+  {D}     День без ведущего нуля (например, 7)
+  {MN}    Полное название месяца (например, декабрь)
+  {MNg}   Название месяца внутри даты, в родительном падеже (например, декабря)
+  {mn}    Сокращённое название месяца (например, дек.)
+  {mn1}   Узкое название месяца (например, Д)
+  {WD}    Полное название дня недели (например, воскресенье)
+  {wd}    Сокращённое название дня недели (например, вс)
+  {wd1}   Узкое название дня недели (например, В)
+  {WW}    Неделя ISO с ведущим нулём (например, 49)
+  {GGGG}  Год нумерации недель ISO (например, 2025)
+  {ww}    Неделя локали с ведущим нулём, неделя 1 содержит 1 января в США и 4 января в большей части Европы (например, 49)
+  {gggg}  Год нумерации недель локали (например, 2025)
+  {DOY}   День года с ведущими нулями (например, 341)
+  {Q}     Квартал года (например, 4)
+  {Do}    День месяца как порядковое числительное (например, 7-е)
+  {U}     Метка времени Unix в секундах, для целых дней полночи в часовом поясе (например, 1765065600)
+  {JDN}   Номер юлианского дня (например, 2461017)
+  {N}     День недели ISO, понедельник = 1 (например, 7)
+  {idx}   Позиция в выводе, начиная с 1
+  {hh}    Час (00-23)
+  {mm}    Минута (00-59)
+  {ss}    Секунда (00-59)
+  {HH12}  Час в 12-часовом формате (01-12)
+  {AMPM}  До или после полудня (например, AM)
+  {Z}     Смещение часового пояса от UTC (например, +03:00)
+  {ERA}   Японская эра или китайский год шестидесятилетнего цикла (например, Reiwa)
+
+Модификаторы заполнителей для -f (например, {WD:len=2:upper}):
+  upper   Верхний регистр
+  lower   Нижний регистр
+  title   Первая буква заглавная (например, {WD:title} выводит Воскресенье с -l ru)
+  padN    Дополнить до N символов (например, {D:pad3} выводит 007)
+  len=N   Обрезать значение после N символов (например, {mn:len=2} выводит де)
+  Используйте {{ и }}, чтобы вывести символ { или }.
+
+Выражения для --where (например, 'weekday in (mo,fr) and day <= 7 and not holiday'):
+  Сравнения  = != < <= > >= и in (...) или not in (...) с числами, кодами (mo..su, jan..dec) и диапазонами (1..7, nov..feb)
+  Условия    and, or, not и скобки
+  Числа      year month day weekday (mo = 1) week (ISO) quarter doy hour minute second daysInMonth nthWeekday
+  Проверки   isFirstWeekdayOfMonth isLastWeekdayOfMonth isLastDayOfMonth isLeapYear weekend holiday
+
+Стили формата для --format-style:
+  placeholder  {YYYY}-{MM}-{DD} (по умолчанию)
+  strftime     %Y-%m-%d, поддерживает %Y %y %m %-m %d %-d %a %A %b %h %B %F %D %j %V %G %u %q %s
+               %H %M %S %I %p %T %R %n %t %%
+  go           2006-01-02, поддерживает 2006 06 01 1 02 2 002 Jan January Mon Monday 15 03 04 05 PM pm
+
+Календари для --calendar (меняют {YYYY} {YY} {MM} {M} {DD} {D} {Do} {MN} {mn}):
+  gregorian  По умолчанию
+  julian     Пролептический юлианский календарь
+  islamic    Табличный исламский календарь
+  hebrew     Еврейский календарь, месяцы нумеруются с тишрея
+  persian    Солнечный календарь хиджры
+  buddhist   Тайский солнечный календарь
+  japanese   Григорианские месяцы с годом японской эры
+  chinese    Китайский лунно-солнечный календарь, {YYYY} — григорианский год, в котором начался китайский год
+
+Языки для -l (добавьте регион для региональных названий, например, de-AT, es-MX, fr-CA, ar-MA, zh-TW):
+  ar арабский   bg болгарский ca каталанский cs чешский   da датский    de немецкий
+  el греческий  en английский es испанский  et эстонский  fa персидский fi финский
+  fr французский gsw швейцарский he иврит   hi хинди      hr хорватский hu венгерский
+  id индонезийский it итальянский ja японский ko корейский lt литовский lv латышский
+  nb норвежский nl нидерландский pl польский pt португальский ro румынский ru русский
+  sk словацкий  sl словенский sv шведский   th тайский    tr турецкий   uk украинский
+  vi вьетнамский zh китайский zh-Hant традиционный китайский
+  ch по-прежнему принимается для швейцарского немецкого.
+
+Примеры:
+  pdate 2025-10-02
+    Выводит все даты с 2 октября 2025 года по сегодняшний день.
+
+  pdate 2025-10-02 2025-11-30
+    Выводит все даты со 2 октября по 30 ноября 2025 года.
+
+  pdate -i пн вт 2025-10-02 2025-11-30
+    Выводит даты без понедельников и вторников.
+
+  pdate -i пн вт пт сб вс -r 2025-10-02 2025-11-30
+    Выводит даты без пн, вт, пт, сб, вс в обратном порядке.
+
+  pdate -f "{DD}.{MM}.{YYYY} ({wd})" 2025-10-02 2025-10-10
+    Выводит даты в виде 02.10.2025 (чт)
+
+  pdate --count 20 -i сб вс 2025-10-02
+    Выводит следующие 20 рабочих дней начиная со 2 октября 2025 года.
+
+  pdate --count 20 --workdays-only --weekend fri-sat 2025-10-02
+    Выводит следующие 20 рабочих дней недели с выходными в пятницу и субботу.
+
+  pdate --grid --workdays-only --week-start mo 2025-10-01 2025-10-31
+    Выводит рабочие дни октября 2025 года как календарь с неделями с понедельника по воскресенье.
+
+  pdate --count 12 --days 15,-1 2025-01-01
+    Выводит 15-е и последнее число каждого месяца первой половины 2025 года.
+
+  pdate --where 'weekday = fr and isLastWeekdayOfMonth' 2025-01-01 2025-12-31
+    Выводит последнюю пятницу каждого месяца 2025 года.
+
+  pdate --sample 10 --seed 42 2025-01-01 2025-12-31
+    Выводит 10 случайных дат 2025 года, одни и те же при каждом запуске.
+
+  pdate 2025-08-18..2025-12-19 2026-01-05..2026-03-27 --minus 2025-10-06..2025-10-17
+    Выводит даты двух учебных четвертей без осенних каникул.
+
+  git log --format=%as | pdate - -i сб вс -f "{DD}.{MM}.{YYYY}"
+    Переформатирует даты коммитов, сделанных в рабочие дни.
+
+  pdate reformat --in "{YYYY}-{MM}-{DD}" -f "{D} {MNg} {YYYY}" -l ru < CHANGELOG.md
+    Записывает даты журнала изменений по-русски и оставляет остальной текст как есть.
+
+  pdate info 2025-12-25 --holidays holidays.txt
+    Выводит день недели, неделю, день года и праздники Рождества 2025 года и сколько до него осталось.
+
+  pdate --step 15m 2025-10-02T08:00 2025-10-02T18:00
+    Выводит временной интервал каждые 15 минут с 08:00 до 18:00.
+
+  pdate --format-style strftime -f "%d.%m.%Y (%a)" 2025-10-02 2025-10-10
+    Выводит те же даты с помощью строки формата strftime.
+
+  pdate --calendar hebrew -f "{D} {MN} {YYYY}" 2025-09-23 2025-10-02
+    Выводит даты в еврейском календаре, например 1 тишрей 5786
+
+  pdate -l zh --digits native -f "{YYYY}年{MN}{D}日" 2025-12-01 2025-12-07
+    Выводит даты китайскими цифрами, например 二〇二五年十二月一日
+`
@@ -0,0 +1,209 @@
+package messages
+
+const helpZH = `用法：
+  pdate [-i <要跳过的星期>] [-f <格式>] [--format-style <风格>] [-r] [--grid] [-l <语言>] [--locale-file <文件>] [--count <n>] [--tz <时区>] [--step <步长>] [--calendar <历法>] [--digits <数字>] [--week-start <日>] [--weekend <方案>] [--workdays-only] [--only <星期>] [--days <列表>] [--months <列表>] [--weeks <列表>] [--doy <列表>] [--where <表达式>] [--holidays <文件>] [--offset <n>] [--every <n>] [--first <n>] [--last <n>] [--sample <n> [--seed <种子>]] [--union <区间>] [--intersect <区间>] [--minus <区间>] [- | --stdin] [开始日期] [结束日期 | <从>..<到> ...]
+  pdate reformat --in <格式> [-f <格式>] [--format-style <风格>] [-l <语言>] [--calendar <历法>] [--digits <数字>]
+  pdate info [--json] [-f <格式>] [-l <语言>] [--holidays <文件>] [日期]
+
+说明：
+  输出从 <开始日期> 到 <结束日期> 的所有日期（省略结束日期时到今天为止）。
+  可以跳过指定的星期、自定义日期格式或倒序输出。
+  reformat 从标准输入读取文本，把 --in 格式的日期替换为 -f 格式。
+  info 输出某个日期（或今天）的星期、ISO 周、年内天数、季度和节假日。
+
+选项：
+  [开始日期]           区间的开始（格式：YYYY-MM-DD、YYYY-MM-DDThh:mm 或 YYYY-MM-DDThh:mm:ss）。
+  [结束日期]           可选的区间结束（格式相同）。默认为今天，步长小于一天时为现在。
+  [<从>..<到> ...]     用区间代替日期（例如 2025-01-01..2025-01-31 2025-03-01..2025-03-15），
+                       日期按顺序各输出一次。
+  -i <星期>            用代码跳过指定的星期（例如 mo tu fr 或 周一 周二 周五）。
+  -f <格式>            用占位符格式化每个日期（见下文）。
+  --format-style <s>   把 -f 的格式按 placeholder（默认）、strftime 或 go 解读。
+  --in <格式>          用于 reformat：文本中日期的格式，风格与 -f 相同。
+                       需要年、月、日；名称按 -l 的语言或英语读取。
+  --json               用于 info：以 JSON 输出记录。
+  --grid               把日期输出为按月排列的日历，前面是区域设置的周数，
+                       不能与 -f、--calendar 或 -r 同用。
+  -r                   倒序输出日期。
+  -l <语言>            用 BCP 47 标签指定的语言输出格式（例如 de、de-CH、pt-BR、zh-Hant），
+                       默认取 $LC_ALL、$LC_TIME 或 $LANG（例如 zh_CN.UTF-8），否则为 en。
+                       该语言也用于本帮助、错误信息和 -i 的代码。
+  --locale-file <f>    使用 YAML 区域设置文件中的名称（见 README），仍可用 -l 选择其他语言。
+  --count <n>          从开始日期起输出 n 个日期（配合 -r 时向前），在 -i 之后计数。
+  --limit <n>          同 --count。
+  --step <步长>        两个日期之间的间隔，数字后接 s、m、h、d 或 w（例如 15m）。默认为 1d。
+                       以周为步长时从一周的第一天跳到下一周的第一天，7d 则保留开始日期的星期。
+  --tz <时区>          按时区确定今天（例如 Asia/Shanghai），默认为 $TZ 或系统时区。
+                       步长小于一天时遵循该时区的夏令时。
+  --calendar <历法>    用其他历法输出日期（见下文），默认为 gregorian。
+  --digits <数字>      用本地数字（native，例如 ar、fa、hi、th、zh、ja）或拉丁数字（latin）输出数字，ar 和 fa 默认为 native。
+  --week-start <日>    {ww}、{gggg}、以周为步长和 --grid 所用的一周第一天：mo、su 或 sa，
+                       默认为区域设置的第一天（例如 zh 和 en-US 为 su，de 为 mo，ar-EG 为 sa）。
+                       mo 的第 1 周是 ISO 周，su 和 sa 的第 1 周是包含 1 月 1 日的那一周。
+  --weekend <方案>     --workdays-only 跳过的周末：sat-sun、fri-sat、fri 或 sun，
+                       默认为区域设置的周末（例如 zh 为 sat-sun，he 和 ar-SA 为 fri-sat）。
+  --workdays-only      跳过周末，同时跳过 -i 指定的星期。
+  --only <星期>        只保留这些星期，代码同 -i（例如 周一 周三）。
+  --days <列表>        只保留每月的这些日，负数从月末倒数（例如 1,15,-1 或 1-7）。
+  --months <列表>      只保留这些月份，可用代码、该语言的名称或数字（例如 jan,apr,jul,oct 或 nov-feb）。
+  --weeks <列表>       只保留这些 ISO 周（例如 1-10）。
+  --doy <列表>         只保留一年中的这些天，负数从年末倒数（例如 1,100,-1）。
+                       筛选条件可以组合，日期必须全部满足。
+  --where <表达式>     只保留使表达式为真的日期（见下文）。
+  --holidays <文件>    每行一个日期（YYYY-MM-DD）的文件，供 --where 中的 holiday 使用，
+                       日期后可跟一个名称，由 info 输出。
+  --offset <n>         跳过通过筛选的前 n 个日期。
+  --every <n>          在通过筛选的日期中每 n 个保留一个，从 --offset 之后开始。
+  --first <n>          保留前 n 个日期。
+  --last <n>           保留最后 n 个日期。
+  --sample <n>         随机抽取 n 个日期，保持原有顺序。
+  --seed <种子>        每次抽取相同的 --sample 样本。
+                       这些选项在筛选之后、-r 之前按此顺序应用。
+  --union <区间>       加入这些区间的日期。
+  --intersect <区间>   只保留这些区间内的日期。
+  --minus <区间>       去掉这些区间内的日期。
+                       它们在筛选之前按此顺序应用，并接收其后的所有区间。
+  -, --stdin           从标准输入逐行读取日期和区间。
+  -h, --help           显示本帮助。
+  -v, --version        显示版本
+
+-i 的星期代码（该语言的缩写也可以使用，例如 周一 周二）：
+  mo  星期一
+  tu  星期二
+  we  星期三
+  th  星期四
+  fr  星期五
+  sa  星期六
+  su  星期日
+
+-f 的占位符：
+  {YYYY}  完整年份（例如 2025）
+  {YY}    年份的后两位（例如 25）
+  {MM}    带前导零的月份（例如 12）
+  {M}     不带前导零的月份（例如 12）
+  {DD}    带前导零的日（例如 07）
+  {D}     不带前导零的日（例如 7）
+  {MN}    月份全称（例如 十二月）
+  {MNg}   日期中的月份名称，俄语中为属格（例如 декабря）
+  {mn}    月份缩写（例如 12月）
+  {mn1}   月份窄名称（例如 12）
+  {WD}    星期全称（例如 星期日）
+  {wd}    星期缩写（例如 周日）
+  {wd1}   星期窄名称（例如 日）
+  {WW}    带前导零的 ISO 周（例如 49）
+  {GGGG}  ISO 周编号所属的年份（例如 2025）
+  {ww}    区域设置的周数，带前导零，美国的第 1 周包含 1 月 1 日，欧洲大部分地区的包含 1 月 4 日（例如 50）
+  {gggg}  区域设置周编号所属的年份（例如 2025）
+  {DOY}   带前导零的年内天数（例如 341）
+  {Q}     季度（例如 4）
+  {Do}    序数形式的日（例如 7日）
+  {U}     以秒计的 Unix 时间戳，整日时为该时区的午夜（例如 1765065600）
+  {JDN}   儒略日数（例如 2461017）
+  {N}     ISO 星期，星期一 = 1（例如 7）
+  {idx}   在输出中的位置，从 1 开始
+  {hh}    小时（00-23）
+  {mm}    分钟（00-59）
+  {ss}    秒（00-59）
+  {HH12}  12 小时制的小时（01-12）
+  {AMPM}  上午或下午（例如 上午）
+  {Z}     时区与 UTC 的偏移（例如 +08:00）
+  {ERA}   日本年号或中国干支纪年（例如 乙巳）
+
+-f 的占位符修饰符（例如 {WD:len=2:upper}）：
+  upper   大写
+  lower   小写
+  title   首字母大写（例如 -l fr 时 {WD:title} 输出 Dimanche）
+  padN    补足到 N 个字符（例如 {D:pad3} 输出 007）
+  len=N   截取前 N 个字符（例如 {WD:len=2} 输出 星期）
+  用 {{ 和 }} 输出字面的 { 或 }。
+
+--where 的表达式（例如 'weekday in (mo,fr) and day <= 7 and not holiday'）：
+  比较    = != < <= > >= 以及 in (...) 或 not in (...)，可用数字、代码（mo..su、jan..dec）和范围（1..7、nov..feb）
+  条件    and、or、not 和括号
+  数值    year month day weekday (mo = 1) week (ISO) quarter doy hour minute second daysInMonth nthWeekday
+  判断    isFirstWeekdayOfMonth isLastWeekdayOfMonth isLastDayOfMonth isLeapYear weekend holiday
+
+--format-style 的格式风格：
+  placeholder  {YYYY}-{MM}-{DD}（默认）
+  strftime     %Y-%m-%d，支持 %Y %y %m %-m %d %-d %a %A %b %h %B %F %D %j %V %G %u %q %s
+               %H %M %S %I %p %T %R %n %t %%
+  go           2006-01-02，支持 2006 06 01 1 02 2 002 Jan January Mon Monday 15 03 04 05 PM pm
+
+--calendar 的历法（影响 {YYYY} {YY} {MM} {M} {DD} {D} {Do} {MN} {mn}）：
+  gregorian  默认，公历
+  julian     前推儒略历
+  islamic    表格式伊斯兰历
+  hebrew     希伯来历，月份从提斯利月起编号
+  persian    伊朗太阳历
+  buddhist   泰国佛历
+  japanese   公历月份，年份为日本年号纪年
+  chinese    农历，{YYYY} 为该农历年开始时所在的公历年
+
+-l 的语言（加上地区可使用地区名称，例如 de-AT、es-MX、fr-CA、ar-MA、zh-TW）：
+  ar 阿拉伯语   bg 保加利亚语 ca 加泰罗尼亚语 cs 捷克语   da 丹麦语     de 德语
+  el 希腊语     en 英语       es 西班牙语   et 爱沙尼亚语 fa 波斯语     fi 芬兰语
+  fr 法语       gsw 瑞士德语  he 希伯来语   hi 印地语     hr 克罗地亚语 hu 匈牙利语
+  id 印度尼西亚语 it 意大利语 ja 日语       ko 韩语       lt 立陶宛语   lv 拉脱维亚语
+  nb 挪威语     nl 荷兰语     pl 波兰语     pt 葡萄牙语   ro 罗马尼亚语 ru 俄语
+  sk 斯洛伐克语 sl 斯洛文尼亚语 sv 瑞典语   th 泰语       tr 土耳其语   uk 乌克兰语
+  vi 越南语     zh 中文       zh-Hant 繁体中文
+  ch 仍可用于瑞士德语。
+
+示例：
+  pdate 2025-10-02
+    输出从 2025 年 10 月 2 日到今天的所有日期。
+
+  pdate 2025-10-02 2025-11-30
+    输出 2025 年 10 月 2 日到 11 月 30 日的所有日期。
+
+  pdate -i 周一 周二 2025-10-02 2025-11-30
+    输出日期，跳过星期一和星期二。
+
+  pdate -i 周一 周二 周五 周六 周日 -r 2025-10-02 2025-11-30
+    倒序输出日期，跳过周一、周二、周五、周六、周日。
+
+  pdate -f "{YYYY}年{M}月{D}日 {WD}" 2025-10-02 2025-10-10
+    输出格式化的日期，例如 2025年10月2日 星期四
+
+  pdate --count 20 -i 周六 周日 2025-10-02
+    输出从 2025 年 10 月 2 日起的 20 个工作日。
+
+  pdate --count 20 --workdays-only --weekend fri-sat 2025-10-02
+    输出周末为星期五和星期六时接下来的 20 个工作日。
+
+  pdate --grid --workdays-only --week-start mo 2025-10-01 2025-10-31
+    把 2025 年 10 月的工作日输出为从星期一到星期日的日历。
+
+  pdate --count 12 --days 15,-1 2025-01-01
+    输出 2025 年上半年每月的 15 日和最后一天。
+
+  pdate --where 'weekday = fr and isLastWeekdayOfMonth' 2025-01-01 2025-12-31
+    输出 2025 年每个月的最后一个星期五。
+
+  pdate --sample 10 --seed 42 2025-01-01 2025-12-31
+    输出 2025 年的 10 个随机日期，每次运行结果相同。
+
+  pdate 2025-08-18..2025-12-19 2026-01-05..2026-03-27 --minus 2025-10-06..2025-10-17
+    输出两个学期的日期，去掉秋假。
+
+  git log --format=%as | pdate - -i 周六 周日 -f "{YYYY}年{M}月{D}日"
+    重新格式化在工作日所做提交的日期。
+
+  pdate reformat --in "{YYYY}-{MM}-{DD}" -f "{YYYY}年{M}月{D}日" -l zh < CHANGELOG.md
+    把更新日志中的日期改写为中文格式，其余文本保持不变。
+
+  pdate info 2025-12-25 --holidays holidays.txt
+    输出 2025 年圣诞节的星期、周数、年内天数和节假日，以及距今多久。
+
+  pdate --step 15m 2025-10-02T08:00 2025-10-02T18:00
+    从 08:00 到 18:00 每 15 分钟输出一个时间段。
+
+  pdate --format-style strftime -f "%Y/%m/%d (%a)" 2025-10-02 2025-10-10
+    用 strftime 格式字符串输出同样的日期。
+
+  pdate --calendar chinese -f "{ERA}年{MN}{D}日" 2025-12-01 2025-12-07
+    用农历输出日期，例如 乙巳年十月12日
+
+  pdate -l zh --digits native -f "{YYYY}年{MN}{D}日" 2025-12-01 2025-12-07
+    用中文数字输出日期，例如 二〇二五年十二月一日
+`
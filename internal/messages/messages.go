@@ -0,0 +1,196 @@
+// Package messages translates the help text and the error messages of pdate
+// into the language chosen with -l or taken from the environment.
+package messages
+
+import (
+	"errors"
+	"pdate/internal/constants"
+	"pdate/internal/locale"
+)
+
+// Message is an error message of pdate. The English text is the key of its
+// translations in the catalog, so a Message prints in English until it is
+// translated with Translate.
+type Message string
+
+func (m Message) Error() string {
+	return string(m)
+}
+
+// FileError is a Message about the file at Path, which is printed before the
+// message.
+type FileError struct {
+	Path    string
+	Message Message
+}
+
+func (e FileError) Error() string {
+	return e.Path + ": " + string(e.Message)
+}
+
+const (
+	InvalidOption               Message = "invalid option given for no flag"
+	NoIgnoredWeekdays           Message = "no weekdays for ignoring provided"
+	InvalidWeekday              Message = "error while trying to parse a weekday"
+	WrongFormatArgs             Message = "wrong format args given"
+	WrongFormatStyleArgs        Message = "wrong number of format style args given"
+	UnknownFormatStyle          Message = "unknown format style detected"
+	ReverseArgs                 Message = "reverse flag doesn't have arguments"
+	WrongLanguageArgs           Message = "wrong number language args given"
+	UnknownLanguage             Message = "unknown language detected"
+	WrongLocaleFileArgs         Message = "wrong number locale file args given"
+	WrongCountArgs              Message = "wrong number of count args given"
+	InvalidCount                Message = "count has to be a positive number"
+	WrongTimezoneArgs           Message = "wrong number of timezone args given"
+	UnknownTimezone             Message = "unknown timezone detected"
+	WrongStepArgs               Message = "wrong number of step args given"
+	InvalidStep                 Message = "invalid step detected"
+	WrongCalendarArgs           Message = "wrong number of calendar args given"
+	UnknownCalendar             Message = "unknown calendar detected"
+	WrongDigitsArgs             Message = "wrong number of digits args given"
+	UnknownDigits               Message = "unknown digits detected"
+	WrongWeekStartArgs          Message = "wrong number of week start args given"
+	UnknownWeekStart            Message = "unknown week start detected"
+	WrongWeekendArgs            Message = "wrong number of weekend args given"
+	UnknownWeekend              Message = "unknown weekend detected"
+	WorkdaysOnlyArgs            Message = "workdays only flag doesn't have arguments"
+	NoFilterValues              Message = "no values for the filter provided"
+	InvalidDay                  Message = "invalid day of month detected"
+	InvalidMonth                Message = "invalid month detected"
+	InvalidWeek                 Message = "invalid week detected"
+	InvalidDayOfYear            Message = "invalid day of year detected"
+	NoWhereExpression           Message = "no where expression provided"
+	InvalidExpression           Message = "invalid where expression"
+	UnknownName                 Message = "unknown name in the where expression"
+	MismatchedTypes             Message = "numbers, codes and conditions mixed up in the where expression"
+	WrongHolidaysArgs           Message = "wrong number of holidays args given"
+	InvalidHoliday              Message = "invalid holiday date detected"
+	UnreadableFile              Message = "the file can't be read"
+	WrongOffsetArgs             Message = "wrong number of offset args given"
+	InvalidOffset               Message = "offset can't be negative"
+	WrongEveryArgs              Message = "wrong number of every args given"
+	InvalidEvery                Message = "every has to be a positive number"
+	WrongFirstArgs              Message = "wrong number of first args given"
+	InvalidFirst                Message = "first has to be a positive number"
+	WrongLastArgs               Message = "wrong number of last args given"
+	InvalidLast                 Message = "last has to be a positive number"
+	WrongSampleArgs             Message = "wrong number of sample args given"
+	InvalidSample               Message = "sample has to be a positive number"
+	WrongSeedArgs               Message = "wrong number of seed args given"
+	InvalidSeed                 Message = "seed has to be a number"
+	SeedWithoutSample           Message = "seed can't be used without sample"
+	InvalidRange                Message = "invalid range detected"
+	NoRanges                    Message = "no ranges for the set operation provided"
+	DatesWithRanges             Message = "dates can't be combined with ranges"
+	StdinArgs                   Message = "stdin flag doesn't have arguments"
+	InvalidInput                Message = "invalid date or range in the input"
+	StdinWithDates              Message = "stdin can't be combined with dates, ranges or a count"
+	WrongInArgs                 Message = "wrong number of in args given"
+	NoInputFormat               Message = "reformat needs the format of the dates with --in"
+	InputFormatWithoutReformat  Message = "--in can only be used with reformat"
+	ReformatWithDates           Message = "reformat can't be combined with dates, ranges, a count or stdin"
+	UnsupportedInputPlaceholder Message = "placeholder can't be read from the text"
+	IncompleteInputFormat       Message = "the --in format needs a year, a month and a day"
+	InfoWithDates               Message = "info takes a single date"
+	JSONArgs                    Message = "json flag doesn't have arguments"
+	JSONWithoutInfo             Message = "--json can only be used with info"
+	InfoWithCount               Message = "info can't be combined with a count"
+	InfoWithFilters             Message = "info can't be combined with filters, selections, -r or --step"
+	GridArgs                    Message = "grid flag doesn't have arguments"
+	GridWithCommand             Message = "--grid can only be used to print dates"
+	GridWithFormat              Message = "--grid can't be combined with -f, --calendar or -r"
+	UnknownFlag                 Message = "found unknown flag"
+	DuplicateFlag               Message = "found duplicate flag argument"
+	WrongNumberOfDates          Message = "wrong number of dates provided"
+	DatesNotNextToEachOther     Message = "the two dates are not next to each other"
+	DatesBetweenOptions         Message = "dates are in between options"
+	DoubleWeekday               Message = "double weekdays for ignore -i flag detected"
+	CountWithEndDate            Message = "count can't be combined with an end date"
+	CountWithoutWeekdays        Message = "count can't be reached when all weekdays are ignored"
+	CountWithoutMatches         Message = "count can't be reached when no date matches the filters"
+	UnsupportedStrftime         Message = "unsupported strftime directive found"
+	UnsupportedGoLayout         Message = "unsupported go layout element found"
+	UnterminatedPlaceholder     Message = "unterminated placeholder found"
+	UnknownPlaceholder          Message = "unknown placeholder found"
+	InvalidPadModifier          Message = "invalid pad modifier found"
+	InvalidLenModifier          Message = "invalid len modifier found"
+	UnknownModifier             Message = "unknown placeholder modifier found"
+)
+
+// The labels and values of the record of info, which are translated like
+// the messages.
+const (
+	InfoDate      Message = "Date"
+	InfoWeekday   Message = "Weekday"
+	InfoISOWeek   Message = "ISO week"
+	InfoDayOfYear Message = "Day of year"
+	InfoQuarter   Message = "Quarter"
+	InfoFromToday Message = "From today"
+	InfoLeapYear  Message = "Leap year"
+	InfoHolidays  Message = "Holidays"
+	InfoToday     Message = "today"
+	InfoTomorrow  Message = "tomorrow"
+	InfoYesterday Message = "yesterday"
+	InfoInDays    Message = "in %d days"
+	InfoDaysAgo   Message = "%d days ago"
+	InfoYes       Message = "yes"
+	InfoNo        Message = "no"
+)
+
+// languageFallbacks are the languages whose speakers read the messages of
+// another language.
+var languageFallbacks = map[string]string{
+	"gsw": "de",
+}
+
+// helpMessages are the translations of constants.HelpMessage.
+var helpMessages = map[string]string{
+	"de": helpDE,
+	"fr": helpFR,
+	"es": helpES,
+	"it": helpIT,
+	"pt": helpPT,
+	"nl": helpNL,
+	"ru": helpRU,
+	"pl": helpPL,
+	"zh": helpZH,
+	"ja": helpJA,
+	"ar": helpAR,
+	"hi": helpHI,
+}
+
+// Translate returns the message of err in the language of the tag. Errors
+// which are no Message and messages without translation stay English.
+func Translate(err error, tag string) string {
+	var fileErr FileError
+	if errors.As(err, &fileErr) {
+		return fileErr.Path + ": " + Translate(fileErr.Message, tag)
+	}
+	var m Message
+	if !errors.As(err, &m) {
+		return err.Error()
+	}
+	if text, found := catalog[language(tag)][m]; found {
+		return text
+	}
+	return string(m)
+}
+
+// Help returns the help text in the language of the tag, or in English.
+func Help(tag string) string {
+	if text, found := helpMessages[language(tag)]; found {
+		return text
+	}
+	return constants.HelpMessage
+}
+
+func language(tag string) string {
+	l, found := locale.Lookup(tag)
+	if !found {
+		return ""
+	}
+	if fallback, found := languageFallbacks[l.Language]; found {
+		return fallback
+	}
+	return l.Language
+}
@@ -0,0 +1,121 @@
+package messages
+
+import (
+	"errors"
+	"fmt"
+	"pdate/internal/constants"
+	"strings"
+	"testing"
+)
+
+var allMessages = []Message{
+	InvalidOption, NoIgnoredWeekdays, InvalidWeekday, WrongFormatArgs, WrongFormatStyleArgs,
+	UnknownFormatStyle, ReverseArgs, WrongLanguageArgs, UnknownLanguage, WrongLocaleFileArgs,
+	WrongCountArgs, InvalidCount, WrongTimezoneArgs, UnknownTimezone, WrongStepArgs, InvalidStep,
+	WrongCalendarArgs, UnknownCalendar, WrongDigitsArgs, UnknownDigits, WrongWeekStartArgs,
+	UnknownWeekStart, WrongWeekendArgs, UnknownWeekend, WorkdaysOnlyArgs,
+	NoFilterValues, InvalidDay, InvalidMonth, InvalidWeek, InvalidDayOfYear,
+	NoWhereExpression, InvalidExpression, UnknownName, MismatchedTypes, WrongHolidaysArgs, InvalidHoliday, UnreadableFile,
+	WrongOffsetArgs, InvalidOffset, WrongEveryArgs, InvalidEvery, WrongFirstArgs, InvalidFirst,
+	WrongLastArgs, InvalidLast, WrongSampleArgs, InvalidSample, WrongSeedArgs, InvalidSeed, SeedWithoutSample,
+	InvalidRange, NoRanges, DatesWithRanges, StdinArgs, InvalidInput, StdinWithDates,
+	WrongInArgs, NoInputFormat, InputFormatWithoutReformat, ReformatWithDates, UnsupportedInputPlaceholder,
+	IncompleteInputFormat, InfoWithDates, JSONArgs, JSONWithoutInfo, InfoWithCount, InfoWithFilters,
+	GridArgs, GridWithCommand, GridWithFormat,
+	InfoDate, InfoWeekday, InfoISOWeek, InfoDayOfYear, InfoQuarter, InfoFromToday, InfoLeapYear, InfoHolidays,
+	InfoToday, InfoTomorrow, InfoYesterday, InfoInDays, InfoDaysAgo, InfoYes, InfoNo,
+	UnknownFlag, DuplicateFlag,
+	WrongNumberOfDates, DatesNotNextToEachOther, DatesBetweenOptions, DoubleWeekday,
+	CountWithEndDate, CountWithoutWeekdays, CountWithoutMatches, UnsupportedStrftime, UnsupportedGoLayout,
+	UnterminatedPlaceholder, UnknownPlaceholder, InvalidPadModifier, InvalidLenModifier,
+	UnknownModifier,
+}
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		tag      string
+		expected string
+	}{
+		{"English", UnknownLanguage, "en", "unknown language detected"},
+		{"German", UnknownLanguage, "de", "unbekannte Sprache erkannt"},
+		{"Region", InvalidCount, "fr-CA", "le nombre de dates doit être un nombre positif"},
+		{"Swiss German falls back to German", DuplicateFlag, "gsw", "doppeltes Flag gefunden"},
+		{"Language without catalog", UnknownFlag, "sv", "found unknown flag"},
+		{"Unknown tag", UnknownFlag, "xx", "found unknown flag"},
+		{"Wrapped message", fmt.Errorf("-f: %w", UnknownPlaceholder), "es", "marcador desconocido"},
+		{"File error", FileError{"feiertage.txt", UnreadableFile}, "de", "feiertage.txt: die Datei kann nicht gelesen werden"},
+		{"Other errors stay untouched", errors.New("line 2: unterminated list"), "de", "line 2: unterminated list"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Translate(tt.err, tt.tag); result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCatalogIsComplete(t *testing.T) {
+	for language, translations := range catalog {
+		for _, m := range allMessages {
+			if translations[m] == "" {
+				t.Errorf("%s: missing translation of %q", language, m)
+			}
+		}
+		if len(translations) != len(allMessages) {
+			t.Errorf("%s: expected %d translations, got %d", language, len(allMessages), len(translations))
+		}
+	}
+}
+
+func TestHelp(t *testing.T) {
+	tests := []struct {
+		tag      string
+		contains string
+	}{
+		{"de", "Verwendung:"},
+		{"de-CH", "  so  Sonntag"},
+		{"gsw", "Verwendung:"},
+		{"fr", "  di  dimanche"},
+		{"es", "  do  domingo"},
+		{"pt-BR", "Uso:"},
+		{"nl", "  zo  zondag"},
+		{"ru", "Использование:"},
+		{"zh-Hant", "用法："},
+		{"ja", "使い方:"},
+		{"ar", "الاستخدام:"},
+		{"hi", "उपयोग:"},
+		{"ko", "Usage:"},
+		{"", "Usage:"},
+	}
+
+	for _, tt := range tests {
+		if help := Help(tt.tag); !strings.Contains(help, tt.contains) {
+			t.Errorf("Help(%q) doesn't contain %q", tt.tag, tt.contains)
+		}
+	}
+	if Help("en") != constants.HelpMessage {
+		t.Errorf("expected the English help")
+	}
+}
+
+// TestHelpTranslationsListEveryFlag keeps the translated help texts in step
+// with the English one.
+func TestHelpTranslationsListEveryFlag(t *testing.T) {
+	var flags []string
+	for _, line := range strings.Split(constants.HelpMessage, "\n") {
+		if field, found := strings.CutPrefix(strings.TrimSpace(line), "-"); found {
+			flags = append(flags, "-"+strings.Fields(field)[0])
+		}
+	}
+	for language, help := range helpMessages {
+		for _, flag := range flags {
+			if !strings.Contains(help, "\n  "+flag) {
+				t.Errorf("%s: the help doesn't describe %s", language, flag)
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"iter"
+	"pdate/internal/job"
+	"pdate/internal/messages"
+	"strings"
+)
+
+// lineInput reads a date or a range in the formats of the arguments from
+// every line. Empty lines are skipped, a single date is a range of one day.
+type lineInput struct {
+	reader io.Reader
+	err    error
+}
+
+// NewInput reads the dates and ranges of - and --stdin from the reader. The
+// lines are read while the dates are printed, so pdate can filter the
+// output of other tools as it arrives.
+func NewInput(reader io.Reader) job.Input {
+	return &lineInput{reader: reader}
+}
+
+func (in *lineInput) Ranges() iter.Seq[job.Range] {
+	return func(yield func(job.Range) bool) {
+		scanner := bufio.NewScanner(in.reader)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" {
+				continue
+			}
+			r, err := parseInputLine(line)
+			if err != nil {
+				in.err = err
+				return
+			}
+			if !yield(r) {
+				return
+			}
+		}
+		in.err = scanner.Err()
+	}
+}
+
+func (in *lineInput) Err() error {
+	return in.err
+}
+
+func parseInputLine(line string) (job.Range, error) {
+	if r, err := ParseRange(line); err == nil {
+		return r, nil
+	}
+	date, err := ParseDate(line)
+	if err != nil {
+		return job.Range{}, messages.InvalidInput
+	}
+	return job.Range{From: date, To: date}, nil
+}
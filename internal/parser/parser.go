@@ -2,48 +2,203 @@ package parser
 
 import (
 	"errors"
+	"os"
 	"pdate/internal/constants"
 	"pdate/internal/job"
+	"pdate/internal/locale"
+	"pdate/internal/messages"
+	"strconv"
+	"strings"
 	"time"
 )
 
 type Sorted struct {
 	options     map[flag][]string
 	dates       []time.Time
+	ranges      []job.Range
 	argumentPos []job.Argument
 }
 
 type flag int
 
+// The options are parsed in the order of the flags, so the locale file and
+// the language come first and -i, --only, --months and --week-start can read
+// the weekday and month names of the language.
 const (
-	Ignore flag = iota
+	LocaleFile flag = iota
+	Language
+	Ignore
+	Only
+	Days
+	Months
+	Weeks
+	DaysOfYear
+	Holidays
+	Where
+	Offset
+	Every
+	First
+	Last
+	Sample
+	Seed
+	Union
+	Intersect
+	Minus
+	Stdin
+	WeekStart
 	Reverse
 	Format
-	Language
+	InputFormat
+	Style
+	Count
+	Timezone
+	StepSize
+	CalendarSystem
+	DigitSystem
+	Weekend
+	WorkdaysOnly
+	JSON
+	Grid
 	Version
 	Help
 	Invalid
 )
 
 var strToOption = map[string]flag{
-	"-i":        Ignore,
-	"-r":        Reverse,
-	"-f":        Format,
-	"-l":        Language,
-	"-v":        Version,
-	"--version": Version,
-	"-h":        Help,
-	"--help":    Help,
+	"-i":              Ignore,
+	"-r":              Reverse,
+	"-f":              Format,
+	"--in":            InputFormat,
+	"--json":          JSON,
+	"--grid":          Grid,
+	"--format-style":  Style,
+	"-l":              Language,
+	"--locale-file":   LocaleFile,
+	"--count":         Count,
+	"--limit":         Count,
+	"--tz":            Timezone,
+	"--step":          StepSize,
+	"--calendar":      CalendarSystem,
+	"--digits":        DigitSystem,
+	"--week-start":    WeekStart,
+	"--weekend":       Weekend,
+	"--workdays-only": WorkdaysOnly,
+	"--only":          Only,
+	"--days":          Days,
+	"--months":        Months,
+	"--weeks":         Weeks,
+	"--doy":           DaysOfYear,
+	"--holidays":      Holidays,
+	"--where":         Where,
+	"--offset":        Offset,
+	"--every":         Every,
+	"--first":         First,
+	"--last":          Last,
+	"--sample":        Sample,
+	"--seed":          Seed,
+	"--union":         Union,
+	"--intersect":     Intersect,
+	"--minus":         Minus,
+	"-":               Stdin,
+	"--stdin":         Stdin,
+	"-v":              Version,
+	"--version":       Version,
+	"-h":              Help,
+	"--help":          Help,
 }
 
 var optionToJobFunc = map[flag]func([]string, *job.Job) error{
-	Ignore:   ParseIgnore,
-	Reverse:  ParseReverse,
-	Format:   ParseFormat,
-	Language: ParseLanguage,
-	Version:  ParseVersion,
-	Help:     ParseHelp,
-	Invalid:  ParseInvalid,
+	Ignore:         ParseIgnore,
+	Reverse:        ParseReverse,
+	Format:         ParseFormat,
+	InputFormat:    ParseInputFormat,
+	JSON:           ParseJSON,
+	Grid:           ParseGrid,
+	Style:          ParseFormatStyle,
+	Language:       ParseLanguage,
+	LocaleFile:     ParseLocaleFile,
+	Count:          ParseCount,
+	Timezone:       ParseTimezone,
+	StepSize:       ParseStep,
+	CalendarSystem: ParseCalendar,
+	DigitSystem:    ParseDigits,
+	WeekStart:      ParseWeekStart,
+	Weekend:        ParseWeekend,
+	WorkdaysOnly:   ParseWorkdaysOnly,
+	Only:           ParseOnly,
+	Days:           ParseDays,
+	Months:         ParseMonths,
+	Weeks:          ParseWeeks,
+	DaysOfYear:     ParseDaysOfYear,
+	Holidays:       ParseHolidays,
+	Where:          ParseWhere,
+	Offset:         ParseOffset,
+	Every:          ParseEvery,
+	First:          ParseFirst,
+	Last:           ParseLast,
+	Sample:         ParseSample,
+	Seed:           ParseSeed,
+	Union:          ParseUnion,
+	Intersect:      ParseIntersect,
+	Minus:          ParseMinus,
+	Stdin:          ParseStdin,
+	Version:        ParseVersion,
+	Help:           ParseHelp,
+	Invalid:        ParseInvalid,
+}
+
+// localWeekdayCodes are the two letter -i codes of languages whose
+// abbreviations are longer than two letters. Swiss German uses the German
+// codes.
+var localWeekdayCodes = map[string]map[string]time.Weekday{
+	"de":  germanWeekdayCodes,
+	"gsw": germanWeekdayCodes,
+	"fr": {
+		"lu": time.Monday,
+		"ma": time.Tuesday,
+		"me": time.Wednesday,
+		"je": time.Thursday,
+		"ve": time.Friday,
+		"sa": time.Saturday,
+		"di": time.Sunday,
+	},
+	"es": {
+		"lu": time.Monday,
+		"ma": time.Tuesday,
+		"mi": time.Wednesday,
+		"ju": time.Thursday,
+		"vi": time.Friday,
+		"sa": time.Saturday,
+		"do": time.Sunday,
+	},
+	"it": {
+		"lu": time.Monday,
+		"ma": time.Tuesday,
+		"me": time.Wednesday,
+		"gi": time.Thursday,
+		"ve": time.Friday,
+		"sa": time.Saturday,
+		"do": time.Sunday,
+	},
+	"nl": {
+		"ma": time.Monday,
+		"di": time.Tuesday,
+		"wo": time.Wednesday,
+		"do": time.Thursday,
+		"vr": time.Friday,
+		"za": time.Saturday,
+		"zo": time.Sunday,
+	},
+}
+
+var germanWeekdayCodes = map[string]time.Weekday{
+	"mo": time.Monday,
+	"di": time.Tuesday,
+	"mi": time.Wednesday,
+	"do": time.Thursday,
+	"fr": time.Friday,
+	"sa": time.Saturday,
+	"so": time.Sunday,
 }
 
 var strToWeekday = map[string]time.Weekday{
@@ -56,58 +211,327 @@ var strToWeekday = map[string]time.Weekday{
 	"su": time.Sunday,
 }
 
-var strToLanguage = map[string]job.Language{
-	"en": job.English,
-	"fr": job.French,
-	"es": job.Spanish,
-	"de": job.German,
-	"ch": job.Swiss,
-	"it": job.Italian,
-	"pt": job.Portuguese,
-	"nl": job.Dutch,
-	"ru": job.Russian,
-	"zh": job.Chinese,
-	"ar": job.Arabic,
-	"hi": job.Hindi,
+var weekdayToWeekStart = map[time.Weekday]job.WeekStart{
+	time.Monday:   job.MondayStart,
+	time.Sunday:   job.SundayStart,
+	time.Saturday: job.SaturdayStart,
+}
+
+var strToWeekend = map[string]job.Weekend{
+	"sat-sun": job.SaturdaySundayWeekend,
+	"fri-sat": job.FridaySaturdayWeekend,
+	"fri":     job.FridayWeekend,
+	"sun":     job.SundayWeekend,
+}
+
+var strToMonth = map[string]time.Month{
+	"jan": time.January,
+	"feb": time.February,
+	"mar": time.March,
+	"apr": time.April,
+	"may": time.May,
+	"jun": time.June,
+	"jul": time.July,
+	"aug": time.August,
+	"sep": time.September,
+	"oct": time.October,
+	"nov": time.November,
+	"dec": time.December,
+}
+
+var setOperations = map[flag]bool{
+	Union:     true,
+	Intersect: true,
+	Minus:     true,
+}
+
+// strToCommand holds the subcommands, which are given as the first
+// argument.
+var strToCommand = map[string]job.Command{
+	"reformat": job.ReformatCommand,
+	"info":     job.InfoCommand,
+}
+
+var strToStepUnit = map[string]job.StepUnit{
+	"s": job.Second,
+	"m": job.Minute,
+	"h": job.Hour,
+	"d": job.Day,
+	"w": job.Week,
+}
+
+var strToDigits = map[string]job.Digits{
+	"latin":  job.LatinDigits,
+	"native": job.NativeDigits,
+}
+
+var strToCalendar = map[string]job.Calendar{
+	"gregorian": job.GregorianCalendar,
+	"islamic":   job.IslamicCalendar,
+	"hebrew":    job.HebrewCalendar,
+	"persian":   job.PersianCalendar,
+	"buddhist":  job.BuddhistCalendar,
+	"japanese":  job.JapaneseCalendar,
+	"julian":    job.JulianCalendar,
+	"chinese":   job.ChineseCalendar,
+}
+
+var strToFormatStyle = map[string]job.FormatStyle{
+	"placeholder": job.Placeholder,
+	"strftime":    job.Strftime,
+	"go":          job.GoLayout,
 }
 
 func Parse(args []string, job *job.Job) error {
+	ParseTimezoneEnvironment(job)
+	ParseLanguageEnvironment(job)
+	if len(args) > 0 {
+		if command, found := strToCommand[args[0]]; found {
+			job.Command = command
+			args = args[1:]
+		}
+	}
 	sorted, err := SortOptions(args)
 	if err != nil {
 		return err
 	}
 	job.DatesInput = sorted.dates
+	job.Ranges = sorted.ranges
 	job.PosArguments = sorted.argumentPos
-	for key, value := range sorted.options {
-		parseMethod, found := optionToJobFunc[key]
-		if found {
-			jobError := parseMethod(value, job)
-			if jobError != nil {
-				return jobError
-			}
+	for option := range Invalid + 1 {
+		value, found := sorted.options[option]
+		if !found {
+			continue
+		}
+		jobError := optionToJobFunc[option](value, job)
+		if jobError != nil {
+			return jobError
 		}
 	}
 	return nil
 }
 
 func ParseInvalid(args []string, job *job.Job) error {
-	return errors.New("invalid option given for no flag")
+	return messages.InvalidOption
 }
 
-func ParseIgnore(args []string, job *job.Job) error {
+func ParseIgnore(args []string, j *job.Job) error {
 	if len(args) == 0 {
-		return errors.New("no weekdays for ignoring provided")
+		return messages.NoIgnoredWeekdays
 	}
+	l := locale.Get(string(j.Language))
 	for _, arg := range args {
-		weekday, valid := strToWeekday[arg]
+		weekday, valid := lookupWeekday(arg, l)
 		if !valid {
-			return errors.New("error while trying to parse a weekday")
+			return messages.InvalidWeekday
+		}
+		j.IgnoredWeekdays = append(j.IgnoredWeekdays, weekday)
+	}
+	return nil
+}
+
+// lookupWeekday resolves a -i code with the two letter codes of the
+// language, then with the abbreviations of the locale (e.g. lun or lun. in
+// French, case doesn't matter) and at last with the English codes, which
+// work in every language.
+func lookupWeekday(code string, l *locale.Locale) (time.Weekday, bool) {
+	code = strings.ToLower(code)
+	if weekday, found := localWeekdayCodes[l.Language][code]; found {
+		return weekday, true
+	}
+	if name := strings.TrimSuffix(code, "."); name != "" {
+		for weekday, abbreviation := range l.WeekdayAbbreviations {
+			if strings.TrimSuffix(strings.ToLower(abbreviation), ".") == name {
+				return time.Weekday(weekday), true
+			}
+		}
+	}
+	weekday, found := strToWeekday[code]
+	return weekday, found
+}
+
+// ParseOnly reads the weekdays --only keeps, with the same codes as -i.
+func ParseOnly(args []string, j *job.Job) error {
+	codes := splitList(args)
+	if len(codes) == 0 {
+		return messages.NoFilterValues
+	}
+	l := locale.Get(string(j.Language))
+	for _, code := range codes {
+		weekday, valid := lookupWeekday(code, l)
+		if !valid {
+			return messages.InvalidWeekday
+		}
+		j.OnlyWeekdays = append(j.OnlyWeekdays, weekday)
+	}
+	return nil
+}
+
+// ParseDays reads the days of the month --days keeps, like 1,15,-1 or 1-7.
+func ParseDays(args []string, job *job.Job) error {
+	days, err := parseNumbers(args, 31, messages.InvalidDay)
+	job.OnlyDays = days
+	return err
+}
+
+// ParseWeeks reads the ISO weeks --weeks keeps, like 1-10 or 1,27.
+func ParseWeeks(args []string, job *job.Job) error {
+	weeks, err := parseNumbers(args, 53, messages.InvalidWeek)
+	for _, week := range weeks {
+		if week < 0 {
+			return messages.InvalidWeek
+		}
+	}
+	job.OnlyWeeks = weeks
+	return err
+}
+
+// ParseDaysOfYear reads the days of the year --doy keeps, like 1,100 or -1.
+func ParseDaysOfYear(args []string, job *job.Job) error {
+	days, err := parseNumbers(args, 366, messages.InvalidDayOfYear)
+	job.OnlyDaysOfYear = days
+	return err
+}
+
+// ParseMonths reads the months --months keeps as English codes (jan feb
+// ...), names or abbreviations of the language, numbers or ranges like
+// jan-mar. Ranges may wrap around the end of the year, e.g. nov-feb.
+func ParseMonths(args []string, j *job.Job) error {
+	entries := splitList(args)
+	if len(entries) == 0 {
+		return messages.NoFilterValues
+	}
+	l := locale.Get(string(j.Language))
+	for _, entry := range entries {
+		first, last, isRange := strings.Cut(entry, "-")
+		if !isRange {
+			last = first
+		}
+		from, validFrom := lookupMonth(first, l)
+		to, validTo := lookupMonth(last, l)
+		if !validFrom || !validTo {
+			return messages.InvalidMonth
+		}
+		for month := from; ; month = month%12 + 1 {
+			j.OnlyMonths = append(j.OnlyMonths, month)
+			if month == to {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// ParseWhere reads the --where expression. Unquoted expressions are split
+// by the shell, so the arguments are joined again.
+func ParseWhere(args []string, job *job.Job) error {
+	expression := strings.TrimSpace(strings.Join(args, " "))
+	if expression == "" {
+		return messages.NoWhereExpression
+	}
+	job.Where = expression
+	return nil
+}
+
+// ParseHolidays reads the holidays of --where from a file with a date
+// (YYYY-MM-DD) per line. Text after the date, empty lines and comments
+// starting with # are skipped.
+func ParseHolidays(args []string, job *job.Job) error {
+	if len(args) != 1 {
+		return messages.WrongHolidaysArgs
+	}
+	content, err := os.ReadFile(args[0])
+	if err != nil {
+		return messages.FileError{Path: args[0], Message: messages.UnreadableFile}
+	}
+	for line := range strings.Lines(string(content)) {
+		line, _, _ = strings.Cut(line, "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		holiday, err := time.Parse(constants.ParseLayoutDate, fields[0])
+		if err != nil {
+			return messages.InvalidHoliday
 		}
-		job.IgnoredWeekdays = append(job.IgnoredWeekdays, weekday)
+		job.Holidays = append(job.Holidays, holiday)
+		job.HolidayNames = append(job.HolidayNames, strings.Join(fields[1:], " "))
 	}
 	return nil
 }
 
+// lookupMonth resolves a month like lookupWeekday resolves a weekday: by the
+// names and abbreviations of the locale, the English codes or its number.
+func lookupMonth(name string, l *locale.Locale) (time.Month, bool) {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	if name == "" {
+		return 0, false
+	}
+	for _, names := range [][]string{l.MonthNames, l.MonthAbbreviations} {
+		for i, monthName := range names {
+			if strings.TrimSuffix(strings.ToLower(monthName), ".") == name {
+				return time.Month(i + 1), true
+			}
+		}
+	}
+	if month, found := strToMonth[name]; found {
+		return month, true
+	}
+	number, err := strconv.Atoi(name)
+	if err != nil || number < 1 || number > 12 {
+		return 0, false
+	}
+	return time.Month(number), true
+}
+
+// splitList splits the arguments of a list option at commas as well, so
+// "1,15,-1" and "1 15 -1" are the same.
+func splitList(args []string) []string {
+	var entries []string
+	for _, arg := range args {
+		for entry := range strings.SplitSeq(arg, ",") {
+			if entry = strings.TrimSpace(entry); entry != "" {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	return entries
+}
+
+// parseNumbers reads a list of numbers from 1 to limit and ranges like
+// 1-10. Negative numbers down to -limit count from the end and can't be
+// used in ranges.
+func parseNumbers(args []string, limit int, invalid error) ([]int, error) {
+	entries := splitList(args)
+	if len(entries) == 0 {
+		return nil, messages.NoFilterValues
+	}
+	var numbers []int
+	for _, entry := range entries {
+		if strings.HasPrefix(entry, "-") {
+			number, err := strconv.Atoi(entry)
+			if err != nil || number < -limit || number > -1 {
+				return nil, invalid
+			}
+			numbers = append(numbers, number)
+			continue
+		}
+		first, last, isRange := strings.Cut(entry, "-")
+		if !isRange {
+			last = first
+		}
+		from, errFrom := strconv.Atoi(first)
+		to, errTo := strconv.Atoi(last)
+		if errFrom != nil || errTo != nil || from < 1 || to > limit || from > to {
+			return nil, invalid
+		}
+		for number := from; number <= to; number++ {
+			numbers = append(numbers, number)
+		}
+	}
+	return numbers, nil
+}
+
 func ParseHelp(args []string, job *job.Job) error {
 	job.Help = true
 	return nil
@@ -120,58 +544,394 @@ func ParseVersion(args []string, job *job.Job) error {
 
 func ParseFormat(args []string, job *job.Job) error {
 	if len(args) != 1 {
-		return errors.New("wrong format args given")
+		return messages.WrongFormatArgs
 	}
 	job.Format = args[0]
 	return nil
 }
 
+// ParseInputFormat takes the format of the dates reformat looks for, it's
+// written in the style of --format-style like -f.
+func ParseInputFormat(args []string, job *job.Job) error {
+	if len(args) != 1 {
+		return messages.WrongInArgs
+	}
+	job.InputFormat = args[0]
+	return nil
+}
+
+func ParseFormatStyle(args []string, job *job.Job) error {
+	if len(args) != 1 {
+		return messages.WrongFormatStyleArgs
+	}
+	style, found := strToFormatStyle[args[0]]
+	if !found {
+		return messages.UnknownFormatStyle
+	}
+	job.FormatStyle = style
+	return nil
+}
+
 func ParseReverse(args []string, job *job.Job) error {
 	if len(args) != 0 {
-		return errors.New("reverse flag doesn't have arguments")
+		return messages.ReverseArgs
 	}
 	job.Reversed = true
 	return nil
 }
 
-func ParseLanguage(args []string, job *job.Job) error {
+func ParseLanguage(args []string, j *job.Job) error {
+	if len(args) != 1 {
+		return messages.WrongLanguageArgs
+	}
+	l, found := locale.Lookup(args[0])
+	if !found {
+		return messages.UnknownLanguage
+	}
+	j.Language = job.Language(l.Tag)
+	return nil
+}
+
+// ParseLanguageEnvironment takes the language from the first of LC_ALL,
+// LC_TIME and LANG which is set, like date(1) does. Regions pdate doesn't
+// know fall back to the language, unknown languages and the C locale keep
+// the default.
+func ParseLanguageEnvironment(j *job.Job) {
+	for _, name := range []string{"LC_ALL", "LC_TIME", "LANG"} {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		if l, found := locale.Lookup(locale.POSIXTag(value)); found {
+			j.Language = job.Language(l.Tag)
+		}
+		return
+	}
+}
+
+func ParseLocaleFile(args []string, j *job.Job) error {
+	if len(args) != 1 {
+		return messages.WrongLocaleFileArgs
+	}
+	l, err := locale.LoadFile(args[0])
+	if errors.Is(err, locale.ErrUnreadableFile) {
+		return messages.FileError{Path: args[0], Message: messages.UnreadableFile}
+	}
+	if err != nil {
+		return err
+	}
+	j.Language = job.Language(l.Tag)
+	return nil
+}
+
+func ParseCount(args []string, job *job.Job) error {
+	if len(args) != 1 {
+		return messages.WrongCountArgs
+	}
+	count, err := strconv.Atoi(args[0])
+	if err != nil || count < 1 {
+		return messages.InvalidCount
+	}
+	job.Count = count
+	return nil
+}
+
+// ParseOffset reads how many dates --offset skips, before --every counts.
+func ParseOffset(args []string, job *job.Job) error {
+	offset, err := parseNumber(args, 0, messages.WrongOffsetArgs, messages.InvalidOffset)
+	job.Offset = offset
+	return err
+}
+
+func ParseEvery(args []string, job *job.Job) error {
+	every, err := parseNumber(args, 1, messages.WrongEveryArgs, messages.InvalidEvery)
+	job.Every = every
+	return err
+}
+
+func ParseFirst(args []string, job *job.Job) error {
+	first, err := parseNumber(args, 1, messages.WrongFirstArgs, messages.InvalidFirst)
+	job.First = first
+	return err
+}
+
+func ParseLast(args []string, job *job.Job) error {
+	last, err := parseNumber(args, 1, messages.WrongLastArgs, messages.InvalidLast)
+	job.Last = last
+	return err
+}
+
+func ParseSample(args []string, job *job.Job) error {
+	sample, err := parseNumber(args, 1, messages.WrongSampleArgs, messages.InvalidSample)
+	job.Sample = sample
+	return err
+}
+
+// ParseSeed reads the seed of --sample, the same seed draws the same dates.
+func ParseSeed(args []string, job *job.Job) error {
+	if len(args) != 1 {
+		return messages.WrongSeedArgs
+	}
+	seed, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return messages.InvalidSeed
+	}
+	job.Seed = seed
+	job.Seeded = true
+	return nil
+}
+
+// parseNumber reads the single number of an option, which has to be at
+// least minimum.
+func parseNumber(args []string, minimum int, wrongArgs error, invalid error) (int, error) {
+	if len(args) != 1 {
+		return 0, wrongArgs
+	}
+	number, err := strconv.Atoi(args[0])
+	if err != nil || number < minimum {
+		return 0, invalid
+	}
+	return number, nil
+}
+
+func ParseUnion(args []string, job *job.Job) error {
+	ranges, err := parseRanges(args)
+	job.Union = ranges
+	return err
+}
+
+func ParseIntersect(args []string, job *job.Job) error {
+	ranges, err := parseRanges(args)
+	job.Intersect = ranges
+	return err
+}
+
+func ParseMinus(args []string, job *job.Job) error {
+	ranges, err := parseRanges(args)
+	job.Minus = ranges
+	return err
+}
+
+func parseRanges(args []string) ([]job.Range, error) {
+	if len(args) == 0 {
+		return nil, messages.NoRanges
+	}
+	var ranges []job.Range
+	for _, arg := range args {
+		r, err := ParseRange(arg)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+// ParseRange reads a range like 2025-01-01..2025-01-31 of two dates in the
+// formats of ParseDate. The earlier date becomes the start.
+func ParseRange(arg string) (job.Range, error) {
+	first, last, found := strings.Cut(arg, "..")
+	if !found {
+		return job.Range{}, messages.InvalidRange
+	}
+	from, err := ParseDate(first)
+	if err != nil {
+		return job.Range{}, messages.InvalidRange
+	}
+	to, err := ParseDate(last)
+	if err != nil {
+		return job.Range{}, messages.InvalidRange
+	}
+	if to.Before(from) {
+		from, to = to, from
+	}
+	return job.Range{From: from, To: to}, nil
+}
+
+// ParseStdin reads the dates and ranges from standard input instead of the
+// arguments.
+func ParseStdin(args []string, job *job.Job) error {
+	if len(args) != 0 {
+		return messages.StdinArgs
+	}
+	job.Input = NewInput(os.Stdin)
+	return nil
+}
+
+func ParseTimezone(args []string, job *job.Job) error {
 	if len(args) != 1 {
-		return errors.New("wrong number language args given")
+		return messages.WrongTimezoneArgs
+	}
+	loc, err := time.LoadLocation(args[0])
+	if err != nil {
+		return messages.UnknownTimezone
 	}
-	lan, found := strToLanguage[args[0]]
+	job.Location = loc
+	return nil
+}
+
+// ParseTimezoneEnvironment reads the TZ environment variable the same way on
+// every platform (Go ignores it on Windows). A value which isn't a known
+// timezone name is skipped and --tz always wins.
+func ParseTimezoneEnvironment(job *job.Job) {
+	tz, found := os.LookupEnv("TZ")
 	if !found {
-		return errors.New("unknown language detected")
+		return
+	}
+	loc, err := time.LoadLocation(strings.TrimPrefix(tz, ":"))
+	if err == nil {
+		job.Location = loc
+	}
+}
+
+// ParseStep reads steps like 15m, 1h or 2d.
+func ParseStep(args []string, job *job.Job) error {
+	if len(args) != 1 {
+		return messages.WrongStepArgs
+	}
+	value := args[0]
+	if len(value) < 2 {
+		return messages.InvalidStep
 	}
-	job.Language = lan
+	unit, found := strToStepUnit[value[len(value)-1:]]
+	amount, err := strconv.Atoi(value[:len(value)-1])
+	if !found || err != nil || amount < 1 {
+		return messages.InvalidStep
+	}
+	job.Step.Amount = amount
+	job.Step.Unit = unit
 	return nil
 }
 
+func ParseCalendar(args []string, job *job.Job) error {
+	if len(args) != 1 {
+		return messages.WrongCalendarArgs
+	}
+	calendar, found := strToCalendar[args[0]]
+	if !found {
+		return messages.UnknownCalendar
+	}
+	job.Calendar = calendar
+	return nil
+}
+
+func ParseDigits(args []string, job *job.Job) error {
+	if len(args) != 1 {
+		return messages.WrongDigitsArgs
+	}
+	digits, found := strToDigits[args[0]]
+	if !found {
+		return messages.UnknownDigits
+	}
+	job.Digits = digits
+	return nil
+}
+
+// ParseWeekStart reads the first day of the week as a weekday code like -i
+// does. Weeks can start on Monday, Sunday or Saturday.
+func ParseWeekStart(args []string, j *job.Job) error {
+	if len(args) != 1 {
+		return messages.WrongWeekStartArgs
+	}
+	weekday, found := lookupWeekday(args[0], locale.Get(string(j.Language)))
+	start, valid := weekdayToWeekStart[weekday]
+	if !found || !valid {
+		return messages.UnknownWeekStart
+	}
+	j.WeekStart = start
+	return nil
+}
+
+func ParseWeekend(args []string, job *job.Job) error {
+	if len(args) != 1 {
+		return messages.WrongWeekendArgs
+	}
+	weekend, found := strToWeekend[args[0]]
+	if !found {
+		return messages.UnknownWeekend
+	}
+	job.Weekend = weekend
+	return nil
+}
+
+func ParseWorkdaysOnly(args []string, job *job.Job) error {
+	if len(args) != 0 {
+		return messages.WorkdaysOnlyArgs
+	}
+	job.WorkdaysOnly = true
+	return nil
+}
+
+func ParseJSON(args []string, job *job.Job) error {
+	if len(args) != 0 {
+		return messages.JSONArgs
+	}
+	job.JSON = true
+	return nil
+}
+
+func ParseGrid(args []string, job *job.Job) error {
+	if len(args) != 0 {
+		return messages.GridArgs
+	}
+	job.Grid = true
+	return nil
+}
+
+// ParseDate reads a date with an optional time, e.g. 2025-10-02 or
+// 2025-10-02T08:00.
+func ParseDate(arg string) (time.Time, error) {
+	layout := constants.ParseLayoutDate
+	switch strings.Count(arg, ":") {
+	case 1:
+		layout = constants.ParseLayoutDateTime
+	case 2:
+		layout = constants.ParseLayoutDateTimeSeconds
+	}
+	return time.Parse(layout, arg)
+}
+
+// isFlag reports whether the argument is a flag. Negative numbers like the
+// -1 of --days 15 -1 are values.
+func isFlag(arg string) bool {
+	isNumber := len(arg) > 1 && '0' <= arg[1] && arg[1] <= '9'
+	return strings.HasPrefix(arg, "-") && !isNumber
+}
+
 func SortOptions(args []string) (Sorted, error) {
 	sorted := Sorted{
 		map[flag][]string{},
 		[]time.Time{},
+		nil,
 		[]job.Argument{},
 	}
 	var currentOption = Invalid
 	for _, arg := range args {
-		if len(arg) > 0 && arg[0] == '-' {
+		if isFlag(arg) {
 			newOption, val := strToOption[arg]
 			if !val {
-				return Sorted{}, errors.New("found unknown flag")
+				return Sorted{}, messages.UnknownFlag
 			}
 			_, isAlreadyInOption := sorted.options[newOption]
 			if isAlreadyInOption {
-				return Sorted{}, errors.New("found duplicate flag argument")
+				return Sorted{}, messages.DuplicateFlag
 			}
 			sorted.options[newOption] = []string{}
 			sorted.argumentPos = append(sorted.argumentPos, job.Flag)
 			currentOption = newOption
 		} else {
-			date, err := time.Parse(constants.ParseLayoutDate, arg)
-			if err == nil && !date.IsZero() {
+			// Ranges after --union, --intersect or --minus are its values.
+			isValue := setOperations[currentOption]
+			date, err := ParseDate(arg)
+			r, rangeErr := ParseRange(arg)
+			switch {
+			case !isValue && rangeErr == nil:
+				sorted.ranges = append(sorted.ranges, r)
+				sorted.argumentPos = append(sorted.argumentPos, job.Date)
+			case err == nil && !date.IsZero():
 				sorted.dates = append(sorted.dates, date)
 				sorted.argumentPos = append(sorted.argumentPos, job.Date)
-			} else {
+			default:
 				sorted.options[currentOption] = append(sorted.options[currentOption], arg)
 				sorted.argumentPos = append(sorted.argumentPos, job.Option)
 			}
@@ -2,11 +2,15 @@ package parser
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"pdate/internal/constants"
 	"pdate/internal/job"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
+	_ "time/tzdata"
 )
 
 func TestParser(t *testing.T) {
@@ -55,6 +59,18 @@ func TestParser(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "count with limit alias",
+			args: []string{"--limit", "20", "2025-10-02"},
+			wantedJob: job.Job{
+				DatesInput:      []time.Time{time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC)},
+				PosArguments:    []job.Argument{job.Flag, job.Option, job.Date},
+				IgnoredWeekdays: []time.Weekday{},
+				Format:          constants.DefaultInputFormat,
+				Count:           20,
+			},
+			wantErr: nil,
+		},
 		{
 			name:      "ignore error",
 			args:      []string{"2025-03-18", "-f", "{MM}", "-i", "mo", "wrong weekday", "-r", "2022-08-10"},
@@ -62,195 +78,1327 @@ func TestParser(t *testing.T) {
 			wantErr:   errors.New("error while trying to parse a weekday"),
 		},
 		{
-			name:      "error on sorting",
-			args:      []string{"2025-03-18", "-a", "{MM}", "-i", "mo", "wrong weekday", "-r", "2022-08-10"},
-			wantedJob: job.Job{},
-			wantErr:   errors.New("found unknown flag"),
+			name:      "error on sorting",
+			args:      []string{"2025-03-18", "-a", "{MM}", "-i", "mo", "wrong weekday", "-r", "2022-08-10"},
+			wantedJob: job.Job{},
+			wantErr:   errors.New("found unknown flag"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := job.New()
+
+			err := Parse(tt.args, j)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(j.DatesInput, tt.wantedJob.DatesInput) {
+				t.Errorf("parsed job mismatch:\n got: %+v\nwant: %+v", j.DatesInput, tt.wantedJob.DatesInput)
+			}
+			if !reflect.DeepEqual(j.PosArguments, tt.wantedJob.PosArguments) {
+				t.Errorf("parsed job mismatch:\n got: %+v\nwant: %+v", j.PosArguments, tt.wantedJob.PosArguments)
+			}
+			if !reflect.DeepEqual(j.IgnoredWeekdays, tt.wantedJob.IgnoredWeekdays) {
+				t.Errorf("parsed job mismatch:\n got: %+v\nwant: %+v", j.IgnoredWeekdays, tt.wantedJob.IgnoredWeekdays)
+			}
+			if j.Format != tt.wantedJob.Format {
+				t.Errorf("parsed job mismatch:\n got: %+v\nwant: %+v", j.Format, tt.wantedJob.Format)
+			}
+			if j.Reversed != tt.wantedJob.Reversed {
+				t.Errorf("parsed job mismatch:\n got: %+v\nwant: %+v", j.Reversed, tt.wantedJob.Reversed)
+			}
+			if j.Count != tt.wantedJob.Count {
+				t.Errorf("parsed job mismatch:\n got: %+v\nwant: %+v", j.Count, tt.wantedJob.Count)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	j := &job.Job{}
+
+	err := ParseInvalid([]string{"anything"}, j)
+	if err == nil {
+		t.Errorf("expected error, got nothing")
+	}
+}
+
+func TestParseHelp(t *testing.T) {
+	j := &job.Job{}
+
+	err := ParseHelp([]string{"anything"}, j)
+	if err != nil {
+		t.Errorf("error occured while trying to parse help")
+	}
+	if !j.Help {
+		t.Errorf("help flag was not activated")
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	j := &job.Job{}
+
+	err := ParseVersion([]string{"anything"}, j)
+	if err != nil {
+		t.Errorf("error occured while trying to parse version")
+	}
+	if !j.Version {
+		t.Errorf("help flag was not activated")
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		initialJob job.Job
+		wantFormat string
+		wantErr    error
+	}{
+		{
+			name:       "No arguments - returns error",
+			args:       []string{},
+			initialJob: job.Job{},
+			wantFormat: "",
+			wantErr:    errors.New("wrong format args given"),
+		},
+		{
+			name:       "Multiple arguments - returns error",
+			args:       []string{"json", "extra"},
+			initialJob: job.Job{},
+			wantFormat: "",
+			wantErr:    errors.New("wrong format args given"),
+		},
+		{
+			name:       "Single valid format",
+			args:       []string{"json"},
+			initialJob: job.Job{},
+			wantFormat: "json",
+			wantErr:    nil,
+		},
+		{
+			name:       "Single valid format with different string",
+			args:       []string{"custom-format"},
+			initialJob: job.Job{},
+			wantFormat: "custom-format",
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := tt.initialJob
+			err := ParseFormat(tt.args, &j)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if j.Format != tt.wantFormat {
+				t.Errorf("expected Format to be %q, got %q", tt.wantFormat, j.Format)
+			}
+		})
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		initialJob   job.Job
+		wantLanguage job.Language
+		wantErr      error
+	}{
+		{
+			name:         "No arguments - returns error",
+			args:         []string{},
+			initialJob:   job.Job{Language: job.English},
+			wantLanguage: job.English,
+			wantErr:      errors.New("wrong number language args given"),
+		},
+		{
+			name:         "Multiple arguments - returns error",
+			args:         []string{"args", "extra"},
+			initialJob:   job.Job{Language: job.English},
+			wantLanguage: job.English,
+			wantErr:      errors.New("wrong number language args given"),
+		},
+		{
+			name:         "Single valid format",
+			args:         []string{"de"},
+			initialJob:   job.Job{},
+			wantLanguage: job.German,
+			wantErr:      nil,
+		},
+		{
+			name:         "Regional variant",
+			args:         []string{"pt-BR"},
+			initialJob:   job.Job{},
+			wantLanguage: "pt-BR",
+			wantErr:      nil,
+		},
+		{
+			name:         "Tag with underscore and other case",
+			args:         []string{"zh_hant"},
+			initialJob:   job.Job{},
+			wantLanguage: "zh-Hant",
+			wantErr:      nil,
+		},
+		{
+			name:         "Unknown region falls back to the language",
+			args:         []string{"pl-XX"},
+			initialJob:   job.Job{},
+			wantLanguage: "pl",
+			wantErr:      nil,
+		},
+		{
+			name:         "Old code for Swiss German",
+			args:         []string{"ch"},
+			initialJob:   job.Job{},
+			wantLanguage: job.Swiss,
+			wantErr:      nil,
+		},
+		{
+			name:         "Single valid format with different string",
+			args:         []string{"cc"},
+			initialJob:   job.Job{Language: job.English},
+			wantLanguage: job.English,
+			wantErr:      errors.New("unknown language detected"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := tt.initialJob
+			err := ParseLanguage(tt.args, &j)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if j.Language != tt.wantLanguage {
+				t.Errorf("expected Format to be %q, got %q", tt.wantLanguage, j.Language)
+			}
+		})
+	}
+}
+
+func TestParseLanguageEnvironment(t *testing.T) {
+	tests := []struct {
+		name         string
+		lcAll        string
+		lcTime       string
+		lang         string
+		wantLanguage job.Language
+	}{
+		{"LANG with region and codeset", "", "", "de_CH.UTF-8", "de-CH"},
+		{"LC_TIME before LANG", "", "pl_PL.UTF-8", "en_US.UTF-8", "pl-PL"},
+		{"LC_ALL before LC_TIME", "ja_JP.UTF-8", "pl_PL.UTF-8", "en_US.UTF-8", "ja-JP"},
+		{"Unknown region falls back to the language", "", "", "fr_XX.UTF-8", job.French},
+		{"Unknown language keeps the default", "", "", "xx_YY", job.English},
+		{"C locale keeps the default", "C.UTF-8", "", "de_DE.UTF-8", job.English},
+		{"Nothing set", "", "", "", job.English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("LC_ALL", tt.lcAll)
+			t.Setenv("LC_TIME", tt.lcTime)
+			t.Setenv("LANG", tt.lang)
+			j := job.New()
+			ParseLanguageEnvironment(j)
+			if j.Language != tt.wantLanguage {
+				t.Errorf("expected Language to be %q, got %q", tt.wantLanguage, j.Language)
+			}
+		})
+	}
+}
+
+func TestLanguageFlagOverridesEnvironment(t *testing.T) {
+	t.Setenv("LANG", "de_DE.UTF-8")
+	j := job.New()
+	if err := Parse([]string{"-l", "fr"}, j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if j.Language != job.French {
+		t.Errorf("expected Language to be fr, got %q", j.Language)
+	}
+}
+
+func TestLanguageIsParsedBeforeIgnore(t *testing.T) {
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_TIME", "")
+	t.Setenv("LANG", "")
+	j := job.New()
+	if err := Parse([]string{"-i", "sa", "di", "-l", "fr"}, j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(j.IgnoredWeekdays) != 2 || j.IgnoredWeekdays[1] != time.Sunday {
+		t.Errorf("expected di to be read as French, got %v", j.IgnoredWeekdays)
+	}
+}
+
+func TestParseLocaleFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bern.yaml")
+	data := "tag: gsw-x-bern\nbase: gsw\nmonths:\n  wide: [Januar, Februar, März, Aprill, Mai, Juni, Juli, Oguscht, Septämber, Oktober, Novämber, Dezämber]\n"
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	j := job.New()
+	if err := Parse([]string{"--locale-file", path}, j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if j.Language != "gsw-x-bern" {
+		t.Errorf("expected Language to be gsw-x-bern, got %q", j.Language)
+	}
+
+	j = job.New()
+	if err := Parse([]string{"-l", "de", "--locale-file", path}, j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if j.Language != job.German {
+		t.Errorf("expected -l to choose the language, got %q", j.Language)
+	}
+
+	if err := ParseLocaleFile([]string{}, job.New()); err == nil || err.Error() != "wrong number locale file args given" {
+		t.Errorf("expected an error for a missing path, got %v", err)
+	}
+}
+
+func TestParseFormatStyle(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		wantStyle job.FormatStyle
+		wantErr   error
+	}{
+		{
+			name:      "No arguments - returns error",
+			args:      []string{},
+			wantStyle: job.Placeholder,
+			wantErr:   errors.New("wrong number of format style args given"),
+		},
+		{
+			name:      "Multiple arguments - returns error",
+			args:      []string{"go", "strftime"},
+			wantStyle: job.Placeholder,
+			wantErr:   errors.New("wrong number of format style args given"),
+		},
+		{
+			name:      "Strftime style",
+			args:      []string{"strftime"},
+			wantStyle: job.Strftime,
+			wantErr:   nil,
+		},
+		{
+			name:      "Go style",
+			args:      []string{"go"},
+			wantStyle: job.GoLayout,
+			wantErr:   nil,
+		},
+		{
+			name:      "Unknown style - returns error",
+			args:      []string{"python"},
+			wantStyle: job.Placeholder,
+			wantErr:   errors.New("unknown format style detected"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := job.Job{}
+			err := ParseFormatStyle(tt.args, &j)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if j.FormatStyle != tt.wantStyle {
+				t.Errorf("expected FormatStyle to be %v, got %v", tt.wantStyle, j.FormatStyle)
+			}
+		})
+	}
+}
+
+func TestParseCount(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		wantCount int
+		wantErr   error
+	}{
+		{
+			name:      "No arguments - returns error",
+			args:      []string{},
+			wantCount: 0,
+			wantErr:   errors.New("wrong number of count args given"),
+		},
+		{
+			name:      "Multiple arguments - returns error",
+			args:      []string{"1", "2"},
+			wantCount: 0,
+			wantErr:   errors.New("wrong number of count args given"),
+		},
+		{
+			name:      "Valid count",
+			args:      []string{"20"},
+			wantCount: 20,
+			wantErr:   nil,
+		},
+		{
+			name:      "Zero - returns error",
+			args:      []string{"0"},
+			wantCount: 0,
+			wantErr:   errors.New("count has to be a positive number"),
+		},
+		{
+			name:      "Not a number - returns error",
+			args:      []string{"ten"},
+			wantCount: 0,
+			wantErr:   errors.New("count has to be a positive number"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := job.Job{}
+			err := ParseCount(tt.args, &j)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if j.Count != tt.wantCount {
+				t.Errorf("expected Count to be %d, got %d", tt.wantCount, j.Count)
+			}
+		})
+	}
+}
+
+func TestParseTimezone(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantZone string
+		wantErr  error
+	}{
+		{
+			name:    "No arguments - returns error",
+			args:    []string{},
+			wantErr: errors.New("wrong number of timezone args given"),
+		},
+		{
+			name:     "Valid timezone",
+			args:     []string{"Europe/Zurich"},
+			wantZone: "Europe/Zurich",
+		},
+		{
+			name:     "UTC",
+			args:     []string{"UTC"},
+			wantZone: "UTC",
+		},
+		{
+			name:    "Unknown timezone - returns error",
+			args:    []string{"Mars/Olympus_Mons"},
+			wantErr: errors.New("unknown timezone detected"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := job.Job{}
+			err := ParseTimezone(tt.args, &j)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if j.Location.String() != tt.wantZone {
+				t.Errorf("expected Location to be %s, got %s", tt.wantZone, j.Location)
+			}
+		})
+	}
+}
+
+func TestParseTimezoneEnvironment(t *testing.T) {
+	tests := []struct {
+		name     string
+		tz       string
+		wantZone string
+	}{
+		{"Timezone name", "Asia/Tokyo", "Asia/Tokyo"},
+		{"Leading colon", ":America/New_York", "America/New_York"},
+		{"Empty means UTC", "", "UTC"},
+		{"Unknown value is skipped", "CET-1CEST,M3.5.0,M10.5.0/3", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TZ", tt.tz)
+			j := job.New()
+			ParseTimezoneEnvironment(j)
+			if tt.wantZone == "" {
+				if j.Location != time.Local {
+					t.Errorf("expected Location to stay the local one, got %s", j.Location)
+				}
+				return
+			}
+			if j.Location.String() != tt.wantZone {
+				t.Errorf("expected Location to be %s, got %s", tt.wantZone, j.Location)
+			}
+		})
+	}
+}
+
+func TestTimezoneFlagOverridesEnvironment(t *testing.T) {
+	t.Setenv("TZ", "Asia/Tokyo")
+	j := job.New()
+	if err := Parse([]string{"--tz", "Europe/Zurich"}, j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if j.Location.String() != "Europe/Zurich" {
+		t.Errorf("expected Location to be Europe/Zurich, got %s", j.Location)
+	}
+}
+
+func TestParseStep(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantStep job.Step
+		wantErr  error
+	}{
+		{
+			name:    "No arguments - returns error",
+			args:    []string{},
+			wantErr: errors.New("wrong number of step args given"),
+		},
+		{
+			name:     "Minutes",
+			args:     []string{"15m"},
+			wantStep: job.Step{Amount: 15, Unit: job.Minute},
+		},
+		{
+			name:     "Hours",
+			args:     []string{"2h"},
+			wantStep: job.Step{Amount: 2, Unit: job.Hour},
+		},
+		{
+			name:     "Seconds",
+			args:     []string{"30s"},
+			wantStep: job.Step{Amount: 30, Unit: job.Second},
+		},
+		{
+			name:     "Days",
+			args:     []string{"3d"},
+			wantStep: job.Step{Amount: 3, Unit: job.Day},
+		},
+		{
+			name:     "Weeks",
+			args:     []string{"1w"},
+			wantStep: job.Step{Amount: 1, Unit: job.Week},
+		},
+		{
+			name:    "Missing amount - returns error",
+			args:    []string{"m"},
+			wantErr: errors.New("invalid step detected"),
+		},
+		{
+			name:    "Unknown unit - returns error",
+			args:    []string{"5y"},
+			wantErr: errors.New("invalid step detected"),
+		},
+		{
+			name:    "Zero - returns error",
+			args:    []string{"0h"},
+			wantErr: errors.New("invalid step detected"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := job.Job{}
+			err := ParseStep(tt.args, &j)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if j.Step != tt.wantStep {
+				t.Errorf("expected Step to be %v, got %v", tt.wantStep, j.Step)
+			}
+		})
+	}
+}
+
+func TestParseCalendar(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		wantCalendar job.Calendar
+		wantErr      error
+	}{
+		{
+			name:    "No arguments - returns error",
+			args:    []string{},
+			wantErr: errors.New("wrong number of calendar args given"),
+		},
+		{
+			name:         "Hebrew",
+			args:         []string{"hebrew"},
+			wantCalendar: job.HebrewCalendar,
+		},
+		{
+			name:         "Chinese",
+			args:         []string{"chinese"},
+			wantCalendar: job.ChineseCalendar,
+		},
+		{
+			name:         "Gregorian",
+			args:         []string{"gregorian"},
+			wantCalendar: job.GregorianCalendar,
+		},
+		{
+			name:    "Unknown calendar - returns error",
+			args:    []string{"mayan"},
+			wantErr: errors.New("unknown calendar detected"),
+		},
+		{
+			name:    "Too many arguments - returns error",
+			args:    []string{"hebrew", "persian"},
+			wantErr: errors.New("wrong number of calendar args given"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := job.Job{}
+			err := ParseCalendar(tt.args, &j)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if j.Calendar != tt.wantCalendar {
+				t.Errorf("expected Calendar to be %v, got %v", tt.wantCalendar, j.Calendar)
+			}
+		})
+	}
+}
+
+func TestParseDigits(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantDigits job.Digits
+		wantErr    error
+	}{
+		{
+			name:    "No arguments - returns error",
+			args:    []string{},
+			wantErr: errors.New("wrong number of digits args given"),
+		},
+		{
+			name:       "Native",
+			args:       []string{"native"},
+			wantDigits: job.NativeDigits,
+		},
+		{
+			name:       "Latin",
+			args:       []string{"latin"},
+			wantDigits: job.LatinDigits,
+		},
+		{
+			name:    "Unknown digits - returns error",
+			args:    []string{"roman"},
+			wantErr: errors.New("unknown digits detected"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := job.Job{}
+			err := ParseDigits(tt.args, &j)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if j.Digits != tt.wantDigits {
+				t.Errorf("expected Digits to be %v, got %v", tt.wantDigits, j.Digits)
+			}
+		})
+	}
+}
+
+func TestParseWeekStart(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		language      job.Language
+		wantWeekStart job.WeekStart
+		wantErr       error
+	}{
+		{
+			name:    "No arguments - returns error",
+			args:    []string{},
+			wantErr: errors.New("wrong number of week start args given"),
+		},
+		{
+			name:          "Monday",
+			args:          []string{"mo"},
+			wantWeekStart: job.MondayStart,
+		},
+		{
+			name:          "Sunday",
+			args:          []string{"su"},
+			wantWeekStart: job.SundayStart,
+		},
+		{
+			name:          "Saturday",
+			args:          []string{"sa"},
+			wantWeekStart: job.SaturdayStart,
+		},
+		{
+			name:          "Code of the language",
+			args:          []string{"so"},
+			language:      job.German,
+			wantWeekStart: job.SundayStart,
+		},
+		{
+			name:    "Weeks don't start on Wednesday - returns error",
+			args:    []string{"we"},
+			wantErr: errors.New("unknown week start detected"),
+		},
+		{
+			name:    "Unknown weekday - returns error",
+			args:    []string{"xx"},
+			wantErr: errors.New("unknown week start detected"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := job.Job{Language: tt.language}
+			err := ParseWeekStart(tt.args, &j)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if j.WeekStart != tt.wantWeekStart {
+				t.Errorf("expected WeekStart to be %v, got %v", tt.wantWeekStart, j.WeekStart)
+			}
+		})
+	}
+}
+
+func TestParseWeekend(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		wantWeekend job.Weekend
+		wantErr     error
+	}{
+		{
+			name:    "No arguments - returns error",
+			args:    []string{},
+			wantErr: errors.New("wrong number of weekend args given"),
+		},
+		{
+			name:        "Saturday and Sunday",
+			args:        []string{"sat-sun"},
+			wantWeekend: job.SaturdaySundayWeekend,
+		},
+		{
+			name:        "Friday and Saturday",
+			args:        []string{"fri-sat"},
+			wantWeekend: job.FridaySaturdayWeekend,
+		},
+		{
+			name:        "Friday",
+			args:        []string{"fri"},
+			wantWeekend: job.FridayWeekend,
+		},
+		{
+			name:        "Sunday",
+			args:        []string{"sun"},
+			wantWeekend: job.SundayWeekend,
+		},
+		{
+			name:    "Unknown weekend - returns error",
+			args:    []string{"mon-tue"},
+			wantErr: errors.New("unknown weekend detected"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := job.Job{}
+			err := ParseWeekend(tt.args, &j)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if j.Weekend != tt.wantWeekend {
+				t.Errorf("expected Weekend to be %v, got %v", tt.wantWeekend, j.Weekend)
+			}
+		})
+	}
+}
+
+func TestParseWorkdaysOnly(t *testing.T) {
+	j := job.Job{}
+	if err := ParseWorkdaysOnly([]string{}, &j); err != nil || !j.WorkdaysOnly {
+		t.Errorf("expected WorkdaysOnly to be set, got %v and error %v", j.WorkdaysOnly, err)
+	}
+	err := ParseWorkdaysOnly([]string{"yes"}, &job.Job{})
+	if err == nil || err.Error() != "workdays only flag doesn't have arguments" {
+		t.Errorf("expected an error for arguments, got %v", err)
+	}
+}
+
+func TestParseOnly(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		initialJob   job.Job
+		wantWeekdays []time.Weekday
+		wantErr      error
+	}{
+		{
+			name:    "No arguments - returns error",
+			args:    []string{},
+			wantErr: errors.New("no values for the filter provided"),
+		},
+		{
+			name:         "Weekdays separated by spaces and commas",
+			args:         []string{"mo", "we,fr"},
+			wantWeekdays: []time.Weekday{time.Monday, time.Wednesday, time.Friday},
+		},
+		{
+			name:         "German codes",
+			args:         []string{"mi"},
+			initialJob:   job.Job{Language: job.German},
+			wantWeekdays: []time.Weekday{time.Wednesday},
+		},
+		{
+			name:    "Invalid weekday - returns error",
+			args:    []string{"mo", "xx"},
+			wantErr: errors.New("error while trying to parse a weekday"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := tt.initialJob
+			err := ParseOnly(tt.args, &j)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(j.OnlyWeekdays, tt.wantWeekdays) {
+				t.Errorf("expected OnlyWeekdays to be %v, got %v", tt.wantWeekdays, j.OnlyWeekdays)
+			}
+		})
+	}
+}
+
+func TestParseDays(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantDays []int
+		wantErr  error
+	}{
+		{
+			name:    "No arguments - returns error",
+			args:    []string{},
+			wantErr: errors.New("no values for the filter provided"),
+		},
+		{
+			name:     "Days and the last day",
+			args:     []string{"1,15,-1"},
+			wantDays: []int{1, 15, -1},
+		},
+		{
+			name:     "Range",
+			args:     []string{"1-3", "28"},
+			wantDays: []int{1, 2, 3, 28},
+		},
+		{
+			name:    "Zero - returns error",
+			args:    []string{"0"},
+			wantErr: errors.New("invalid day of month detected"),
+		},
+		{
+			name:    "Day 32 - returns error",
+			args:    []string{"32"},
+			wantErr: errors.New("invalid day of month detected"),
+		},
+		{
+			name:    "Reversed range - returns error",
+			args:    []string{"10-5"},
+			wantErr: errors.New("invalid day of month detected"),
+		},
+		{
+			name:    "Negative range - returns error",
+			args:    []string{"-3-1"},
+			wantErr: errors.New("invalid day of month detected"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := job.Job{}
+			err := ParseDays(tt.args, &j)
+
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(j.OnlyDays, tt.wantDays) {
+				t.Errorf("expected OnlyDays to be %v, got %v", tt.wantDays, j.OnlyDays)
+			}
+		})
+	}
+}
+
+func TestParseMonths(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		initialJob job.Job
+		wantMonths []time.Month
+		wantErr    error
+	}{
+		{
+			name:    "No arguments - returns error",
+			args:    []string{},
+			wantErr: errors.New("no values for the filter provided"),
+		},
+		{
+			name:       "Quarter starts",
+			args:       []string{"jan,apr,jul,oct"},
+			wantMonths: []time.Month{time.January, time.April, time.July, time.October},
+		},
+		{
+			name:       "Numbers",
+			args:       []string{"2", "12"},
+			wantMonths: []time.Month{time.February, time.December},
+		},
+		{
+			name:       "Range around the end of the year",
+			args:       []string{"nov-feb"},
+			wantMonths: []time.Month{time.November, time.December, time.January, time.February},
+		},
+		{
+			name:       "French names and abbreviations",
+			args:       []string{"mars", "févr."},
+			initialJob: job.Job{Language: job.French},
+			wantMonths: []time.Month{time.March, time.February},
+		},
+		{
+			name:    "Month 13 - returns error",
+			args:    []string{"13"},
+			wantErr: errors.New("invalid month detected"),
+		},
+		{
+			name:    "Unknown month - returns error",
+			args:    []string{"jan-xyz"},
+			wantErr: errors.New("invalid month detected"),
 		},
 	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			j := job.New()
-
-			err := Parse(tt.args, j)
+			j := tt.initialJob
+			err := ParseMonths(tt.args, &j)
 
 			if tt.wantErr != nil {
 				if err == nil || err.Error() != tt.wantErr.Error() {
 					t.Errorf("expected error %v, got %v", tt.wantErr, err)
 				}
 				return
-			} else if err != nil {
-				t.Errorf("unexpected error: %v", err)
-			}
-
-			if !reflect.DeepEqual(j.DatesInput, tt.wantedJob.DatesInput) {
-				t.Errorf("parsed job mismatch:\n got: %+v\nwant: %+v", j.DatesInput, tt.wantedJob.DatesInput)
-			}
-			if !reflect.DeepEqual(j.PosArguments, tt.wantedJob.PosArguments) {
-				t.Errorf("parsed job mismatch:\n got: %+v\nwant: %+v", j.PosArguments, tt.wantedJob.PosArguments)
 			}
-			if !reflect.DeepEqual(j.IgnoredWeekdays, tt.wantedJob.IgnoredWeekdays) {
-				t.Errorf("parsed job mismatch:\n got: %+v\nwant: %+v", j.IgnoredWeekdays, tt.wantedJob.IgnoredWeekdays)
-			}
-			if j.Format != tt.wantedJob.Format {
-				t.Errorf("parsed job mismatch:\n got: %+v\nwant: %+v", j.Format, tt.wantedJob.Format)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
 			}
-			if j.Reversed != tt.wantedJob.Reversed {
-				t.Errorf("parsed job mismatch:\n got: %+v\nwant: %+v", j.Reversed, tt.wantedJob.Reversed)
+			if !reflect.DeepEqual(j.OnlyMonths, tt.wantMonths) {
+				t.Errorf("expected OnlyMonths to be %v, got %v", tt.wantMonths, j.OnlyMonths)
 			}
 		})
 	}
 }
 
-func TestParseInvalid(t *testing.T) {
-	j := &job.Job{}
-
-	err := ParseInvalid([]string{"anything"}, j)
-	if err == nil {
-		t.Errorf("expected error, got nothing")
+func TestParseWeeks(t *testing.T) {
+	j := job.Job{}
+	if err := ParseWeeks([]string{"1-3,53"}, &j); err != nil || !reflect.DeepEqual(j.OnlyWeeks, []int{1, 2, 3, 53}) {
+		t.Errorf("expected weeks 1, 2, 3 and 53, got %v and error %v", j.OnlyWeeks, err)
+	}
+	for _, args := range [][]string{{"54"}, {"-1"}, {"x"}} {
+		if err := ParseWeeks(args, &job.Job{}); err == nil || err.Error() != "invalid week detected" {
+			t.Errorf("expected an error for %v, got %v", args, err)
+		}
 	}
 }
 
-func TestParseHelp(t *testing.T) {
-	j := &job.Job{}
+func TestParseDaysOfYear(t *testing.T) {
+	j := job.Job{}
+	if err := ParseDaysOfYear([]string{"1", "100", "-1"}, &j); err != nil || !reflect.DeepEqual(j.OnlyDaysOfYear, []int{1, 100, -1}) {
+		t.Errorf("expected days 1, 100 and -1, got %v and error %v", j.OnlyDaysOfYear, err)
+	}
+	for _, args := range [][]string{{"367"}, {"-367"}, {"0"}} {
+		if err := ParseDaysOfYear(args, &job.Job{}); err == nil || err.Error() != "invalid day of year detected" {
+			t.Errorf("expected an error for %v, got %v", args, err)
+		}
+	}
+}
 
-	err := ParseHelp([]string{"anything"}, j)
-	if err != nil {
-		t.Errorf("error occured while trying to parse help")
+func TestParseWhere(t *testing.T) {
+	j := job.Job{}
+	if err := ParseWhere([]string{"weekday", "in", "(mo,fr)"}, &j); err != nil || j.Where != "weekday in (mo,fr)" {
+		t.Errorf("expected the joined expression, got %q and error %v", j.Where, err)
 	}
-	if !j.Help {
-		t.Errorf("help flag was not activated")
+	if err := ParseWhere([]string{" "}, &job.Job{}); err == nil || err.Error() != "no where expression provided" {
+		t.Errorf("expected an error for an empty expression, got %v", err)
 	}
 }
 
-func TestParseVersion(t *testing.T) {
-	j := &job.Job{}
+func TestParseHolidays(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "holidays.txt")
+	data := "# Zurich\n2025-12-25 Christmas\n\n2025-12-26  # St. Stephen's Day\n"
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
 
-	err := ParseVersion([]string{"anything"}, j)
-	if err != nil {
-		t.Errorf("error occured while trying to parse version")
+	j := job.Job{}
+	if err := ParseHolidays([]string{path}, &j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
-	if !j.Version {
-		t.Errorf("help flag was not activated")
+	want := []time.Time{time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC)}
+	if !reflect.DeepEqual(j.Holidays, want) {
+		t.Errorf("expected Holidays to be %v, got %v", want, j.Holidays)
+	}
+	if names := []string{"Christmas", ""}; !reflect.DeepEqual(j.HolidayNames, names) {
+		t.Errorf("expected HolidayNames to be %q, got %q", names, j.HolidayNames)
+	}
+
+	invalid := filepath.Join(t.TempDir(), "invalid.txt")
+	if err := os.WriteFile(invalid, []byte("25.12.2025\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ParseHolidays([]string{invalid}, &job.Job{}); err == nil || err.Error() != "invalid holiday date detected" {
+		t.Errorf("expected an error for an invalid date, got %v", err)
+	}
+	if err := ParseHolidays([]string{}, &job.Job{}); err == nil || err.Error() != "wrong number of holidays args given" {
+		t.Errorf("expected an error for a missing path, got %v", err)
+	}
+	missing := filepath.Join(t.TempDir(), "missing.txt")
+	if err := ParseHolidays([]string{missing}, &job.Job{}); err == nil || err.Error() != missing+": the file can't be read" {
+		t.Errorf("expected an error for a missing file, got %v", err)
 	}
 }
 
-func TestParseFormat(t *testing.T) {
+func TestParseSelection(t *testing.T) {
 	tests := []struct {
-		name       string
-		args       []string
-		initialJob job.Job
-		wantFormat string
-		wantErr    error
+		name    string
+		parse   func([]string, *job.Job) error
+		args    []string
+		field   func(*job.Job) int
+		want    int
+		wantErr error
 	}{
-		{
-			name:       "No arguments - returns error",
-			args:       []string{},
-			initialJob: job.Job{},
-			wantFormat: "",
-			wantErr:    errors.New("wrong format args given"),
-		},
-		{
-			name:       "Multiple arguments - returns error",
-			args:       []string{"json", "extra"},
-			initialJob: job.Job{},
-			wantFormat: "",
-			wantErr:    errors.New("wrong format args given"),
-		},
-		{
-			name:       "Single valid format",
-			args:       []string{"json"},
-			initialJob: job.Job{},
-			wantFormat: "json",
-			wantErr:    nil,
-		},
-		{
-			name:       "Single valid format with different string",
-			args:       []string{"custom-format"},
-			initialJob: job.Job{},
-			wantFormat: "custom-format",
-			wantErr:    nil,
-		},
+		{"Offset", ParseOffset, []string{"2"}, func(j *job.Job) int { return j.Offset }, 2, nil},
+		{"Offset of zero", ParseOffset, []string{"0"}, func(j *job.Job) int { return j.Offset }, 0, nil},
+		{"Negative offset", ParseOffset, []string{"-1"}, nil, 0, errors.New("offset can't be negative")},
+		{"Every", ParseEvery, []string{"3"}, func(j *job.Job) int { return j.Every }, 3, nil},
+		{"Every zero", ParseEvery, []string{"0"}, nil, 0, errors.New("every has to be a positive number")},
+		{"Every without a number", ParseEvery, []string{}, nil, 0, errors.New("wrong number of every args given")},
+		{"First", ParseFirst, []string{"5"}, func(j *job.Job) int { return j.First }, 5, nil},
+		{"First of a word", ParseFirst, []string{"five"}, nil, 0, errors.New("first has to be a positive number")},
+		{"Last", ParseLast, []string{"5"}, func(j *job.Job) int { return j.Last }, 5, nil},
+		{"Last of two numbers", ParseLast, []string{"5", "6"}, nil, 0, errors.New("wrong number of last args given")},
+		{"Sample", ParseSample, []string{"10"}, func(j *job.Job) int { return j.Sample }, 10, nil},
+		{"Sample of zero", ParseSample, []string{"0"}, nil, 0, errors.New("sample has to be a positive number")},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			j := tt.initialJob
-			err := ParseFormat(tt.args, &j)
+			j := job.Job{}
+			err := tt.parse(tt.args, &j)
 
 			if tt.wantErr != nil {
 				if err == nil || err.Error() != tt.wantErr.Error() {
 					t.Errorf("expected error %v, got %v", tt.wantErr, err)
 				}
-			} else if err != nil {
-				t.Errorf("unexpected error: %v", err)
+				return
 			}
-
-			if j.Format != tt.wantFormat {
-				t.Errorf("expected Format to be %q, got %q", tt.wantFormat, j.Format)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := tt.field(&j); got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
 			}
 		})
 	}
 }
 
-func TestParseLanguage(t *testing.T) {
+func TestParseSeed(t *testing.T) {
+	j := job.Job{}
+	if err := ParseSeed([]string{"-42"}, &j); err != nil || j.Seed != -42 || !j.Seeded {
+		t.Errorf("expected the seed -42, got %d, %v and error %v", j.Seed, j.Seeded, err)
+	}
+	if err := ParseSeed([]string{"x"}, &job.Job{}); err == nil || err.Error() != "seed has to be a number" {
+		t.Errorf("expected an error for a word, got %v", err)
+	}
+	if err := ParseSeed([]string{}, &job.Job{}); err == nil || err.Error() != "wrong number of seed args given" {
+		t.Errorf("expected an error for a missing seed, got %v", err)
+	}
+}
+
+func TestParseRange(t *testing.T) {
 	tests := []struct {
-		name         string
-		args         []string
-		initialJob   job.Job
-		wantLanguage job.Language
-		wantErr      error
+		name    string
+		arg     string
+		want    job.Range
+		wantErr error
 	}{
 		{
-			name:         "No arguments - returns error",
-			args:         []string{},
-			initialJob:   job.Job{},
-			wantLanguage: job.English,
-			wantErr:      errors.New("wrong number language args given"),
+			name: "Dates",
+			arg:  "2025-01-01..2025-01-31",
+			want: job.Range{From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)},
 		},
 		{
-			name:         "Multiple arguments - returns error",
-			args:         []string{"args", "extra"},
-			initialJob:   job.Job{},
-			wantLanguage: job.English,
-			wantErr:      errors.New("wrong number language args given"),
+			name: "Dates in reverse",
+			arg:  "2025-01-31..2025-01-01",
+			want: job.Range{From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)},
 		},
 		{
-			name:         "Single valid format",
-			args:         []string{"de"},
-			initialJob:   job.Job{},
-			wantLanguage: job.German,
-			wantErr:      nil,
+			name: "Times",
+			arg:  "2025-01-01T08:00..2025-01-01T12:30",
+			want: job.Range{From: time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC), To: time.Date(2025, 1, 1, 12, 30, 0, 0, time.UTC)},
 		},
 		{
-			name:         "Single valid format with different string",
-			args:         []string{"cc"},
-			initialJob:   job.Job{},
-			wantLanguage: job.English,
-			wantErr:      errors.New("unknown language detected"),
+			name:    "Single date - returns error",
+			arg:     "2025-01-01",
+			wantErr: errors.New("invalid range detected"),
+		},
+		{
+			name:    "Open range - returns error",
+			arg:     "2025-01-01..",
+			wantErr: errors.New("invalid range detected"),
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			j := tt.initialJob
-			err := ParseLanguage(tt.args, &j)
+			got, err := ParseRange(tt.arg)
 
 			if tt.wantErr != nil {
 				if err == nil || err.Error() != tt.wantErr.Error() {
 					t.Errorf("expected error %v, got %v", tt.wantErr, err)
 				}
-			} else if err != nil {
-				t.Errorf("unexpected error: %v", err)
+				return
 			}
-
-			if j.Language != tt.wantLanguage {
-				t.Errorf("expected Format to be %q, got %q", tt.wantLanguage, j.Language)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
 			}
 		})
 	}
 }
 
+func TestParseSetOperations(t *testing.T) {
+	j := job.New()
+	args := []string{"2025-01-01..2025-01-31", "2025-03-01..2025-03-15", "--minus", "2025-01-06..2025-01-10", "--union", "2025-02-01..2025-02-02", "-r"}
+	if err := Parse(args, j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(j.Ranges) != 2 || len(j.Minus) != 1 || len(j.Union) != 1 || len(j.DatesInput) != 0 {
+		t.Errorf("expected 2 ranges, 1 to remove and 1 to add, got %v, %v and %v", j.Ranges, j.Minus, j.Union)
+	}
+
+	if err := ParseIntersect([]string{}, &job.Job{}); err == nil || err.Error() != "no ranges for the set operation provided" {
+		t.Errorf("expected an error for no ranges, got %v", err)
+	}
+	if err := ParseMinus([]string{"2025-01-01..x"}, &job.Job{}); err == nil || err.Error() != "invalid range detected" {
+		t.Errorf("expected an error for an invalid range, got %v", err)
+	}
+}
+
+func TestInput(t *testing.T) {
+	input := NewInput(strings.NewReader("2025-01-03\n\n  2025-01-01..2025-01-02\nx\n2025-01-05\n"))
+	var got []job.Range
+	for r := range input.Ranges() {
+		got = append(got, r)
+	}
+	want := []job.Range{
+		{From: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC), To: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if err := input.Err(); err == nil || err.Error() != "invalid date or range in the input" {
+		t.Errorf("expected an error for the invalid line, got %v", err)
+	}
+}
+
+func TestParseStdin(t *testing.T) {
+	j := job.New()
+	if err := Parse([]string{"-", "-f", "{DD}"}, j); err != nil || j.Input == nil {
+		t.Errorf("expected the input to be set, got %v and error %v", j.Input, err)
+	}
+	if err := ParseStdin([]string{"x"}, &job.Job{}); err == nil || err.Error() != "stdin flag doesn't have arguments" {
+		t.Errorf("expected an error for an argument, got %v", err)
+	}
+	j = job.New()
+	if err := Parse([]string{"--stdin", "2025-01-01"}, j); err != nil || len(j.DatesInput) != 1 || j.Input == nil {
+		t.Errorf("expected a date besides the input for the validation, got %v and error %v", j.DatesInput, err)
+	}
+}
+
+func TestParseReformat(t *testing.T) {
+	j := job.New()
+	if err := Parse([]string{"reformat", "--in", "{YYYY}-{MM}-{DD}", "-f", "{D}. {MN} {YYYY}", "-l", "de"}, j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if j.Command != job.ReformatCommand || j.InputFormat != "{YYYY}-{MM}-{DD}" || j.Format != "{D}. {MN} {YYYY}" {
+		t.Errorf("expected reformat with both formats, got %v, %q and %q", j.Command, j.InputFormat, j.Format)
+	}
+
+	// The subcommand is only recognized as the first argument
+	if err := Parse([]string{"-r", "reformat"}, job.New()); err == nil || err.Error() != "reverse flag doesn't have arguments" {
+		t.Errorf("expected an error for reformat after a flag, got %v", err)
+	}
+	if err := ParseInputFormat([]string{}, &job.Job{}); err == nil || err.Error() != "wrong number of in args given" {
+		t.Errorf("expected an error for a missing format, got %v", err)
+	}
+}
+
+func TestParseInfo(t *testing.T) {
+	j := job.New()
+	if err := Parse([]string{"info", "2025-12-25", "--json"}, j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if j.Command != job.InfoCommand || !j.JSON || len(j.DatesInput) != 1 {
+		t.Errorf("expected info about one date as JSON, got %v, %v and %v", j.Command, j.JSON, j.DatesInput)
+	}
+	if err := ParseJSON([]string{"x"}, &job.Job{}); err == nil || err.Error() != "json flag doesn't have arguments" {
+		t.Errorf("expected an error for an argument, got %v", err)
+	}
+}
+
+func TestParseGrid(t *testing.T) {
+	j := job.New()
+	if err := Parse([]string{"2025-10-01", "2025-10-31", "--grid"}, j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !j.Grid || len(j.DatesInput) != 2 {
+		t.Errorf("expected a grid of two dates, got %v and %v", j.Grid, j.DatesInput)
+	}
+	if err := ParseGrid([]string{"x"}, &job.Job{}); err == nil || err.Error() != "grid flag doesn't have arguments" {
+		t.Errorf("expected an error for an argument, got %v", err)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Time
+		wantErr  bool
+	}{
+		{"2025-10-02", time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC), false},
+		{"2025-10-2", time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC), false},
+		{"2025-10-02T08:15", time.Date(2025, 10, 2, 8, 15, 0, 0, time.UTC), false},
+		{"2025-10-02T18:00:30", time.Date(2025, 10, 2, 18, 0, 30, 0, time.UTC), false},
+		{"2025-10-02T25:00", time.Time{}, true},
+		{"08:00", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		result, err := ParseDate(tt.input)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseDate(%q) expected an error", tt.input)
+			}
+			continue
+		}
+		if err != nil || !result.Equal(tt.expected) {
+			t.Errorf("ParseDate(%q) = %v, %v; want %v", tt.input, result, err, tt.expected)
+		}
+	}
+}
+
 func TestParseIgnore(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -294,6 +1442,55 @@ func TestParseIgnore(t *testing.T) {
 			wantWeekdays: []time.Weekday{time.Wednesday},
 			wantErr:      errors.New("error while trying to parse a weekday"),
 		},
+		{
+			name:         "French codes",
+			args:         []string{"lu", "ma", "me", "di"},
+			initialJob:   job.Job{Language: job.French},
+			wantWeekdays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Sunday},
+			wantErr:      nil,
+		},
+		{
+			name:         "German codes with a region",
+			args:         []string{"mo", "di", "mi", "so"},
+			initialJob:   job.Job{Language: "de-AT"},
+			wantWeekdays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Sunday},
+			wantErr:      nil,
+		},
+		{
+			name:         "Swiss German uses the German codes",
+			args:         []string{"do"},
+			initialJob:   job.Job{Language: job.Swiss},
+			wantWeekdays: []time.Weekday{time.Thursday},
+			wantErr:      nil,
+		},
+		{
+			name:         "Codes of the language win over the English codes",
+			args:         []string{"di", "sa"},
+			initialJob:   job.Job{Language: job.Dutch},
+			wantWeekdays: []time.Weekday{time.Tuesday, time.Saturday},
+			wantErr:      nil,
+		},
+		{
+			name:         "Abbreviations of the locale with and without dot",
+			args:         []string{"Lun.", "mar"},
+			initialJob:   job.Job{Language: job.French},
+			wantWeekdays: []time.Weekday{time.Monday, time.Tuesday},
+			wantErr:      nil,
+		},
+		{
+			name:         "English codes work in every language",
+			args:         []string{"tu", "th"},
+			initialJob:   job.Job{Language: job.Russian},
+			wantWeekdays: []time.Weekday{time.Tuesday, time.Thursday},
+			wantErr:      nil,
+		},
+		{
+			name:         "Codes of another language are invalid",
+			args:         []string{"lu"},
+			initialJob:   job.Job{Language: job.German},
+			wantWeekdays: nil,
+			wantErr:      errors.New("error while trying to parse a weekday"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -398,6 +1595,31 @@ func TestSortOptions(t *testing.T) {
 			args:      []string{"-x", "oops"},
 			expectErr: errors.New("found unknown flag"),
 		},
+		{
+			name:      "Ranges and the ranges of a set operation",
+			args:      []string{"2025-01-01..2025-01-31", "--minus", "2025-01-06..2025-01-10", "2025-02-01"},
+			expectErr: nil,
+			expectSorted: Sorted{
+				options: map[flag][]string{
+					Minus: {"2025-01-06..2025-01-10"},
+				},
+				dates:       []time.Time{time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+				ranges:      []job.Range{{From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)}},
+				argumentPos: []job.Argument{job.Date, job.Flag, job.Option, job.Date},
+			},
+		},
+		{
+			name:      "Negative numbers are values",
+			args:      []string{"--days", "15", "-1"},
+			expectErr: nil,
+			expectSorted: Sorted{
+				options: map[flag][]string{
+					Days: {"15", "-1"},
+				},
+				dates:       []time.Time{},
+				argumentPos: []job.Argument{job.Flag, job.Option, job.Option},
+			},
+		},
 		{
 			name:      "Date parsing without flags",
 			args:      []string{"2023-06-15"},
@@ -536,6 +1758,21 @@ func TestSortOptions(t *testing.T) {
 			args:      []string{"-i", "-notAFlag"},
 			expectErr: errors.New("found unknown flag"),
 		},
+		{
+			name:      "Date and time",
+			args:      []string{"--step", "15m", "2025-10-02T08:00", "2025-10-02T18:00"},
+			expectErr: nil,
+			expectSorted: Sorted{
+				options: map[flag][]string{
+					StepSize: {"15m"},
+				},
+				dates: []time.Time{
+					time.Date(2025, 10, 2, 8, 0, 0, 0, time.UTC),
+					time.Date(2025, 10, 2, 18, 0, 0, 0, time.UTC),
+				},
+				argumentPos: []job.Argument{job.Flag, job.Option, job.Date, job.Date},
+			},
+		},
 		{
 			name:      "Version Flag",
 			args:      []string{"-i", "2024-13-40", "val", "-v"},
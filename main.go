@@ -1,11 +1,14 @@
 package main
 
 import (
+	"bufio"
 	"fmt"
 	"os"
 	"pdate/internal/dates"
 	"pdate/internal/job"
+	"pdate/internal/messages"
 	"pdate/internal/parser"
+	_ "time/tzdata"
 )
 
 func main() {
@@ -13,15 +16,53 @@ func main() {
 	j := job.New()
 	parseErr := parser.Parse(argsWithoutProg, j)
 	if parseErr != nil {
-		fmt.Println(parseErr)
+		fmt.Println(messages.Translate(parseErr, string(j.Language)))
 		return
 	}
 	validErr := job.Validate(j)
 	if validErr != nil {
-		fmt.Println(validErr)
+		fmt.Println(messages.Translate(validErr, string(j.Language)))
 		return
 	}
-	for _, date := range dates.GetDates(j) {
-		fmt.Println(date)
+	if j.Command == job.ReformatCommand {
+		reformat(j)
+		return
+	}
+	getDates := dates.GetDates
+	if j.Command == job.InfoCommand && !j.Help && !j.Version {
+		getDates = dates.GetInfo
+	}
+	result, datesErr := getDates(j)
+	if datesErr != nil {
+		fmt.Println(messages.Translate(datesErr, string(j.Language)))
+		return
+	}
+	out := bufio.NewWriter(os.Stdout)
+	for date := range result {
+		fmt.Fprintln(out, date)
+	}
+	out.Flush()
+	if j.Input != nil && j.Input.Err() != nil {
+		fmt.Println(messages.Translate(j.Input.Err(), string(j.Language)))
+	}
+}
+
+// reformat copies standard input to standard output line by line and
+// replaces the dates of the --in format on the way.
+func reformat(j *job.Job) {
+	reformatter, err := dates.NewReformatter(j)
+	if err != nil {
+		fmt.Println(messages.Translate(err, string(j.Language)))
+		return
+	}
+	in := bufio.NewReader(os.Stdin)
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	for {
+		line, err := in.ReadString('\n')
+		out.WriteString(reformatter.Replace(line))
+		if err != nil {
+			return
+		}
 	}
 }